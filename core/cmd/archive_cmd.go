@@ -2,12 +2,24 @@ package cmd
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"os"
 
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/infra"
 	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
 	"github.com/towns-protocol/towns/core/node/rpc"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/streamexport"
 
 	"github.com/spf13/cobra"
 )
@@ -25,6 +37,202 @@ func runArchive(cfg *config.Config, once bool) error {
 	return rpc.RunArchive(ctx, cfg, once)
 }
 
+// openArchiveStore opens the stream store of the archive node that is configured in cfg.
+// The store takes exclusive ownership of the archive database schema, a running archive
+// node on the same schema shuts down. The returned close function releases the store and
+// blockchain client.
+func openArchiveStore(
+	ctx context.Context,
+	cfg *config.Config,
+) (*storage.PostgresStreamStore, func(), error) {
+	if cfg.Archive.ArchiveId == "" {
+		return nil, nil, RiverError(Err_BAD_CONFIG, "ArchiveId must be set")
+	}
+
+	riverChain, err := crypto.NewBlockchain(
+		ctx,
+		&cfg.RiverChain,
+		nil,
+		infra.NewMetricsFactory(nil, "river", "cmdline"),
+		nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	registryContract, err := registries.NewRiverRegistryContract(
+		ctx,
+		riverChain,
+		&cfg.RegistryContract,
+		&cfg.RiverRegistry,
+	)
+	if err != nil {
+		riverChain.Close()
+		return nil, nil, err
+	}
+
+	chainConfig, err := crypto.NewOnChainConfig(
+		ctx, riverChain.Client, registryContract.Address, riverChain.InitialBlockNum, riverChain.ChainMonitor)
+	if err != nil {
+		riverChain.Close()
+		return nil, nil, err
+	}
+
+	pool, err := storage.CreateAndValidatePgxPool(
+		ctx,
+		&cfg.Database,
+		storage.DbSchemaNameForArchive(cfg.Archive.ArchiveId),
+		nil,
+	)
+	if err != nil {
+		riverChain.Close()
+		return nil, nil, err
+	}
+
+	store, err := storage.NewPostgresStreamStore(
+		ctx,
+		pool,
+		uuid.NewString(),
+		make(chan error, 1),
+		infra.NewMetricsFactory(nil, "river", "cmdline"),
+		chainConfig,
+		&cfg.ExternalMediaStreamStorage,
+		cfg.TrimmingBatchSize,
+	)
+	if err != nil {
+		pool.Pool.Close()
+		riverChain.Close()
+		return nil, nil, err
+	}
+
+	return store, func() {
+		store.Close(ctx)
+		riverChain.Close()
+	}, nil
+}
+
+// openArchiveReader opens the stream store of the archive node that is configured in cfg for reading.
+// Unlike openArchiveStore it doesn't take ownership of the archive database schema, it is safe to
+// use while the archive node is running.
+func openArchiveReader(ctx context.Context, cfg *config.Config) (*storage.PostgresSnapshotReader, error) {
+	if cfg.Archive.ArchiveId == "" {
+		return nil, RiverError(Err_BAD_CONFIG, "ArchiveId must be set")
+	}
+
+	pool, err := storage.CreateAndValidatePgxPool(
+		ctx,
+		&cfg.Database,
+		storage.DbSchemaNameForArchive(cfg.Archive.ArchiveId),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := storage.NewPostgresSnapshotReader(ctx, pool)
+	if err != nil {
+		pool.Pool.Close()
+		return nil, err
+	}
+	return reader, nil
+}
+
+func runArchiveExportCmd(cmd *cobra.Command, cfg *config.Config, args []string) error {
+	ctx := cmd.Context()
+
+	spaceFlag, err := cmd.Flags().GetString("space")
+	if err != nil {
+		return err
+	}
+
+	var streamIds []StreamId
+	for _, arg := range args[1:] {
+		streamId, err := StreamIdFromString(arg)
+		if err != nil {
+			return err
+		}
+		streamIds = append(streamIds, streamId)
+	}
+
+	if spaceFlag == "" && len(streamIds) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "No streams to export, pass stream ids or --space")
+	}
+
+	store, err := openArchiveReader(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	pageSize := int64(cfg.Archive.GetReadMiniblocksSize())
+
+	if spaceFlag != "" {
+		spaceId, err := StreamIdFromString(spaceFlag)
+		if err != nil {
+			return err
+		}
+		channels, err := streamexport.SpaceChannels(ctx, store, spaceId, pageSize)
+		if err != nil {
+			return err
+		}
+		streamIds = append(streamIds, spaceId)
+		streamIds = append(streamIds, channels...)
+	}
+
+	out, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	w := streamexport.NewWriter(out)
+	for _, streamId := range streamIds {
+		if err := streamexport.ExportStream(ctx, store, w, streamId, pageSize); err != nil {
+			return err
+		}
+		fmt.Printf("Exported %s\n", streamId)
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return out.Close()
+}
+
+func runArchiveImportCmd(cmd *cobra.Command, cfg *config.Config, args []string) error {
+	ctx := cmd.Context()
+
+	in, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	store, closeStore, err := openArchiveStore(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeStore()
+
+	r := streamexport.NewReader(in)
+	for {
+		stream, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		imported, err := streamexport.ImportStream(
+			ctx, store, stream, int(cfg.Archive.GetReadMiniblocksSize()))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Imported %s: %d miniblocks\n", stream.StreamId, imported)
+	}
+}
+
 func init() {
 	cmdArch := &cobra.Command{
 		Use:   "archive",
@@ -40,5 +248,34 @@ func init() {
 
 	cmdArch.Flags().Bool("once", false, "Run the archiver once and exit")
 
+	cmdArchExport := &cobra.Command{
+		Use:   "export <output-file> [stream-id...]",
+		Short: "Export archived streams to a portable file",
+		Long: `Export the archived miniblocks of the given streams to a self-describing file that
+can be verified offline with "stream validate --file" and imported with "archive import".
+With --space the space stream and all its channels are exported.
+The archive database is only read, the archive node can keep running.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchiveExportCmd(cmd, cmdConfig, args)
+		},
+	}
+	cmdArchExport.Flags().String("space", "", "Export the space stream and all its channels")
+
+	cmdArchImport := &cobra.Command{
+		Use:   "import <input-file>",
+		Short: "Import streams from an export file into the archive",
+		Long: `Verify and import streams from a file created with "archive export".
+Miniblocks that are already archived are skipped.
+The archive node must be stopped, the command takes ownership of the archive database schema.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runArchiveImportCmd(cmd, cmdConfig, args)
+		},
+	}
+
+	cmdArch.AddCommand(cmdArchExport)
+	cmdArch.AddCommand(cmdArchImport)
+
 	rootCmd.AddCommand(cmdArch)
 }
@@ -177,8 +177,8 @@ func (cc *cmdContext) getHttpClient() (*http.Client, error) {
 		return nil, err
 	}
 
-	cc.httpClient = httpClient
-	return httpClient, nil
+	cc.httpClient = streamHttpClient(httpClient)
+	return cc.httpClient, nil
 }
 
 func (cc *cmdContext) getStubForStream(
@@ -222,6 +222,7 @@ func (cc *cmdContext) getStream(streamId StreamId, stub StreamServiceClient) (*S
 	})
 	request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 	request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
+	request.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 	response, err := stub.GetStream(cc.ctx, request)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/events/migrations"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// snapshotMigrationStreamTypes are the stream types that are sampled when no streams are given.
+var snapshotMigrationStreamTypes = []byte{
+	STREAM_SPACE_BIN,
+	STREAM_CHANNEL_BIN,
+	STREAM_DM_CHANNEL_BIN,
+	STREAM_GDM_CHANNEL_BIN,
+	STREAM_USER_BIN,
+	STREAM_USER_INBOX_BIN,
+	STREAM_USER_SETTINGS_BIN,
+	STREAM_USER_METADATA_KEY_BIN,
+	STREAM_MEDIA_BIN,
+	STREAM_METADATA_BIN,
+}
+
+type snapshotMigrationStats struct {
+	checked int
+	failed  int
+	skipped int
+}
+
+func runSnapshotMigrationsDryRunCmd(cmd *cobra.Command, cfg *config.Config, args []string) error {
+	ctx := cmd.Context()
+
+	node, err := cmd.Flags().GetString("node")
+	if err != nil {
+		return err
+	}
+	sample, err := cmd.Flags().GetInt("sample")
+	if err != nil {
+		return err
+	}
+	verbose, err := cmd.Flags().GetBool("verbose")
+	if err != nil {
+		return err
+	}
+
+	var schema string
+	switch {
+	case node != "":
+		if !common.IsHexAddress(node) {
+			return RiverError(Err_INVALID_ARGUMENT, "Invalid node address", "arg", node)
+		}
+		schema = storage.DbSchemaNameFromAddress(common.HexToAddress(node).Hex())
+	case cfg.Archive.ArchiveId != "":
+		schema = storage.DbSchemaNameForArchive(cfg.Archive.ArchiveId)
+	default:
+		return RiverError(Err_INVALID_ARGUMENT, "Either --node or Archive.ArchiveId must be set")
+	}
+
+	pool, err := storage.CreateAndValidatePgxPool(ctx, &cfg.Database, schema, nil)
+	if err != nil {
+		return err
+	}
+	reader, err := storage.NewPostgresSnapshotReader(ctx, pool)
+	if err != nil {
+		pool.Pool.Close()
+		return err
+	}
+	defer reader.Close()
+
+	var streamIds []StreamId
+	for _, arg := range args {
+		streamId, err := StreamIdFromString(arg)
+		if err != nil {
+			return err
+		}
+		streamIds = append(streamIds, streamId)
+	}
+	if len(streamIds) == 0 {
+		for _, streamType := range snapshotMigrationStreamTypes {
+			sampled, err := reader.SampleStreams(ctx, streamType, sample)
+			if err != nil {
+				return err
+			}
+			streamIds = append(streamIds, sampled...)
+		}
+	}
+
+	fmt.Printf("Schema %s, current snapshot version %d, checking %d streams\n",
+		schema, migrations.CurrentSnapshotVersion(), len(streamIds))
+
+	stats := make(map[byte]*snapshotMigrationStats)
+	failed := 0
+	for _, streamId := range streamIds {
+		st := stats[streamId.Type()]
+		if st == nil {
+			st = &snapshotMigrationStats{}
+			stats[streamId.Type()] = st
+		}
+
+		mbDescriptor, err := reader.ReadLatestSnapshotMiniblock(ctx, streamId)
+		if IsRiverErrorCode(err, Err_UNAVAILABLE) {
+			fmt.Printf("%s: SKIPPED (%v)\n", streamId, err)
+			st.skipped++
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		st.checked++
+
+		// parsing verifies that the stored snapshot matches the hash in the miniblock header
+		mb, err := events.NewMiniblockInfoFromDescriptor(mbDescriptor)
+		if err != nil {
+			fmt.Printf("%s: FAILED miniblock %d can't be parsed: %v\n", streamId, mbDescriptor.Number, err)
+			st.failed++
+			failed++
+			continue
+		}
+
+		check, err := events.CheckSnapshotMigrations(streamId, mb)
+		if err != nil {
+			fmt.Printf("%s: FAILED %v\n", streamId, err)
+			st.failed++
+			failed++
+			continue
+		}
+
+		status := "OK"
+		if check.Failed() {
+			status = "FAILED"
+			st.failed++
+			failed++
+		}
+		fmt.Printf("%s: %s miniblock %d, version %d\n", streamId, status, check.MiniblockNum, check.FromVersion)
+
+		for _, step := range check.Steps {
+			if step.Err != nil {
+				fmt.Printf("  migration %d failed: %v\n", step.Version, step.Err)
+				continue
+			}
+			if !verbose && !check.Failed() {
+				continue
+			}
+			fmt.Printf("  migration %d: %d changed fields\n", step.Version, len(step.Changes))
+			for _, change := range step.Changes {
+				fmt.Printf("  %s\n", change.String())
+			}
+		}
+		for _, violation := range check.Violations {
+			fmt.Printf("  violation: %s\n", violation)
+		}
+	}
+
+	fmt.Printf("\n")
+	for _, streamType := range snapshotMigrationStreamTypes {
+		if st := stats[streamType]; st != nil {
+			fmt.Printf("%s: %d checked, %d failed, %d skipped\n",
+				StreamTypeToString(streamType), st.checked, st.failed, st.skipped)
+		}
+	}
+
+	if failed > 0 {
+		return RiverError(Err_INTERNAL, "Snapshot migration check failed", "failedStreams", failed)
+	}
+	return nil
+}
+
+func init() {
+	cmdSnapshotMigrations := &cobra.Command{
+		Use:   "snapshot-migrations",
+		Short: "Snapshot schema migration tools",
+	}
+
+	cmdSnapshotMigrationsDryRun := &cobra.Command{
+		Use:   "dry-run [stream-id...]",
+		Short: "Apply pending snapshot migrations in memory to snapshots from a node database",
+		Long: `Read the latest snapshots of the given streams from the database of a stream node and apply
+the pending snapshot migrations in memory. Failures, the fields changed by each migration and violated
+invariants are reported. Without stream ids a random sample of each stream type is checked.
+The database is only read, the command is safe to run against the database of a running node.
+Without --node the archive database of the configured Archive.ArchiveId is read.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSnapshotMigrationsDryRunCmd(cmd, cmdConfig, args)
+		},
+	}
+	cmdSnapshotMigrationsDryRun.Flags().String("node", "", "Address of the node whose database is read")
+	cmdSnapshotMigrationsDryRun.Flags().Int("sample", 100, "Number of streams to check per stream type")
+	cmdSnapshotMigrationsDryRun.Flags().Bool("verbose", false, "Print the changed fields of passing streams")
+
+	cmdSnapshotMigrations.AddCommand(cmdSnapshotMigrationsDryRun)
+
+	rootCmd.AddCommand(cmdSnapshotMigrations)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 
+	"github.com/towns-protocol/towns/core/blockchain"
 	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/contracts/river"
 	. "github.com/towns-protocol/towns/core/node/base"
@@ -59,9 +60,58 @@ func printOnChainConfig(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	fmt.Printf("Config:\n%s\n", string(yaml))
+
+	return printPendingOnChainConfigChanges(config)
+}
+
+// printPendingOnChainConfigChanges prints the settings that become active after the current block.
+func printPendingOnChainConfigChanges(config crypto.OnChainConfiguration) error {
+	all := config.All()
+	pending := false
+	for i := 1; i < len(all); i++ {
+		if all[i].FromBlockNumber <= config.ActiveBlock() {
+			continue
+		}
+		if !pending {
+			fmt.Println("Pending changes:")
+			pending = true
+		}
+		changes, err := crypto.DiffOnChainSettings(all[i-1], all[i])
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Block %d:\n", all[i].FromBlockNumber)
+		printOnChainSettingChanges(changes)
+	}
+	if !pending {
+		fmt.Println("No pending changes")
+	}
 	return nil
 }
 
+func printOnChainSettingChanges(changes []crypto.OnChainSettingChange) {
+	if len(changes) == 0 {
+		fmt.Println("  no changes")
+	}
+	for _, c := range changes {
+		fmt.Printf("  %s: %s -> %s\n", c.Key, formatSettingValue(c.Old), formatSettingValue(c.New))
+	}
+}
+
+// formatSettingValue formats a setting value as single line YAML.
+func formatSettingValue(v any) string {
+	var node yaml.Node
+	if err := node.Encode(v); err != nil {
+		return fmt.Sprint(v)
+	}
+	node.Style = yaml.FlowStyle
+	out, err := yaml.Marshal(&node)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return strings.TrimSpace(string(out))
+}
+
 func getOnChainConfig(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
 	cfg := cmdConfig
@@ -241,6 +291,240 @@ func parseSetArgs(args []string, force bool) (setArgs, error) {
 	}, nil
 }
 
+// readSetArgsFromCSV reads settings from a CSV file with lines in the format key,blockNumber,value,[abi_type].
+func readSetArgsFromCSV(file string, force bool) ([]setArgs, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	var setArgsList []setArgs
+	for i, record := range records {
+		sa, err := parseSetArgs(record, force)
+		if err != nil {
+			return nil, AsRiverError(err).Tag("line", i+1)
+		}
+		setArgsList = append(setArgsList, sa)
+	}
+	return setArgsList, nil
+}
+
+// validateSetArgs checks that the settings decode into the on-chain settings schema.
+// Unknown keys, values that don't decode into the setting type and duplicate settings are rejected.
+func validateSetArgs(ctx context.Context, args []setArgs) error {
+	byBlock := make(map[uint64]map[string][]byte)
+	for _, arg := range args {
+		values, ok := byBlock[arg.blockNum]
+		if !ok {
+			values = make(map[string][]byte)
+			byBlock[arg.blockNum] = values
+		}
+		key := strings.ToLower(arg.key)
+		if _, ok := values[key]; ok {
+			return RiverError(Err_INVALID_ARGUMENT, "duplicate setting", "key", arg.key, "block", arg.blockNum)
+		}
+		values[key] = arg.value
+	}
+
+	for blockNum, values := range byBlock {
+		if _, err := crypto.DecodeOnChainSettings(ctx, crypto.DefaultOnChainSettings(), values); err != nil {
+			return AsRiverError(err).Tag("block", blockNum)
+		}
+	}
+	return nil
+}
+
+// activationBlocks returns the sorted, unique block numbers on which the given settings become active.
+func activationBlocks(args []setArgs) []blockchain.BlockNumber {
+	var blockNums []blockchain.BlockNumber
+	for _, arg := range args {
+		blockNums = append(blockNums, blockchain.BlockNumber(arg.blockNum))
+	}
+	slices.Sort(blockNums)
+	return slices.Compact(blockNums)
+}
+
+// loadProposedOnChainConfig loads the on-chain configuration as it is currently stored in the registry contract
+// and the configuration as nodes would load it after the proposed settings are submitted.
+func loadProposedOnChainConfig(
+	ctx context.Context,
+	cfg *config.Config,
+	args []setArgs,
+) (current crypto.OnChainConfiguration, proposed crypto.OnChainConfiguration, err error) {
+	bc, err := crypto.NewBlockchain(
+		ctx,
+		&cfg.RiverChain,
+		nil,
+		infra.NewMetricsFactory(nil, "river", "cmdline"),
+		nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caller, err := river.NewRiverConfigV1Caller(cfg.RegistryContract.Address, bc.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	settings, err := caller.GetAllConfiguration(&bind.CallOpts{
+		Context:     ctx,
+		BlockNumber: bc.InitialBlockNum.AsBigInt(),
+	})
+	if err != nil {
+		return nil, nil, AsRiverError(err, Err_CANNOT_CALL_CONTRACT).
+			Message("Failed to retrieve on-chain configuration")
+	}
+
+	current, err = crypto.NewOnChainConfigFromSettings(ctx, settings, bc.InitialBlockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Proposed settings are added after the current settings, so they overwrite current settings
+	// with the same key and block number in the same way as when they are submitted.
+	proposedSettings := slices.Clone(settings)
+	for _, arg := range args {
+		proposedSettings = append(proposedSettings, river.Setting{
+			Key:         crypto.HashSettingName(arg.key),
+			BlockNumber: arg.blockNum,
+			Value:       arg.value,
+		})
+	}
+	proposed, err = crypto.NewOnChainConfigFromSettings(ctx, proposedSettings, bc.InitialBlockNum)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return current, proposed, nil
+}
+
+// readProposedSetArgs reads and validates proposed settings from the CSV file given in args.
+func readProposedSetArgs(ctx context.Context, args []string) ([]setArgs, error) {
+	setArgsList, err := readSetArgsFromCSV(args[0], false)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSetArgs(ctx, setArgsList); err != nil {
+		return nil, err
+	}
+	return setArgsList, nil
+}
+
+func validateOnChainConfig(cmd *cobra.Command, args []string) error {
+	setArgsList, err := readProposedSetArgs(cmd.Context(), args)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%d settings are valid\n", len(setArgsList))
+	return nil
+}
+
+func diffOnChainConfig(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	setArgsList, err := readProposedSetArgs(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	current, proposed, err := loadProposedOnChainConfig(ctx, cmdConfig, setArgsList)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Current block: %d\n", current.ActiveBlock())
+	for _, blockNum := range activationBlocks(setArgsList) {
+		changes, err := crypto.DiffOnChainSettings(current.GetOnBlock(blockNum), proposed.GetOnBlock(blockNum))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Block %d:\n", blockNum)
+		if blockNum <= current.ActiveBlock() {
+			fmt.Println("  WARNING: block is not in the future, changes become active immediately")
+		}
+		printOnChainSettingChanges(changes)
+	}
+	return nil
+}
+
+// unappliedSetArgs loads the given settings through the proposed configuration in the same way as a node and
+// returns an error for every setting that doesn't decode or that is not in effect on its activation block.
+func unappliedSetArgs(ctx context.Context, proposed crypto.OnChainConfiguration, args []setArgs) []error {
+	var errs []error
+	for _, arg := range args {
+		effective := proposed.GetOnBlock(blockchain.BlockNumber(arg.blockNum))
+		expected, err := crypto.DecodeOnChainSettings(ctx, effective, map[string][]byte{arg.key: arg.value})
+		if err != nil {
+			errs = append(errs, AsRiverError(err).Tag("key", arg.key).Tag("block", arg.blockNum))
+			continue
+		}
+		changes, err := crypto.DiffOnChainSettings(effective, expected)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, c := range changes {
+			errs = append(errs, RiverError(
+				Err_INVALID_ARGUMENT,
+				"setting is not in effect",
+				"key", c.Key,
+				"block", arg.blockNum,
+				"effective", formatSettingValue(c.Old),
+				"proposed", formatSettingValue(c.New),
+			))
+		}
+	}
+	return errs
+}
+
+func simulateOnChainConfig(cmd *cobra.Command, args []string) error {
+	ctx := cmd.Context()
+
+	setArgsList, err := readSetArgsFromCSV(args[0], false)
+	if err != nil {
+		return err
+	}
+
+	var failures []error
+	if err := validateSetArgs(ctx, setArgsList); err != nil {
+		failures = append(failures, err)
+	}
+
+	current, proposed, err := loadProposedOnChainConfig(ctx, cmdConfig, setArgsList)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Current block: %d\n", current.ActiveBlock())
+	for _, blockNum := range activationBlocks(setArgsList) {
+		yaml, err := yaml.Marshal(proposed.GetOnBlock(blockNum))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Effective config on block %d:\n%s\n", blockNum, string(yaml))
+	}
+
+	failures = append(failures, unappliedSetArgs(ctx, proposed, setArgsList)...)
+	if len(failures) == 0 {
+		fmt.Printf("All %d settings are in effect\n", len(setArgsList))
+		return nil
+	}
+	fmt.Println("Validation failures:")
+	for _, err := range failures {
+		fmt.Printf("  %s\n", err)
+	}
+	return RiverError(Err_INVALID_ARGUMENT, "proposed settings are not in effect", "failures", len(failures))
+}
+
 func submitConfig(ctx context.Context, cfg *config.Config, args []setArgs) error {
 	wallet, err := crypto.NewWalletFromEnv(ctx, "PRIVATE_KEY")
 	if err != nil {
@@ -324,6 +608,12 @@ func setOnChainConfig(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if !force {
+		if err := validateSetArgs(ctx, []setArgs{sa}); err != nil {
+			return err
+		}
+	}
+
 	dryRun, err := cmd.Flags().GetBool("dry-run")
 	if err != nil {
 		return err
@@ -347,25 +637,15 @@ func setOnChainConfigFromCSV(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	f, err := os.Open(file)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	r := csv.NewReader(f)
-	records, err := r.ReadAll()
+	setArgsList, err := readSetArgsFromCSV(file, force)
 	if err != nil {
 		return err
 	}
 
-	var setArgsList []setArgs
-	for _, record := range records {
-		sa, err := parseSetArgs(record, force)
-		if err != nil {
+	if !force {
+		if err := validateSetArgs(ctx, setArgsList); err != nil {
 			return err
 		}
-		setArgsList = append(setArgsList, sa)
 	}
 
 	dryRun, err := cmd.Flags().GetBool("dry-run")
@@ -405,7 +685,7 @@ func init() {
 
 	onChainConfigCmd.AddCommand(&cobra.Command{
 		Use:   "print",
-		Short: "Print current on-chain config",
+		Short: "Print current on-chain config and pending changes",
 		RunE:  printOnChainConfig,
 	})
 
@@ -437,6 +717,27 @@ func init() {
 		BoolP("dry-run", "n", false, "Dry run the command without submitting transactions, print hex values")
 	onChainConfigCmd.AddCommand(setCsvCmd)
 
+	onChainConfigCmd.AddCommand(&cobra.Command{
+		Use:   "validate <file>",
+		Short: "Validate on-chain config from CSV file: key,blockNumber,value,[abi_type]",
+		Args:  cobra.ExactArgs(1),
+		RunE:  validateOnChainConfig,
+	})
+
+	onChainConfigCmd.AddCommand(&cobra.Command{
+		Use:   "diff <file>",
+		Short: "Print changes to the current on-chain config from CSV file: key,blockNumber,value,[abi_type]",
+		Args:  cobra.ExactArgs(1),
+		RunE:  diffOnChainConfig,
+	})
+
+	onChainConfigCmd.AddCommand(&cobra.Command{
+		Use:   "simulate <file>",
+		Short: "Load on-chain config with changes from CSV file: key,blockNumber,value,[abi_type] as a node would",
+		Args:  cobra.ExactArgs(1),
+		RunE:  simulateOnChainConfig,
+	})
+
 	onChainConfigCmd.AddCommand(&cobra.Command{
 		Use:   "names",
 		Short: "Print known on-chain config names and types",
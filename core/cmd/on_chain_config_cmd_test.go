@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/contracts/river"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+)
+
+func TestValidateOnChainConfigCSV(t *testing.T) {
+	require := require.New(t)
+	ctx := test.NewTestContext(t)
+
+	file := filepath.Join(t.TempDir(), "config.csv")
+	require.NoError(os.WriteFile(file, []byte(
+		crypto.StreamReplicationFactorConfigKey+",100,3\n"+
+			crypto.StreamCacheExpirationMsConfigKey+",100,5000\n"+
+			crypto.StreamMaxEventsPerMiniblockKey+",200,1000,uint64\n",
+	), 0o600))
+
+	args, err := readSetArgsFromCSV(file, false)
+	require.NoError(err)
+	require.Len(args, 3)
+	require.NoError(validateSetArgs(ctx, args))
+
+	// the same key can't be set twice on the same block
+	args = append(args, args[0])
+	require.ErrorContains(validateSetArgs(ctx, args), "duplicate setting")
+
+	// unknown keys that are accepted with --force are rejected by validation
+	args, err = readSetArgsFromCSV(file, true)
+	require.NoError(err)
+	args = append(args, setArgs{key: "stream.maxeventsperminiblok", blockNum: 200, value: crypto.ABIEncodeUint64(1)})
+	require.ErrorContains(validateSetArgs(ctx, args), "key is not known")
+}
+
+func TestUnappliedSetArgs(t *testing.T) {
+	require := require.New(t)
+	ctx := test.NewTestContext(t)
+
+	args := []setArgs{
+		{key: crypto.StreamReplicationFactorConfigKey, blockNum: 100, value: crypto.ABIEncodeUint64(3)},
+		{key: crypto.StreamCacheExpirationMsConfigKey, blockNum: 200, value: crypto.ABIEncodeUint64(5000)},
+	}
+	var settings []river.Setting
+	for _, arg := range args {
+		settings = append(settings, river.Setting{
+			Key:         crypto.HashSettingName(arg.key),
+			BlockNumber: arg.blockNum,
+			Value:       arg.value,
+		})
+	}
+
+	proposed, err := crypto.NewOnChainConfigFromSettings(ctx, settings, 50)
+	require.NoError(err)
+	require.Empty(unappliedSetArgs(ctx, proposed, args))
+
+	// the node ignores values it can't decode
+	invalid := setArgs{key: crypto.StreamMaxEventsPerMiniblockKey, blockNum: 200, value: []byte{1, 2, 3}}
+	errs := unappliedSetArgs(ctx, proposed, append(args, invalid))
+	require.Len(errs, 1)
+	require.ErrorContains(errs[0], "failed to decode setting")
+
+	// a later setting with the same key and block overwrites the earlier one
+	settings = append(settings, river.Setting{
+		Key:         crypto.HashSettingName(crypto.StreamReplicationFactorConfigKey),
+		BlockNumber: 100,
+		Value:       crypto.ABIEncodeUint64(5),
+	})
+	proposed, err = crypto.NewOnChainConfigFromSettings(ctx, settings, 50)
+	require.NoError(err)
+	errs = unappliedSetArgs(ctx, proposed, args)
+	require.Len(errs, 1)
+	require.ErrorContains(errs[0], "setting is not in effect")
+}
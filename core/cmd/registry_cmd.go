@@ -231,6 +231,7 @@ func validateStream(
 	})
 	request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 	request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
+	request.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 	response, err := streamServiceClient.GetStream(ctx, request)
 	if err != nil {
 		return err
@@ -367,6 +368,7 @@ func srStream(
 	if err != nil {
 		return err
 	}
+	httpClient = streamHttpClient(httpClient)
 
 	blockchain, err := crypto.NewBlockchain(
 		ctx,
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/towns-protocol/towns/core/node/rpc"
+)
+
+func runSearchService(cmd *cobra.Command, args []string) error {
+	err := setupProfiler("search-node", cmdConfig)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background() // lint:ignore context.Background() is fine here
+	return rpc.RunSearchService(ctx, cmdConfig)
+}
+
+func init() {
+	cmdRunSearchService := &cobra.Command{
+		Use:   "search",
+		Short: "Runs the search service",
+		RunE:  runSearchService,
+	}
+
+	rootCmd.AddCommand(cmdRunSearchService)
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/towns-protocol/towns/core/contracts/river"
 	"github.com/towns-protocol/towns/core/node/http_client"
 	"github.com/towns-protocol/towns/core/node/rpc/headers"
+	"github.com/towns-protocol/towns/core/node/rpc/node2nodeauth"
+	"github.com/towns-protocol/towns/core/xchain/util"
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -50,8 +52,37 @@ import (
 	"github.com/towns-protocol/towns/core/node/registries"
 	. "github.com/towns-protocol/towns/core/node/shared"
 	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/streamexport"
 )
 
+// fullMiniblocksRequest returns a request for miniblocks that include expired messages,
+// miniblocks without them can't be verified against their headers.
+func fullMiniblocksRequest[T any](msg *T) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
+	return req
+}
+
+var (
+	fullMiniblocksSignerOnce sync.Once
+	fullMiniblocksSigner     *node2nodeauth.FullMiniblocksSigner
+)
+
+// streamHttpClient returns base that signs full miniblocks requests with the wallet from WALLETPRIVATEKEY,
+// PRIVATE_KEY or the default wallet file. Nodes only return full miniblocks when the wallet belongs to a node
+// or is listed in the node.serviceaddresses setting, otherwise expired messages are removed.
+func streamHttpClient(base *http.Client) *http.Client {
+	fullMiniblocksSignerOnce.Do(func() {
+		if wallet, err := util.LoadWallet(context.Background()); err == nil {
+			fullMiniblocksSigner = node2nodeauth.NewFullMiniblocksSigner(wallet)
+		}
+	})
+	if fullMiniblocksSigner == nil {
+		return base
+	}
+	return node2nodeauth.WithFullMiniblocksSigner(base, fullMiniblocksSigner)
+}
+
 func getStreamFromNode(
 	ctx context.Context,
 	registryContract registries.RiverRegistryContract,
@@ -63,7 +94,7 @@ func getStreamFromNode(
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
 
 	request := connect.NewRequest(&protocol.GetStreamRequest{
 		StreamId: streamID[:],
@@ -71,6 +102,7 @@ func getStreamFromNode(
 	})
 	request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 	request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
+	request.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 
 	response, err := remoteClient.GetStream(ctx, request)
 	if err != nil {
@@ -138,9 +170,9 @@ func runStreamGetEventCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
 
-	response, err := remoteClient.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+	response, err := remoteClient.GetStream(ctx, fullMiniblocksRequest(&protocol.GetStreamRequest{
 		StreamId: streamID[:],
 		Optional: false,
 	}))
@@ -160,7 +192,7 @@ func runStreamGetEventCmd(cmd *cobra.Command, args []string) error {
 	}
 	from := max(to-blockRange, 0)
 
-	miniblocks, err := remoteClient.GetMiniblocks(ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+	miniblocks, err := remoteClient.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 		StreamId:      streamID[:],
 		FromInclusive: from,
 		ToExclusive:   to,
@@ -278,9 +310,9 @@ func runStreamGetMiniblockCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
 
-	response, err := remoteClient.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+	response, err := remoteClient.GetStream(ctx, fullMiniblocksRequest(&protocol.GetStreamRequest{
 		StreamId: streamID[:],
 		Optional: false,
 	}))
@@ -303,7 +335,7 @@ func runStreamGetMiniblockCmd(cmd *cobra.Command, args []string) error {
 	var miniblocks []*protocol.Miniblock
 	for currentFrom := from; currentFrom < to; currentFrom += 100 {
 		currentTo := min(currentFrom+100, to)
-		resp, err := remoteClient.GetMiniblocks(ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+		resp, err := remoteClient.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 			StreamId:      streamID[:],
 			FromInclusive: currentFrom,
 			ToExclusive:   currentTo,
@@ -498,8 +530,8 @@ func runStreamGetMiniblockNumCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
-	miniblocks, err := remoteClient.GetMiniblocks(ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
+	miniblocks, err := remoteClient.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 		StreamId:      streamID[:],
 		FromInclusive: miniblockNum,
 		ToExclusive:   miniblockNum + rangeCount,
@@ -576,9 +608,9 @@ func runStreamDumpCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
 
-	response, err := remoteClient.GetStream(ctx, connect.NewRequest(&protocol.GetStreamRequest{
+	response, err := remoteClient.GetStream(ctx, fullMiniblocksRequest(&protocol.GetStreamRequest{
 		StreamId: streamID[:],
 		Optional: false,
 	}))
@@ -599,7 +631,7 @@ func runStreamDumpCmd(cmd *cobra.Command, args []string) error {
 	from := max(maxBlock-blockRange, 0)
 	to := maxBlock
 	for {
-		miniblocks, err := remoteClient.GetMiniblocks(ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+		miniblocks, err := remoteClient.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 			StreamId:      streamID[:],
 			FromInclusive: from,
 			ToExclusive:   to,
@@ -669,7 +701,7 @@ func runStreamNodeDumpCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remoteClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, remote.Url)
+	remoteClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), remote.Url)
 
 	blockRange := int64(100)
 	if len(args) == 3 {
@@ -683,7 +715,7 @@ func runStreamNodeDumpCmd(cmd *cobra.Command, args []string) error {
 	from := int64(0)
 	to := blockRange
 	for blocksRead != 0 {
-		miniblocks, err := remoteClient.GetMiniblocks(ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+		miniblocks, err := remoteClient.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 			StreamId:      streamId[:],
 			FromInclusive: from,
 			ToExclusive:   to,
@@ -804,6 +836,17 @@ func runStreamUserCmd(cmd *cobra.Command, args []string) error {
 }
 
 func runStreamValidateCmd(cmd *cobra.Command, args []string) error {
+	file, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return err
+	}
+	if file != "" {
+		return runStreamValidateFileCmd(file, args)
+	}
+	if len(args) != 1 {
+		return RiverError(protocol.Err_INVALID_ARGUMENT, "stream-id is required when not validating a file")
+	}
+
 	cc, ctxCancel, err := newCmdContext(cmd, cmdConfig)
 	if err != nil {
 		return err
@@ -838,7 +881,7 @@ func runStreamValidateCmd(cmd *cobra.Command, args []string) error {
 				fromInclusive = 0
 			}
 
-			resp, err := stub.GetMiniblocks(cc.ctx, connect.NewRequest(&protocol.GetMiniblocksRequest{
+			resp, err := stub.GetMiniblocks(cc.ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
 				StreamId:      streamId[:],
 				FromInclusive: fromInclusive,
 				ToExclusive:   toExclusive,
@@ -882,6 +925,165 @@ func runStreamValidateCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// exportValidatePageSize is the number of miniblocks that are read at once from an export file.
+const exportValidatePageSize = 100
+
+// runStreamValidateFileCmd validates the streams in an export file created with "archive export".
+// If a stream id is given only that stream is validated.
+func runStreamValidateFileCmd(file string, args []string) error {
+	var onlyStream *StreamId
+	if len(args) == 1 {
+		streamId, err := StreamIdFromString(args[0])
+		if err != nil {
+			return err
+		}
+		onlyStream = &streamId
+	}
+
+	in, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	r := streamexport.NewReader(in)
+	validated := 0
+	for {
+		stream, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if onlyStream != nil && *onlyStream != stream.StreamId {
+			continue
+		}
+
+		si := &streamInfo{}
+		if err := si.initFromExport(stream, exportValidatePageSize); err != nil {
+			return AsRiverError(err).Tag("streamId", stream.StreamId)
+		}
+
+		// events in trimmed streams can reference miniblocks that are not part of the export
+		if stream.FromInclusive == 0 {
+			if err := si.validateEventMbRefs(); err != nil {
+				return AsRiverError(err).Tag("streamId", stream.StreamId)
+			}
+		}
+
+		fmt.Printf("%s: OK (%d miniblocks, snapshots at %v)\n",
+			stream.StreamId, len(si.mbsByNum), stream.SnapshotNums)
+		validated++
+	}
+
+	if onlyStream != nil && validated == 0 {
+		return RiverError(protocol.Err_NOT_FOUND, "Stream not found in export file", "streamId", *onlyStream)
+	}
+
+	fmt.Printf("OK\n")
+
+	return nil
+}
+
+// runStreamSnapshotCmd reconstructs the snapshot of a stream as of a miniblock by replaying events from
+// the nearest earlier snapshot. With a second miniblock number the changes between both points are printed,
+// with "verify" the snapshot stored in the miniblock is compared against the replay.
+func runStreamSnapshotCmd(cmd *cobra.Command, args []string) error {
+	cc, ctxCancel, err := newCmdContext(cmd, cmdConfig)
+	if err != nil {
+		return err
+	}
+	defer ctxCancel()
+
+	streamId, err := StreamIdFromString(args[0])
+	if err != nil {
+		return err
+	}
+	miniblockNum, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return err
+	}
+
+	stub, _, _, err := cc.getStubForStream(streamId, cc.nodeAddress)
+	if err != nil {
+		return err
+	}
+
+	read := func(ctx context.Context, fromInclusive int64, toExclusive int64) ([]*events.MiniblockInfo, error) {
+		if toExclusive-fromInclusive > int64(cc.pageSize) {
+			toExclusive = fromInclusive + int64(cc.pageSize)
+		}
+		resp, err := stub.GetMiniblocks(ctx, fullMiniblocksRequest(&protocol.GetMiniblocksRequest{
+			StreamId:      streamId[:],
+			FromInclusive: fromInclusive,
+			ToExclusive:   toExclusive,
+			OmitSnapshots: false,
+		}))
+		if err != nil {
+			return nil, err
+		}
+		miniblocks := make([]*events.MiniblockInfo, len(resp.Msg.GetMiniblocks()))
+		for i, mb := range resp.Msg.GetMiniblocks() {
+			num := resp.Msg.GetFromInclusive() + int64(i)
+			if miniblocks[i], err = events.NewMiniblockInfoFromProto(
+				mb,
+				resp.Msg.GetMiniblockSnapshot(num),
+				events.NewParsedMiniblockInfoOpts().WithExpectedBlockNumber(num),
+			); err != nil {
+				return nil, err
+			}
+		}
+		return miniblocks, nil
+	}
+
+	if len(args) == 2 {
+		replay, err := events.ReplaySnapshotAt(cc.ctx, read, miniblockNum)
+		if err != nil {
+			return err
+		}
+		for _, err := range replay.UpdateErrors {
+			fmt.Printf("Skipped event: %v\n", err)
+		}
+		fmt.Printf("Replayed from snapshot in miniblock %d\n", replay.BaseMiniblockNum)
+		fmt.Println(protojson.Format(replay.Snapshot))
+		return nil
+	}
+
+	var changes []events.SnapshotFieldChange
+	if args[2] == "verify" {
+		if _, changes, err = events.VerifySnapshotAt(cc.ctx, read, miniblockNum); err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			fmt.Printf("OK\n")
+			return nil
+		}
+	} else {
+		otherNum, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			return err
+		}
+		from, err := events.ReplaySnapshotAt(cc.ctx, read, miniblockNum)
+		if err != nil {
+			return err
+		}
+		to, err := events.ReplaySnapshotAt(cc.ctx, read, otherNum)
+		if err != nil {
+			return err
+		}
+		if changes, err = events.DiffSnapshots(from.Snapshot, to.Snapshot); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		fmt.Println(change.String())
+	}
+
+	return nil
+}
+
 func runStreamCompareMiniblockChainCmd(ctx context.Context, cfg *config.Config, args []string) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
 	defer cancel()
@@ -978,12 +1180,13 @@ func runStreamCompareMiniblockChainCmd(ctx context.Context, cfg *config.Config,
 
 		node := allNodes[index]
 
-		streamServiceClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, node.Url)
+		streamServiceClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), node.Url)
 		request := connect.NewRequest(
 			&protocol.GetMiniblocksRequest{StreamId: streamId[:], FromInclusive: num, ToExclusive: num + 1},
 		)
 		request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 		request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
+		request.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 
 		response, err := streamServiceClient.GetMiniblocks(ctx, request)
 		if err != nil {
@@ -1061,7 +1264,7 @@ func runStreamCompareMiniblockChainCmd(ctx context.Context, cfg *config.Config,
 
 		chain := make(map[int64]common.Hash)
 		node := allNodes[index]
-		streamServiceClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, node.Url)
+		streamServiceClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), node.Url)
 		request := connect.NewRequest(&protocol.GetMiniblocksRequest{
 			StreamId:      streamId[:],
 			FromInclusive: fromInclusive,
@@ -1070,6 +1273,7 @@ func runStreamCompareMiniblockChainCmd(ctx context.Context, cfg *config.Config,
 		})
 		request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 		request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
+		request.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 
 		response, err := streamServiceClient.GetMiniblocks(ctx, request)
 		if err != nil {
@@ -1105,7 +1309,7 @@ func runStreamCompareMiniblockChainCmd(ctx context.Context, cfg *config.Config,
 			}
 
 			node := allNodes[index]
-			streamServiceClient := protocolconnect.NewStreamServiceClient(http.DefaultClient, node.Url)
+			streamServiceClient := protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), node.Url)
 			request := connect.NewRequest(&protocol.GetLastMiniblockHashRequest{StreamId: streamId[:]})
 			request.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
 			request.Header().Set(headers.RiverAllowNoQuorumHeader, headers.RiverHeaderTrueValue)
@@ -1292,7 +1496,7 @@ func runStreamCheckStateCmd(cmd *cobra.Command, cfg *config.Config, args []strin
 	for _, n := range allNodesResp {
 		clients[n.NodeAddress] = &Client{
 			sem:    semaphore.NewWeighted(maxConcurrentRequestPerNode),
-			client: protocolconnect.NewStreamServiceClient(http.DefaultClient, n.Url),
+			client: protocolconnect.NewStreamServiceClient(streamHttpClient(http.DefaultClient), n.Url),
 		}
 	}
 
@@ -1801,13 +2005,28 @@ max-block-range is optional and limits the number of blocks to consider (default
 	cmdStreamValidate := &cobra.Command{
 		Use:   "validate <stream-id>",
 		Short: "Validate stream contents",
-		Long:  `Validate stream content by loading all miniblocks and checking for duplicate events.`,
-		Args:  cobra.ExactArgs(1),
-		RunE:  runStreamValidateCmd,
+		Long: `Validate stream content by loading all miniblocks and checking for duplicate events.
+With --file the streams in an export file are validated offline, stream-id is optional in that case.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: runStreamValidateCmd,
 	}
 	cmdStreamValidate.Flags().String("node", "", "Optional node address to fetch stream from")
 	cmdStreamValidate.Flags().Duration("timeout", 30*time.Second, "Timeout for running the command")
 	cmdStreamValidate.Flags().Int("page-size", 1000, "Number of miniblocks to fetch per page")
+	cmdStreamValidate.Flags().String("file", "", "Validate streams from an archive export file")
+
+	cmdStreamSnapshot := &cobra.Command{
+		Use:   "snapshot <stream-id> <miniblock-num> [<other-miniblock-num>|verify]",
+		Short: "Reconstruct the stream snapshot as of a miniblock",
+		Long: `Reconstruct the stream snapshot as of a miniblock by replaying events from the nearest earlier snapshot.
+With a second miniblock number the changes between both snapshots are printed.
+With "verify" the snapshot stored in the miniblock is compared against the replayed snapshot.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: runStreamSnapshotCmd,
+	}
+	cmdStreamSnapshot.Flags().String("node", "", "Optional node address to fetch stream from")
+	cmdStreamSnapshot.Flags().Duration("timeout", 30*time.Second, "Timeout for running the command")
+	cmdStreamSnapshot.Flags().Int("page-size", 1000, "Number of miniblocks to fetch per page")
 
 	cmdStreamCompareMiniblockChain := &cobra.Command{
 		Use:   "compare-miniblock-chain <stream-id>",
@@ -1849,6 +2068,7 @@ max-block-range is optional and limits the number of blocks to consider (default
 	cmdStream.AddCommand(cmdStreamGetPartition)
 	cmdStream.AddCommand(cmdStreamUser)
 	cmdStream.AddCommand(cmdStreamValidate)
+	cmdStream.AddCommand(cmdStreamSnapshot)
 	cmdStream.AddCommand(cmdStreamCompareMiniblockChain)
 	cmdStream.AddCommand(cmdStreamOutOfSync)
 	cmdStream.AddCommand(cmdStreamCheckStreamState)
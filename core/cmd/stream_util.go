@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"errors"
+	"io"
 	"math"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -8,6 +10,7 @@ import (
 	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/events"
 	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/streamexport"
 )
 
 type streamInfo struct {
@@ -123,6 +126,30 @@ func (si *streamInfo) init(stream *StreamAndCookie) error {
 	return nil
 }
 
+// initFromExport initializes the stream info from a stream section of an export file. Miniblocks
+// are read in pages of pageSize miniblocks and verified while they are read.
+func (si *streamInfo) initFromExport(stream *streamexport.ExportedStream, pageSize int) error {
+	si.mbsByNum = make(map[int64]*MiniblockInfo)
+	si.mbsByHash = make(map[common.Hash]*MiniblockInfo)
+	si.eventsByHash = make(map[common.Hash]*eventInfo)
+	si.minKnownMb = math.MaxInt64
+
+	for {
+		_, infos, err := stream.ReadMiniblocks(pageSize)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, mb := range infos {
+			if err := si.addMb(mb); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 func (si *streamInfo) validateEventMbRefs() error {
 	for _, e := range si.eventsByHash {
 		event := e.parsedEvent
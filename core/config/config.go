@@ -176,12 +176,18 @@ type Config struct {
 	// Should be set if the node is running in archive mode.
 	Archive ArchiveConfig
 
+	// Sync configures client stream sync sessions.
+	Sync SyncConfig
+
 	// Notifications must be set when running in notification mode.
 	Notifications NotificationsConfig
 
 	// AppRegistry must be set when running in app registry mode.
 	AppRegistry AppRegistryConfig
 
+	// Search must be set when running in search mode.
+	Search SearchConfig
+
 	// Feature flags
 	// Used to disable functionality for some testing setups.
 
@@ -406,6 +412,29 @@ type ArchiveConfig struct {
 	// allow before considering a stream corrupt.
 	// Please access with GetMaxFailedConsecutiveUpdates
 	MaxFailedConsecutiveUpdates uint32 `json:",omitempty"` // If 0, default to 50.
+
+	// Repair configures the automatic repair of streams that failed a miniblock scrub.
+	Repair ArchiveRepairConfig `json:",omitempty"`
+}
+
+// ArchiveRepairConfig configures the automatic repair of corrupt streams in archive mode.
+// When enabled, the archiver re-fetches the corrupt miniblock range from the stream's replicas,
+// verifies the hash chain, replaces the corrupt miniblocks in storage and re-scrubs the stream.
+type ArchiveRepairConfig struct {
+	// Enabled turns on automatic repair of corrupt streams. Disabled by default.
+	Enabled bool
+
+	// Interval is how often the archiver looks for corrupt streams to repair. If 0, default to 1m.
+	Interval time.Duration `json:",omitempty"`
+
+	// MaxAttempts is the number of times the archiver attempts to repair a stream before giving up
+	// until the next restart. If 0, default to 3.
+	MaxAttempts uint32 `json:",omitempty"`
+
+	// AuditLogSize is the number of most recent repair records loaded from storage on start and kept
+	// for the debug endpoint.
+	// If 0, default to 1000.
+	AuditLogSize int `json:",omitempty"`
 }
 
 // ExternalMediaStreamStorageAWSS3Config defines configuration to store media stream miniblocks
@@ -504,24 +533,175 @@ type WebPushNotificationConfig struct {
 }
 
 type SessionKeyConfig struct {
-	// Algorithm indicates how the session token is signed (only HS256 is supported)
+	// Algorithm indicates how the session token is signed, HS256 (default), ES256 or EdDSA.
 	Algorithm string
-	// Key holds the hex encoded key
+	// Key holds the hex encoded key. For HS256 this is the 32 byte secret, for ES256 and EdDSA
+	// the PKCS#8 DER encoded private key.
 	Key string
+	// PublicKey holds the hex encoded PKIX DER public key for ES256 and EdDSA. It allows services
+	// that only verify session tokens to do so without access to the private key.
+	PublicKey string
 }
 
 type SessionTokenConfig struct {
 	// Lifetime indicates how long a session token is valid (default=30m).
 	Lifetime time.Duration
+	// RefreshTokenLifetime indicates how long a refresh token is valid (default=720h).
+	// Each refresh extends the session with this lifetime.
+	RefreshTokenLifetime time.Duration
 	// Key holds the secret key that is used to sign the session token.
 	Key SessionKeyConfig `json:"-" yaml:"-"` // Omit sensitive field from logging
 }
 
+// SIWEConfig configures Sign-In with Ethereum (EIP-4361) authentication.
+type SIWEConfig struct {
+	// Domain that SIWE messages must be issued for, SIWE is disabled when empty.
+	Domain string
+	// URI that SIWE messages must be issued for. If empty the host of the message URI must match Domain.
+	URI string
+	// ChainID that SIWE messages must be issued for.
+	ChainID uint64
+}
+
 type AuthenticationConfig struct {
 	// ChallengeTimeout is the lifetime an authentication challenge is valid (default=30s).
 	ChallengeTimeout time.Duration
 	// SessionTokenKey contains the configuration for the JWT session token.
 	SessionToken SessionTokenConfig
+	// SmartAccountSignatures enables authentication of smart contract accounts. Their signatures are
+	// verified through ERC-1271, or ERC-6492 for undeployed accounts, against the base chain.
+	SmartAccountSignatures bool
+	// SIWE enables authentication with Sign-In with Ethereum messages.
+	SIWE SIWEConfig
+}
+
+// SyncConfig configures client stream sync sessions.
+type SyncConfig struct {
+	// ResumeGracePeriod is the time a sync session is kept after its client disconnected. Within this period
+	// the client can resume the session and receives the updates that were buffered in the meantime.
+	// Resuming is disabled when 0 (default).
+	ResumeGracePeriod time.Duration
+
+	// ResumeBufferSize is the maximum number of updates buffered for a disconnected client. If more updates
+	// arrive the session is reset and the client must add its streams again after resuming. Defaults to 1024.
+	ResumeBufferSize int
+
+	// WebSocket configures the WebSocket transport for sync sessions.
+	WebSocket SyncWebSocketConfig
+
+	// SendQueue limits the updates that are queued for a client that reads slower than updates arrive.
+	SendQueue SyncSendQueueConfig
+}
+
+const (
+	// SlowConsumerPolicyCancel cancels the sync session when its send queue is full.
+	SlowConsumerPolicyCancel = "cancel"
+	// SlowConsumerPolicyCoalesce merges queued updates of a stream into a single update when the send queue
+	// is full. If the queue is still over its byte limit, streams are dropped as with SlowConsumerPolicyDrop.
+	SlowConsumerPolicyCoalesce = "coalesce"
+	// SlowConsumerPolicyDrop drops the queued updates of the streams with the most queued bytes until the update
+	// fits in the send queue. The client receives a SYNC_DOWN message for each dropped stream and must add it again.
+	SlowConsumerPolicyDrop = "drop"
+)
+
+// SyncSendQueueConfig configures the per sync session queue of updates that are not yet sent to the client.
+type SyncSendQueueConfig struct {
+	// MaxMessages is the maximum number of queued updates. Defaults to 4096.
+	MaxMessages int
+
+	// MaxBytes is the maximum encoded size of the queued updates. Defaults to 64MB.
+	MaxBytes int
+
+	// Policy is applied when an update doesn't fit in the queue: cancel (default), coalesce or drop.
+	// Unknown policies fall back to cancel. Control messages such as SYNC_DOWN and SYNC_PONG are always queued.
+	Policy string
+}
+
+func (qc *SyncSendQueueConfig) GetMaxMessages() int {
+	if qc.MaxMessages <= 0 {
+		return 4096
+	}
+	return qc.MaxMessages
+}
+
+func (qc *SyncSendQueueConfig) GetMaxBytes() int {
+	if qc.MaxBytes <= 0 {
+		return 64 * 1024 * 1024
+	}
+	return qc.MaxBytes
+}
+
+func (qc *SyncSendQueueConfig) GetPolicy() string {
+	switch qc.Policy {
+	case SlowConsumerPolicyCoalesce, SlowConsumerPolicyDrop:
+		return qc.Policy
+	default:
+		return SlowConsumerPolicyCancel
+	}
+}
+
+// SyncWebSocketConfig configures the WebSocket transport for sync sessions. It is an alternative to the
+// SyncStreams server stream for clients behind proxies that break long-lived HTTP/2 streams.
+type SyncWebSocketConfig struct {
+	// Enabled enables the WebSocket endpoint.
+	Enabled bool
+
+	// AllowedOrigins are the origins of browser clients that are allowed to open a WebSocket, e.g.
+	// "https://app.towns.com". An entry of the form "https://*.towns.com" allows all subdomains and "*" allows
+	// all origins. Requests without an Origin header, i.e. from non-browser clients, are always allowed.
+	AllowedOrigins []string
+
+	// PingInterval is the interval in which the node pings the client. The connection is closed when the client
+	// doesn't respond within 2 intervals. Defaults to 30s.
+	PingInterval time.Duration
+
+	// WriteTimeout is the maximum duration of writing a message to the client. Defaults to 10s.
+	WriteTimeout time.Duration
+
+	// MaxMessageSize is the maximum size of a message received from the client. Defaults to 4MB.
+	MaxMessageSize int64
+
+	// DisableCompression disables per-message compression.
+	DisableCompression bool
+
+	// CompressionLevel is the flate compression level of messages sent to the client, from 1 (best speed)
+	// to 9 (best compression). Defaults to 1.
+	CompressionLevel int
+}
+
+func (wc *SyncWebSocketConfig) GetPingInterval() time.Duration {
+	if wc.PingInterval <= 0 {
+		return 30 * time.Second
+	}
+	return wc.PingInterval
+}
+
+func (wc *SyncWebSocketConfig) GetWriteTimeout() time.Duration {
+	if wc.WriteTimeout <= 0 {
+		return 10 * time.Second
+	}
+	return wc.WriteTimeout
+}
+
+func (wc *SyncWebSocketConfig) GetMaxMessageSize() int64 {
+	if wc.MaxMessageSize <= 0 {
+		return 4 * 1024 * 1024
+	}
+	return wc.MaxMessageSize
+}
+
+func (wc *SyncWebSocketConfig) GetCompressionLevel() int {
+	if wc.CompressionLevel < 1 || wc.CompressionLevel > 9 {
+		return 1
+	}
+	return wc.CompressionLevel
+}
+
+func (sc *SyncConfig) GetResumeBufferSize() int {
+	if sc.ResumeBufferSize <= 0 {
+		return 1024
+	}
+	return sc.ResumeBufferSize
 }
 
 type StreamTrackingConfig struct {
@@ -573,6 +753,125 @@ type NotificationsConfig struct {
 	// ColdStreamsEnabled if set to true, the service will not subscribe to all of the
 	// streams on init. default is false.
 	ColdStreamsEnabled bool
+
+	// SentNotificationsRetention is how long the service remembers which notifications were sent
+	// for an event. Within this period notifications are replaced when the event is edited and
+	// retracted when the event is redacted. Defaults to 7 days.
+	SentNotificationsRetention time.Duration
+
+	// NotificationHistorySize is the maximum number of sent notifications that are kept in the
+	// notification history of each user. Defaults to 500.
+	NotificationHistorySize int
+
+	// LowPriorityNotificationDelay delays notifications for regular messages and reactions. This gives
+	// the user's other devices the opportunity to mark the message as read, in which case the
+	// notification is dropped. Defaults to 3 seconds, a negative value disables the delay.
+	LowPriorityNotificationDelay time.Duration
+
+	// ResolveRoleMentions if set to true role mentions in space channel messages are resolved against
+	// the space roles on the base chain. Requires the base chain and architect contract configuration.
+	// Defaults to false.
+	ResolveRoleMentions bool
+
+	// StreamLeases partitions the tracked streams over multiple notification service instances.
+	StreamLeases StreamLeasesConfig
+}
+
+// StreamLeasesConfig configures how tracked streams are partitioned over notification service instances.
+// Each instance holds leases on a share of the partitions and only tracks the streams in these partitions.
+type StreamLeasesConfig struct {
+	// Enabled if set to true streams are partitioned over the running instances. When disabled a single
+	// instance tracks all streams. Defaults to false.
+	Enabled bool
+	// Partitions is the number of partitions streams are hashed into. All instances must use the same
+	// value. Defaults to 256.
+	Partitions int
+	// LeaseDuration is how long a lease is valid without being renewed. When an instance stops
+	// renewing its leases, other instances take over its partitions after this duration. Defaults to 30s.
+	LeaseDuration time.Duration
+	// HeartbeatInterval is the interval in which an instance renews its leases and rebalances
+	// partitions over the live instances. Must be smaller than LeaseDuration. Defaults to 10s.
+	HeartbeatInterval time.Duration
+	// ClockSkewMargin is subtracted from the lease expiration when an instance checks if it owns a
+	// stream. It covers clock differences between instances and the database, so an instance stops
+	// processing a stream before another instance can take it over. Must be smaller than LeaseDuration
+	// minus HeartbeatInterval. Defaults to LeaseDuration / 6.
+	ClockSkewMargin time.Duration
+}
+
+func (lc *StreamLeasesConfig) GetPartitions() int {
+	if lc.Partitions <= 0 {
+		return 256
+	}
+	return lc.Partitions
+}
+
+func (lc *StreamLeasesConfig) GetLeaseDuration() time.Duration {
+	if lc.LeaseDuration <= 0 {
+		return 30 * time.Second
+	}
+	return lc.LeaseDuration
+}
+
+func (lc *StreamLeasesConfig) GetHeartbeatInterval() time.Duration {
+	if lc.HeartbeatInterval <= 0 || lc.HeartbeatInterval >= lc.GetLeaseDuration() {
+		return lc.GetLeaseDuration() / 3
+	}
+	return lc.HeartbeatInterval
+}
+
+func (lc *StreamLeasesConfig) GetClockSkewMargin() time.Duration {
+	// a larger margin would let leases lapse between heartbeats
+	maxMargin := lc.GetLeaseDuration() - lc.GetHeartbeatInterval()
+	if lc.ClockSkewMargin <= 0 {
+		return min(lc.GetLeaseDuration()/6, maxMargin/2)
+	}
+	if lc.ClockSkewMargin >= maxMargin {
+		return maxMargin / 2
+	}
+	return lc.ClockSkewMargin
+}
+
+func (nc *NotificationsConfig) GetSentNotificationsRetention() time.Duration {
+	if nc.SentNotificationsRetention <= 0 {
+		return 7 * 24 * time.Hour
+	}
+	return nc.SentNotificationsRetention
+}
+
+func (nc *NotificationsConfig) GetNotificationHistorySize() int {
+	if nc.NotificationHistorySize <= 0 {
+		return 500
+	}
+	return nc.NotificationHistorySize
+}
+
+func (nc *NotificationsConfig) GetLowPriorityNotificationDelay() time.Duration {
+	if nc.LowPriorityNotificationDelay < 0 {
+		return 0
+	}
+	if nc.LowPriorityNotificationDelay == 0 {
+		return 3 * time.Second
+	}
+	return nc.LowPriorityNotificationDelay
+}
+
+// SearchConfig configures the search service that indexes the cleartext content of streams.
+type SearchConfig struct {
+	// Authentication holds configuration for the Client API authentication service.
+	Authentication AuthenticationConfig
+
+	StreamTracking StreamTrackingConfig
+
+	// MaxPageSize is the maximum number of results returned for a search request. Defaults to 200.
+	MaxPageSize int
+}
+
+func (sc *SearchConfig) GetMaxPageSize() int {
+	if sc.MaxPageSize <= 0 {
+		return 200
+	}
+	return sc.MaxPageSize
 }
 
 type AppRegistryConfig struct {
@@ -607,6 +906,9 @@ type AppRegistryConfig struct {
 	// RudderstackDataPlaneURL is the data plane URL for RudderStack analytics.
 	RudderstackDataPlaneURL string
 
+	// Analytics configures additional analytics sinks, e.g. for self-hosted deployments that can't use RudderStack.
+	Analytics AnalyticsConfig
+
 	// EnqueuedMessageRetention configures retention for enqueued messages
 	EnqueuedMessageRetention EnqueuedMessageRetentionConfig
 
@@ -616,6 +918,137 @@ type AppRegistryConfig struct {
 	ColdStreamsEnabled bool
 }
 
+// AnalyticsConfig configures the analytics sinks that receive product analytics events next to RudderStack.
+// Events are buffered and written in batches to each enabled sink. Events are dropped when a sink can't keep up
+// and its buffer is full or when a batch can't be written.
+type AnalyticsConfig struct {
+	// File writes events as JSON lines to a local file.
+	File AnalyticsFileSinkConfig
+
+	// Webhook posts batches of events as a JSON array to an HTTP endpoint.
+	Webhook AnalyticsWebhookSinkConfig
+
+	// Kafka publishes events as JSON messages to a topic through a Kafka REST proxy.
+	Kafka AnalyticsKafkaSinkConfig
+
+	// BatchSize is the maximum number of events written to a sink at once, defaults to 100.
+	BatchSize int
+
+	// FlushInterval is the maximum time an event is buffered before it is written, defaults to 5s.
+	FlushInterval time.Duration
+
+	// BufferSize is the maximum number of events buffered per sink, defaults to 10000.
+	BufferSize int
+
+	// Redact holds the rules that remove or hash PII event properties before events are passed to any sink.
+	Redact []AnalyticsRedactionRule
+
+	// RedactHashKey is the HMAC key used by "hash" redaction rules, required when such a rule is configured.
+	RedactHashKey string `json:"-" yaml:"-"` // Omit sensitive field from logging
+}
+
+// AnalyticsFileSinkConfig configures the JSON lines file analytics sink.
+type AnalyticsFileSinkConfig struct {
+	// Path of the file, the sink is disabled when empty.
+	Path string
+
+	// MaxSizeBytes is the size after which the file is rotated, defaults to 100MB.
+	MaxSizeBytes int64
+
+	// MaxBackups is the number of rotated files that are kept, defaults to 5.
+	MaxBackups int
+}
+
+// AnalyticsWebhookSinkConfig configures the HTTP analytics sink.
+type AnalyticsWebhookSinkConfig struct {
+	// URL that batches are posted to, the sink is disabled when empty.
+	URL string
+
+	// Headers are added to each request, e.g. for authorization.
+	Headers map[string]string `json:"-" yaml:"-"` // Omit sensitive field from logging
+
+	// Timeout of a single request, defaults to 10s.
+	Timeout time.Duration
+}
+
+// AnalyticsKafkaSinkConfig configures the Kafka analytics sink. Events are produced through the REST proxy
+// API (v2) of the broker, messages are keyed by account.
+type AnalyticsKafkaSinkConfig struct {
+	// RestProxyURL is the base URL of the Kafka REST proxy, the sink is disabled when empty.
+	RestProxyURL string
+
+	// Topic events are published to.
+	Topic string
+
+	// Headers are added to each request, e.g. for authorization.
+	Headers map[string]string `json:"-" yaml:"-"` // Omit sensitive field from logging
+
+	// Timeout of a single request, defaults to 10s.
+	Timeout time.Duration
+}
+
+// AnalyticsRedactionRule redacts properties of analytics events.
+type AnalyticsRedactionRule struct {
+	// Event name the rule applies to, "*" applies the rule to all events.
+	Event string
+
+	// Properties that are redacted.
+	Properties []string
+
+	// Action is either "remove" (default) to drop the properties or "hash" to replace their value with
+	// the hex encoded HMAC-SHA256 of the value keyed with RedactHashKey, which keeps them joinable.
+	Action string
+}
+
+func (ac *AnalyticsConfig) GetBatchSize() int {
+	if ac.BatchSize <= 0 {
+		return 100
+	}
+	return ac.BatchSize
+}
+
+func (ac *AnalyticsConfig) GetFlushInterval() time.Duration {
+	if ac.FlushInterval <= 0 {
+		return 5 * time.Second
+	}
+	return ac.FlushInterval
+}
+
+func (ac *AnalyticsConfig) GetBufferSize() int {
+	if ac.BufferSize <= 0 {
+		return 10000
+	}
+	return ac.BufferSize
+}
+
+func (fc *AnalyticsFileSinkConfig) GetMaxSizeBytes() int64 {
+	if fc.MaxSizeBytes <= 0 {
+		return 100 * 1024 * 1024
+	}
+	return fc.MaxSizeBytes
+}
+
+func (fc *AnalyticsFileSinkConfig) GetMaxBackups() int {
+	if fc.MaxBackups <= 0 {
+		return 5
+	}
+	return fc.MaxBackups
+}
+
+func (wc *AnalyticsWebhookSinkConfig) GetTimeout() time.Duration {
+	if wc.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return wc.Timeout
+}
+
+func (kc *AnalyticsKafkaSinkConfig) GetTimeout() time.Duration {
+	if kc.Timeout <= 0 {
+		return 10 * time.Second
+	}
+	return kc.Timeout
+}
+
 // EnqueuedMessageRetentionConfig configures TTL and limits for the enqueued_messages table.
 type EnqueuedMessageRetentionConfig struct {
 	// TTL is how long messages are kept before cleanup.
@@ -737,6 +1170,14 @@ type DebugEndpointsConfig struct {
 	TxPool          bool
 	CorruptStreams  bool
 
+	// Console enables the read-only operator console on /debug/console. The console is only
+	// served when ConsoleToken is set, sections of the console follow the toggles above.
+	Console bool
+
+	// ConsoleToken authenticates operator console requests, either as a bearer token in the
+	// Authorization header or as the basic auth password.
+	ConsoleToken string `json:"-" yaml:"-"`
+
 	// Make storage statistics available via debug endpoints. This may involve running queries
 	// on the underlying database.
 	EnableStorageEndpoint bool
@@ -828,6 +1269,27 @@ func (ac *ArchiveConfig) GetMaxConsecutiveFailedUpdates() uint32 {
 	return ac.MaxFailedConsecutiveUpdates
 }
 
+func (rc *ArchiveRepairConfig) GetInterval() time.Duration {
+	if rc.Interval <= 0 {
+		return time.Minute
+	}
+	return rc.Interval
+}
+
+func (rc *ArchiveRepairConfig) GetMaxAttempts() uint32 {
+	if rc.MaxAttempts == 0 {
+		return 3
+	}
+	return rc.MaxAttempts
+}
+
+func (rc *ArchiveRepairConfig) GetAuditLogSize() int {
+	if rc.AuditLogSize <= 0 {
+		return 1000
+	}
+	return rc.AuditLogSize
+}
+
 type ScrubbingConfig struct {
 	// ScrubEligibleDuration is the minimum length of time that must pass before a stream is eligible
 	// to be re-scrubbed.
@@ -849,6 +1311,59 @@ type StreamReconciliationConfig struct {
 	// ReconciliationTaskRetryDuration is the duration to wait before retrying a failed reconciliation task.
 	// default is 2 minutes.
 	ReconciliationTaskRetryDuration time.Duration
+
+	// ConsistencyCheck configures the background check that compares the last miniblock of local
+	// streams with the other replicas and schedules reconciliation for streams that diverged.
+	ConsistencyCheck StreamConsistencyCheckConfig `json:",omitempty"`
+}
+
+// StreamConsistencyCheckConfig configures the cross-node stream consistency checker.
+type StreamConsistencyCheckConfig struct {
+	// Enabled turns on the consistency checker. Disabled by default.
+	Enabled bool
+
+	// Interval is how often a sample of local streams is checked. If 0, default to 1m.
+	Interval time.Duration `json:",omitempty"`
+
+	// SampleSize is the number of streams checked per interval. If 0, default to 100.
+	SampleSize int `json:",omitempty"`
+
+	// MaxLagMiniblocks is the number of miniblocks a replica is allowed to lag behind before
+	// it is considered diverged. This absorbs miniblocks produced while the check is running.
+	// If 0, default to 2.
+	MaxLagMiniblocks int64 `json:",omitempty"`
+
+	// RequestTimeout is the timeout for fetching the last miniblock hash from a replica.
+	// If 0, default to 5s.
+	RequestTimeout time.Duration `json:",omitempty"`
+}
+
+func (c *StreamConsistencyCheckConfig) GetInterval() time.Duration {
+	if c.Interval <= 0 {
+		return time.Minute
+	}
+	return c.Interval
+}
+
+func (c *StreamConsistencyCheckConfig) GetSampleSize() int {
+	if c.SampleSize <= 0 {
+		return 100
+	}
+	return c.SampleSize
+}
+
+func (c *StreamConsistencyCheckConfig) GetMaxLagMiniblocks() int64 {
+	if c.MaxLagMiniblocks <= 0 {
+		return 2
+	}
+	return c.MaxLagMiniblocks
+}
+
+func (c *StreamConsistencyCheckConfig) GetRequestTimeout() time.Duration {
+	if c.RequestTimeout <= 0 {
+		return 5 * time.Second
+	}
+	return c.RequestTimeout
 }
 
 type FilterConfig struct {
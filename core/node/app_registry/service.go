@@ -70,6 +70,7 @@ func NewService(
 	nodes []nodes.NodeRegistry,
 	metrics infra.MetricsFactory,
 	listener track_streams.StreamEventListener,
+	analyticsSinks []analytics.Sink,
 	webhookHttpClient *http.Client,
 	baseChain *crypto.Blockchain,
 	appRegistryContractConfig *config.ContractConfig,
@@ -110,7 +111,17 @@ func NewService(
 	}
 
 	if listener == nil {
-		analyticsClient := analytics.NewRudderstack(ctx, cfg.RudderstackWriteKey, cfg.RudderstackDataPlaneURL)
+		analyticsClient, err := analytics.New(
+			ctx,
+			cfg.RudderstackWriteKey,
+			cfg.RudderstackDataPlaneURL,
+			cfg.Analytics,
+			metrics,
+			analyticsSinks...,
+		)
+		if err != nil {
+			return nil, err
+		}
 		listener = NewAppMessageProcessor(cache, analyticsClient)
 	}
 
@@ -165,7 +176,21 @@ func NewService(
 		}
 	}
 
-	if err := s.InitAuthentication(appServiceChallengePrefix, &cfg.Authentication); err != nil {
+	var smartAccounts *crypto.SmartAccountVerifier
+	if cfg.Authentication.SmartAccountSignatures {
+		if baseChain == nil {
+			return nil, base.RiverError(Err_BAD_CONFIG, "Smart account signatures require the base chain")
+		}
+		smartAccounts = crypto.NewSmartAccountVerifier(baseChain.Client)
+	}
+
+	if err := s.InitAuthentication(
+		appServiceChallengePrefix,
+		&cfg.Authentication,
+		authentication.NewPostgresChallengeStore(store.Pool()),
+		authentication.NewPostgresSessionStore(store.Pool()),
+		smartAccounts,
+	); err != nil {
 		return nil, err
 	}
 	return s, nil
@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"net/http"
 
 	"connectrpc.com/connect"
 
@@ -26,12 +27,8 @@ func (i *testBypassInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryF
 		return next
 	}
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		// If secret is empty, bypass is disabled.
-		if i.secret != "" && req != nil {
-			hdr := req.Header().Get(headers.RiverTestBypassHeaderName)
-			if hdr != "" && hdr == i.secret {
-				ctx = context.WithValue(ctx, testBypassCtxKey{}, true)
-			}
+		if req != nil {
+			ctx = WithTestEntitlementBypass(ctx, i.secret, req.Header())
 		}
 		return next(ctx, req)
 	}
@@ -46,16 +43,25 @@ func (i *testBypassInterceptor) WrapStreamingHandler(next connect.StreamingHandl
 		return next
 	}
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		if i.secret != "" && conn != nil {
-			hdr := conn.RequestHeader().Get(headers.RiverTestBypassHeaderName)
-			if hdr != "" && hdr == i.secret {
-				ctx = context.WithValue(ctx, testBypassCtxKey{}, true)
-			}
+		if conn != nil {
+			ctx = WithTestEntitlementBypass(ctx, i.secret, conn.RequestHeader())
 		}
 		return next(ctx, conn)
 	}
 }
 
+// WithTestEntitlementBypass marks the context for entitlement bypass when the request header carries
+// the given secret. If secret is empty, bypass is disabled and ctx is returned as is.
+func WithTestEntitlementBypass(ctx context.Context, secret string, header http.Header) context.Context {
+	if secret == "" {
+		return ctx
+	}
+	if hdr := header.Get(headers.RiverTestBypassHeaderName); hdr != "" && hdr == secret {
+		return context.WithValue(ctx, testBypassCtxKey{}, true)
+	}
+	return ctx
+}
+
 // IsTestEntitlementBypassEnabled returns true if the request context was marked by the
 // test-bypass interceptor.
 func IsTestEntitlementBypassEnabled(ctx context.Context) bool {
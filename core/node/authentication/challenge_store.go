@@ -0,0 +1,115 @@
+package authentication
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// ChallengeStore keeps the pending authentication challenges between StartAuthentication and
+// FinishAuthentication. Services that run multiple instances behind a load balancer must use a
+// store that is shared between instances, e.g. PostgresChallengeStore.
+type ChallengeStore interface {
+	// StoreChallenge stores a pending challenge for the given user that is valid until expires.
+	StoreChallenge(
+		ctx context.Context,
+		challenge []byte,
+		userID common.Address,
+		expires time.Time,
+	) error
+
+	// ConsumeChallenge removes the challenge from the store and returns the user it was issued for and
+	// when it expires. A challenge can only be consumed once, consuming a challenge that doesn't exist or
+	// was already consumed returns an Err_NOT_FOUND error.
+	ConsumeChallenge(
+		ctx context.Context,
+		challenge []byte,
+	) (common.Address, time.Time, error)
+
+	// DeleteExpiredChallenges removes challenges that expired before the given time and returns the
+	// number of removed challenges.
+	DeleteExpiredChallenges(
+		ctx context.Context,
+		before time.Time,
+	) (int64, error)
+}
+
+type (
+	// inMemoryChallengeStore keeps challenges in process memory. It is used when no shared store is
+	// configured and only works when StartAuthentication and FinishAuthentication land on the same instance.
+	inMemoryChallengeStore struct {
+		mu         sync.Mutex
+		challenges map[[challengeLength]byte]pendingChallenge
+	}
+
+	pendingChallenge struct {
+		userID  common.Address
+		expires time.Time
+	}
+)
+
+var _ ChallengeStore = (*inMemoryChallengeStore)(nil)
+
+// NewInMemoryChallengeStore creates a ChallengeStore that keeps challenges in process memory.
+func NewInMemoryChallengeStore() ChallengeStore {
+	return &inMemoryChallengeStore{
+		challenges: make(map[[challengeLength]byte]pendingChallenge),
+	}
+}
+
+func (s *inMemoryChallengeStore) StoreChallenge(
+	_ context.Context,
+	challenge []byte,
+	userID common.Address,
+	expires time.Time,
+) error {
+	if len(challenge) != challengeLength {
+		return RiverError(Err_INVALID_ARGUMENT, "invalid challenge length", "len", len(challenge))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.challenges[[challengeLength]byte(challenge)] = pendingChallenge{userID: userID, expires: expires}
+	return nil
+}
+
+func (s *inMemoryChallengeStore) ConsumeChallenge(
+	_ context.Context,
+	challenge []byte,
+) (common.Address, time.Time, error) {
+	if len(challenge) != challengeLength {
+		return common.Address{}, time.Time{}, RiverError(Err_NOT_FOUND, "no pending authentication challenge")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := [challengeLength]byte(challenge)
+	pending, found := s.challenges[key]
+	if !found {
+		return common.Address{}, time.Time{}, RiverError(Err_NOT_FOUND, "no pending authentication challenge")
+	}
+	delete(s.challenges, key)
+
+	return pending.userID, pending.expires, nil
+}
+
+func (s *inMemoryChallengeStore) DeleteExpiredChallenges(_ context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for challenge, pending := range s.challenges {
+		if pending.expires.Before(before) {
+			delete(s.challenges, challenge)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
 )
 
-type userIDCtxKey struct{}
+type (
+	userIDCtxKey    struct{}
+	sessionIDCtxKey struct{}
+)
 
 // contextWithAuthenticatedUser returns a context that has the id of the authenticated wallet stored
 // within. It's used by the authentication interceptor to supply authentication metadata to the request
@@ -26,3 +30,21 @@ func UserFromAuthenticatedContext(ctx context.Context) common.Address {
 
 	return val.(common.Address)
 }
+
+// contextWithAuthenticatedSession returns a context that has the id of the session the session token
+// was issued for stored within.
+func contextWithAuthenticatedSession(ctx context.Context, sessionID uuid.UUID) context.Context {
+	return context.WithValue(ctx, sessionIDCtxKey{}, sessionID)
+}
+
+// SessionFromAuthenticatedContext retrieves the id of the session the request was made with. This data is
+// populated by the authentication interceptor. It returns the zero uuid if the session token doesn't
+// reference a session.
+func SessionFromAuthenticatedContext(ctx context.Context) uuid.UUID {
+	val := ctx.Value(sessionIDCtxKey{})
+	if val == nil {
+		return uuid.UUID{}
+	}
+
+	return val.(uuid.UUID)
+}
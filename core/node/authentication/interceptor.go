@@ -2,8 +2,8 @@ package authentication
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
+	"slices"
 	"strings"
 	"time"
 
@@ -11,16 +11,32 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	ttlcache "github.com/patrickmn/go-cache"
 
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 )
 
+// activeSessionCacheTTL determines how long the interceptor caches that a session is active. A revoked
+// session is rejected by all service instances at most activeSessionCacheTTL after it was revoked.
+const activeSessionCacheTTL = 10 * time.Second
+
+// unauthenticatedAuthenticationRoutes are the authentication service endpoints that can be called
+// without a session token.
+var unauthenticatedAuthenticationRoutes = []string{
+	"/river.AuthenticationService/StartAuthentication",
+	"/river.AuthenticationService/FinishAuthentication",
+	"/river.AuthenticationService/RefreshSession",
+}
+
 type jwtAuthenticationInterceptor struct {
-	shortServiceName           string
-	sessionTokenSigningKeyAlgo string
-	sessionTokenSigningKey     interface{}
-	publicRoutes               []string
+	shortServiceName string
+	keys             *sessionTokenKeys
+	sessions         SessionStore
+	activeSessions   *ttlcache.Cache
+	publicRoutes     []string
 }
 
 // NewAuthenticationInterceptor creates a connect Interceptor that can be used to require
@@ -28,12 +44,16 @@ type jwtAuthenticationInterceptor struct {
 // jwt token issued by this service in the request header.
 // The shortServiceName parameter must match the string used by the authentication service
 // mixin to construct the JWT token embedded in the session header.
+// sessionTokenKey holds the key to verify session tokens, services that only verify session tokens
+// signed with ES256 or EdDSA can provide just the public key.
+// sessions is used to reject session tokens of revoked sessions. If nil, session tokens are
+// accepted until they expire.
 // publicRoutes is an optional list of routes that will be ignored by the interceptor. This
 // list is only used to whitelist unary endpoints.
 func NewAuthenticationInterceptor(
 	shortServiceName string,
-	sessionTokenSigningKeyAlgo string,
-	sessionTokenSigningKey string,
+	sessionTokenKey config.SessionKeyConfig,
+	sessions SessionStore,
 	publicRoutes ...string,
 ) (connect.Interceptor, error) {
 	if len(shortServiceName) < 2 {
@@ -42,64 +62,119 @@ func NewAuthenticationInterceptor(
 			"ShortServiceName must be at least 2 characters long",
 		).Func("NewAuthenticationInterceptor")
 	}
-	key, err := hex.DecodeString(sessionTokenSigningKey)
+	keys, err := parseSessionTokenKeys(sessionTokenKey)
 	if err != nil {
-		return nil, RiverError(Err_BAD_CONFIG, "Invalid session token key").Func("NewAuthenticationInterceptor")
-	}
-
-	if len(key) != 32 {
-		return nil, RiverError(Err_BAD_CONFIG, "Invalid session token key length").Func("NewAuthenticationInterceptor")
+		return nil, AsRiverError(err).Func("NewAuthenticationInterceptor")
 	}
 
 	return &jwtAuthenticationInterceptor{
-		shortServiceName:           shortServiceName,
-		sessionTokenSigningKeyAlgo: sessionTokenSigningKeyAlgo,
-		sessionTokenSigningKey:     key,
-		publicRoutes:               publicRoutes,
+		shortServiceName: shortServiceName,
+		keys:             keys,
+		sessions:         sessions,
+		activeSessions:   ttlcache.New(activeSessionCacheTTL, time.Minute),
+		publicRoutes:     publicRoutes,
 	}, nil
 }
 
-func (i *jwtAuthenticationInterceptor) authorize(sessionTokenString string) (common.Address, error) {
+func (i *jwtAuthenticationInterceptor) authorize(
+	ctx context.Context,
+	sessionTokenString string,
+) (common.Address, uuid.UUID, error) {
 	token, err := jwt.Parse(sessionTokenString, func(token *jwt.Token) (interface{}, error) {
-		return i.sessionTokenSigningKey, nil
-	}, jwt.WithJSONNumber(), jwt.WithValidMethods([]string{"HS256"}))
+		return i.keys.verificationKey, nil
+	}, jwt.WithJSONNumber(), jwt.WithValidMethods([]string{i.keys.method.Alg()}))
 	if err != nil {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token")
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token")
 	}
 
 	if claims["aud"] != i.shortServiceName {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token audience")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token audience")
 	}
 
 	if claims["iss"] != i.shortServiceName {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token issuer")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token issuer")
 	}
 
 	expiredNumber, ok := claims["exp"].(json.Number)
 	if !ok {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token exp")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token exp")
 	}
 
 	expired, err := expiredNumber.Int64()
 	if err != nil {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token exp")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token exp")
 	}
 
 	if time.Now().After(time.Unix(expired, 0)) {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Session token expired")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Session token expired")
 	}
 
 	subStr, ok := claims["sub"].(string)
 	if !ok {
-		return common.Address{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token subject")
+		return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token subject")
+	}
+	userID := common.HexToAddress(subStr)
+
+	var sessionID uuid.UUID
+	if sidStr, ok := claims["sid"].(string); ok {
+		if sessionID, err = uuid.Parse(sidStr); err != nil {
+			return common.Address{}, uuid.UUID{}, RiverError(Err_UNAUTHENTICATED, "Invalid session token sid")
+		}
+	}
+
+	if i.sessions != nil {
+		if err := i.ensureSessionActive(ctx, userID, sessionID); err != nil {
+			return common.Address{}, uuid.UUID{}, err
+		}
+	}
+
+	return userID, sessionID, nil
+}
+
+// ensureSessionActive returns an error when the session the session token was issued for was revoked
+// or has expired.
+func (i *jwtAuthenticationInterceptor) ensureSessionActive(
+	ctx context.Context,
+	userID common.Address,
+	sessionID uuid.UUID,
+) error {
+	if sessionID == (uuid.UUID{}) {
+		return RiverError(Err_UNAUTHENTICATED, "Session token without session")
+	}
+
+	if _, active := i.activeSessions.Get(sessionID.String()); active {
+		return nil
+	}
+
+	session, err := i.sessions.GetSession(ctx, sessionID)
+	if err != nil {
+		if IsRiverErrorCode(err, Err_NOT_FOUND) {
+			return RiverError(Err_UNAUTHENTICATED, "Session revoked or expired", "sessionId", sessionID)
+		}
+		return err
+	}
+
+	if session.UserID != userID {
+		return RiverError(Err_UNAUTHENTICATED, "Invalid session token subject", "sessionId", sessionID)
+	}
+
+	i.activeSessions.SetDefault(sessionID.String(), struct{}{})
+	return nil
+}
+
+// authenticate verifies the session token and returns a context with the authenticated user and session.
+func (i *jwtAuthenticationInterceptor) authenticate(ctx context.Context, sessionToken string) (context.Context, error) {
+	userID, sessionID, err := i.authorize(ctx, sessionToken)
+	if err != nil {
+		return nil, err
 	}
 
-	return common.HexToAddress(subStr), nil
+	return contextWithAuthenticatedSession(contextWithAuthenticatedUser(ctx, userID), sessionID), nil
 }
 
 func (i *jwtAuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
@@ -107,8 +182,8 @@ func (i *jwtAuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect
 		ctx context.Context,
 		req connect.AnyRequest,
 	) (connect.AnyResponse, error) {
-		// calls to the authentication service are unauthenticated
-		if strings.HasPrefix(req.Spec().Procedure, "/river.AuthenticationService/") {
+		// calls to obtain a session token are unauthenticated
+		if slices.Contains(unauthenticatedAuthenticationRoutes, req.Spec().Procedure) {
 			return next(ctx, req)
 		}
 
@@ -124,12 +199,12 @@ func (i *jwtAuthenticationInterceptor) WrapUnary(next connect.UnaryFunc) connect
 			return nil, RiverError(Err_UNAUTHENTICATED, "missing session token")
 		}
 
-		userID, err := i.authorize(authHeader)
+		ctx, err := i.authenticate(ctx, authHeader)
 		if err != nil {
 			return nil, err
 		}
 
-		return next(contextWithAuthenticatedUser(ctx, userID), req)
+		return next(ctx, req)
 	}
 }
 
@@ -152,11 +227,11 @@ func (i *jwtAuthenticationInterceptor) WrapStreamingHandler(
 		conn connect.StreamingHandlerConn,
 	) error {
 		sessionToken := conn.RequestHeader().Get("Authorization")
-		userID, err := i.authorize(sessionToken)
+		ctx, err := i.authenticate(ctx, sessionToken)
 		if err != nil {
 			return err
 		}
 
-		return next(contextWithAuthenticatedUser(ctx, userID), conn)
+		return next(ctx, conn)
 	}
 }
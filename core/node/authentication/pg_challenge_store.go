@@ -0,0 +1,89 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// PostgresChallengeStore implements ChallengeStore using PostgreSQL. This allows a user to start the
+// authentication on one service instance and finish it on another. It expects an auth_challenges table
+// in the service schema, which is created by the notification and app registry migrations.
+type PostgresChallengeStore struct {
+	pool *pgxpool.Pool
+}
+
+var _ ChallengeStore = (*PostgresChallengeStore)(nil)
+
+// NewPostgresChallengeStore creates a new PostgresChallengeStore.
+func NewPostgresChallengeStore(pool *pgxpool.Pool) *PostgresChallengeStore {
+	return &PostgresChallengeStore{pool: pool}
+}
+
+func (s *PostgresChallengeStore) StoreChallenge(
+	ctx context.Context,
+	challenge []byte,
+	userID common.Address,
+	expires time.Time,
+) error {
+	if len(challenge) != challengeLength {
+		return RiverError(Err_INVALID_ARGUMENT, "invalid challenge length", "len", len(challenge))
+	}
+
+	if _, err := s.pool.Exec(
+		ctx,
+		`INSERT INTO auth_challenges (challenge, user_id, expires_at) VALUES ($1, $2, $3)`,
+		challenge,
+		userID.Bytes(),
+		expires.UTC(),
+	); err != nil {
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to store authentication challenge").
+			Tag("user", userID)
+	}
+
+	return nil
+}
+
+// ConsumeChallenge deletes the challenge and returns the deleted record. The delete is atomic which
+// guarantees that a challenge is only accepted once, even when multiple instances try to consume it.
+func (s *PostgresChallengeStore) ConsumeChallenge(
+	ctx context.Context,
+	challenge []byte,
+) (common.Address, time.Time, error) {
+	var (
+		userID  []byte
+		expires time.Time
+	)
+
+	err := s.pool.QueryRow(
+		ctx,
+		`DELETE FROM auth_challenges WHERE challenge = $1 RETURNING user_id, expires_at`,
+		challenge,
+	).Scan(&userID, &expires)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return common.Address{}, time.Time{}, RiverError(Err_NOT_FOUND, "no pending authentication challenge")
+		}
+		return common.Address{}, time.Time{}, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to load authentication challenge")
+	}
+
+	return common.BytesToAddress(userID), expires, nil
+}
+
+func (s *PostgresChallengeStore) DeleteExpiredChallenges(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.pool.Exec(ctx, `DELETE FROM auth_challenges WHERE expires_at < $1`, before.UTC())
+	if err != nil {
+		return 0, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to delete expired authentication challenges")
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,82 @@
+package authentication_test
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/authentication"
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils/dbtestutils"
+)
+
+// prepareAuthenticationDB returns a pool for a schema with the authentication tables as created by the
+// notification service migrations.
+func prepareAuthenticationDB(t *testing.T, ctx context.Context) *pgxpool.Pool {
+	dbCfg, dbSchemaName, dbCloser, err := dbtestutils.ConfigureDB(ctx)
+	require.NoError(t, err)
+	t.Cleanup(dbCloser)
+
+	dbCfg.StartupDelay = 2 * time.Millisecond
+	dbCfg.Extra = strings.Replace(dbCfg.Extra, "pool_max_conns=1000", "pool_max_conns=10", 1)
+
+	pool, err := storage.CreateAndValidatePgxPool(ctx, dbCfg, dbSchemaName, nil)
+	require.NoError(t, err)
+
+	store, err := storage.NewPostgresNotificationStore(
+		ctx,
+		pool,
+		make(chan error, 1),
+		infra.NewMetricsFactory(nil, "", ""),
+	)
+	require.NoError(t, err)
+
+	return store.Pool()
+}
+
+func TestPostgresChallengeStore(t *testing.T) {
+	var (
+		req        = require.New(t)
+		ctx        = test.NewTestContext(t)
+		challenges = authentication.NewPostgresChallengeStore(prepareAuthenticationDB(t, ctx))
+		challenge  = make([]byte, 16)
+		expired    = make([]byte, 16)
+		expires    = time.Now().Add(time.Minute).UTC().Truncate(time.Microsecond)
+	)
+	_, _ = rand.Read(challenge)
+	_, _ = rand.Read(expired)
+
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+	userID := wallet.Address
+
+	req.NoError(challenges.StoreChallenge(ctx, challenge, userID, expires))
+	req.NoError(challenges.StoreChallenge(ctx, expired, userID, time.Now().Add(-time.Minute)))
+
+	// expired challenges are removed
+	deleted, err := challenges.DeleteExpiredChallenges(ctx, time.Now())
+	req.NoError(err)
+	req.EqualValues(1, deleted)
+
+	_, _, err = challenges.ConsumeChallenge(ctx, expired)
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	gotUserID, gotExpires, err := challenges.ConsumeChallenge(ctx, challenge)
+	req.NoError(err)
+	req.Equal(userID, gotUserID)
+	req.True(expires.Equal(gotExpires))
+
+	// a challenge can only be consumed once
+	_, _, err = challenges.ConsumeChallenge(ctx, challenge)
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+}
@@ -0,0 +1,231 @@
+package authentication
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// PostgresSessionStore implements SessionStore using PostgreSQL. It expects an auth_sessions table
+// in the service schema, which is created by the notification and app registry migrations.
+type PostgresSessionStore struct {
+	pool *pgxpool.Pool
+}
+
+var _ SessionStore = (*PostgresSessionStore)(nil)
+
+// NewPostgresSessionStore creates a new PostgresSessionStore.
+func NewPostgresSessionStore(pool *pgxpool.Pool) *PostgresSessionStore {
+	return &PostgresSessionStore{pool: pool}
+}
+
+const sessionColumns = `session_id, user_id, refresh_token_hash, prev_refresh_token_hash, created_at,
+	last_refreshed_at, expires_at`
+
+func scanSession(row pgx.Row) (*Session, error) {
+	var (
+		session              Session
+		sessionID            []byte
+		userID               []byte
+		refreshTokenHash     []byte
+		prevRefreshTokenHash []byte
+	)
+	if err := row.Scan(
+		&sessionID,
+		&userID,
+		&refreshTokenHash,
+		&prevRefreshTokenHash,
+		&session.CreatedAt,
+		&session.LastRefreshedAt,
+		&session.ExpiresAt,
+	); err != nil {
+		return nil, err
+	}
+	id, err := uuid.FromBytes(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	session.ID = id
+	session.UserID = common.BytesToAddress(userID)
+	session.RefreshTokenHash = common.BytesToHash(refreshTokenHash)
+	session.PrevRefreshTokenHash = common.BytesToHash(prevRefreshTokenHash)
+	return &session, nil
+}
+
+func (s *PostgresSessionStore) CreateSession(ctx context.Context, session *Session) error {
+	if _, err := s.pool.Exec(
+		ctx,
+		`INSERT INTO auth_sessions (`+sessionColumns+`) VALUES ($1, $2, $3, NULL, $4, $5, $6)`,
+		session.ID[:],
+		session.UserID.Bytes(),
+		session.RefreshTokenHash.Bytes(),
+		session.CreatedAt.UTC(),
+		session.LastRefreshedAt.UTC(),
+		session.ExpiresAt.UTC(),
+	); err != nil {
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to create session").
+			Tag("user", session.UserID)
+	}
+	return nil
+}
+
+func (s *PostgresSessionStore) GetSession(ctx context.Context, sessionID uuid.UUID) (*Session, error) {
+	session, err := scanSession(s.pool.QueryRow(
+		ctx,
+		`SELECT `+sessionColumns+` FROM auth_sessions WHERE session_id = $1 AND expires_at > $2`,
+		sessionID[:],
+		time.Now().UTC(),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, RiverError(Err_NOT_FOUND, "session not found", "sessionId", sessionID)
+		}
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to load session").
+			Tag("sessionId", sessionID)
+	}
+	return session, nil
+}
+
+// RefreshSession atomically replaces the refresh token hash which guarantees that a refresh token is
+// only accepted once, even when multiple instances try to refresh the session.
+func (s *PostgresSessionStore) RefreshSession(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	prevRefreshTokenHash common.Hash,
+	refreshTokenHash common.Hash,
+	refreshedAt time.Time,
+	expiresAt time.Time,
+) (*Session, error) {
+	session, err := scanSession(s.pool.QueryRow(
+		ctx,
+		`UPDATE auth_sessions SET prev_refresh_token_hash = refresh_token_hash, refresh_token_hash = $3,
+			last_refreshed_at = $4, expires_at = $5
+			WHERE session_id = $1 AND refresh_token_hash = $2 AND expires_at > $4
+			RETURNING `+sessionColumns,
+		sessionID[:],
+		prevRefreshTokenHash.Bytes(),
+		refreshTokenHash.Bytes(),
+		refreshedAt.UTC(),
+		expiresAt.UTC(),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, RiverError(Err_NOT_FOUND, "session not found", "sessionId", sessionID)
+		}
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to refresh session").
+			Tag("sessionId", sessionID)
+	}
+	return session, nil
+}
+
+func (s *PostgresSessionStore) RevokeReusedSession(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	refreshTokenHash common.Hash,
+) (bool, error) {
+	result, err := s.pool.Exec(
+		ctx,
+		`DELETE FROM auth_sessions WHERE session_id = $1 AND prev_refresh_token_hash = $2`,
+		sessionID[:],
+		refreshTokenHash.Bytes(),
+	)
+	if err != nil {
+		return false, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to revoke reused session").
+			Tag("sessionId", sessionID)
+	}
+	return result.RowsAffected() > 0, nil
+}
+
+func (s *PostgresSessionStore) ListSessions(ctx context.Context, userID common.Address) ([]*Session, error) {
+	rows, err := s.pool.Query(
+		ctx,
+		`SELECT `+sessionColumns+` FROM auth_sessions WHERE user_id = $1 AND expires_at > $2 ORDER BY created_at`,
+		userID.Bytes(),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to list sessions").
+			Tag("user", userID)
+	}
+	defer rows.Close()
+
+	var sessions []*Session
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+				Message("failed to scan session").
+				Tag("user", userID)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to list sessions").
+			Tag("user", userID)
+	}
+	return sessions, nil
+}
+
+func (s *PostgresSessionStore) RevokeSessions(
+	ctx context.Context,
+	userID common.Address,
+	sessionIDs []uuid.UUID,
+) (int64, error) {
+	var (
+		result pgconn.CommandTag
+		err    error
+	)
+	if len(sessionIDs) == 0 {
+		result, err = s.pool.Exec(ctx, `DELETE FROM auth_sessions WHERE user_id = $1`, userID.Bytes())
+	} else {
+		ids := make([][]byte, len(sessionIDs))
+		for i, id := range sessionIDs {
+			ids[i] = id[:]
+		}
+		result, err = s.pool.Exec(
+			ctx,
+			`DELETE FROM auth_sessions WHERE user_id = $1 AND session_id = ANY($2)`,
+			userID.Bytes(),
+			ids,
+		)
+	}
+	if err != nil {
+		return 0, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to revoke sessions").
+			Tag("user", userID)
+	}
+	return result.RowsAffected(), nil
+}
+
+func (s *PostgresSessionStore) DeleteSession(ctx context.Context, sessionID uuid.UUID) error {
+	if _, err := s.pool.Exec(ctx, `DELETE FROM auth_sessions WHERE session_id = $1`, sessionID[:]); err != nil {
+		return WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to delete session").
+			Tag("sessionId", sessionID)
+	}
+	return nil
+}
+
+func (s *PostgresSessionStore) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	result, err := s.pool.Exec(ctx, `DELETE FROM auth_sessions WHERE expires_at < $1`, before.UTC())
+	if err != nil {
+		return 0, WrapRiverError(Err_DB_OPERATION_FAILURE, err).
+			Message("failed to delete expired sessions")
+	}
+	return result.RowsAffected(), nil
+}
@@ -0,0 +1,115 @@
+package authentication_test
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/authentication"
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestPostgresSessionStore(t *testing.T) {
+	var (
+		req      = require.New(t)
+		ctx      = test.NewTestContext(t)
+		sessions = authentication.NewPostgresSessionStore(prepareAuthenticationDB(t, ctx))
+	)
+
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	randomHash := func() common.Hash {
+		var hash common.Hash
+		_, _ = rand.Read(hash[:])
+		return hash
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	newSession := func(expiresAt time.Time) *authentication.Session {
+		session := &authentication.Session{
+			ID:               uuid.New(),
+			UserID:           wallet.Address,
+			RefreshTokenHash: randomHash(),
+			CreatedAt:        now,
+			LastRefreshedAt:  now,
+			ExpiresAt:        expiresAt,
+		}
+		req.NoError(sessions.CreateSession(ctx, session))
+		return session
+	}
+
+	session1 := newSession(now.Add(time.Hour))
+	session2 := newSession(now.Add(time.Hour))
+	expired := newSession(now.Add(-time.Minute))
+
+	got, err := sessions.GetSession(ctx, session1.ID)
+	req.NoError(err)
+	req.Equal(session1.UserID, got.UserID)
+	req.Equal(session1.RefreshTokenHash, got.RefreshTokenHash)
+	req.Equal(common.Hash{}, got.PrevRefreshTokenHash)
+	req.True(session1.ExpiresAt.Equal(got.ExpiresAt))
+
+	_, err = sessions.GetSession(ctx, expired.ID)
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	active, err := sessions.ListSessions(ctx, wallet.Address)
+	req.NoError(err)
+	req.Len(active, 2)
+
+	// refresh only succeeds with the current refresh token hash, the replaced hash is kept
+	newHash := randomHash()
+	refreshed, err := sessions.RefreshSession(
+		ctx, session1.ID, session1.RefreshTokenHash, newHash, now, now.Add(2*time.Hour))
+	req.NoError(err)
+	req.Equal(newHash, refreshed.RefreshTokenHash)
+	req.Equal(session1.RefreshTokenHash, refreshed.PrevRefreshTokenHash)
+	req.True(now.Add(2 * time.Hour).Equal(refreshed.ExpiresAt))
+
+	_, err = sessions.RefreshSession(
+		ctx, session1.ID, session1.RefreshTokenHash, randomHash(), now, now.Add(2*time.Hour))
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	// only the rotated refresh token hash revokes the session
+	revokedReused, err := sessions.RevokeReusedSession(ctx, session1.ID, randomHash())
+	req.NoError(err)
+	req.False(revokedReused)
+	revokedReused, err = sessions.RevokeReusedSession(ctx, session1.ID, newHash)
+	req.NoError(err)
+	req.False(revokedReused)
+	revokedReused, err = sessions.RevokeReusedSession(ctx, session2.ID, session2.RefreshTokenHash)
+	req.NoError(err)
+	req.False(revokedReused)
+
+	revokedReused, err = sessions.RevokeReusedSession(ctx, session1.ID, session1.RefreshTokenHash)
+	req.NoError(err)
+	req.True(revokedReused)
+	_, err = sessions.GetSession(ctx, session1.ID)
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	revoked, err := sessions.RevokeSessions(ctx, wallet.Address, []uuid.UUID{session2.ID})
+	req.NoError(err)
+	req.EqualValues(1, revoked)
+
+	_, err = sessions.GetSession(ctx, session2.ID)
+	req.True(base.IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	// expired sessions are removed
+	deleted, err := sessions.DeleteExpiredSessions(ctx, time.Now())
+	req.NoError(err)
+	req.EqualValues(1, deleted)
+
+	session3 := newSession(now.Add(time.Hour))
+	req.NoError(sessions.DeleteSession(ctx, session3.ID))
+
+	revoked, err = sessions.RevokeSessions(ctx, wallet.Address, nil)
+	req.NoError(err)
+	req.EqualValues(0, revoked)
+}
@@ -8,12 +8,14 @@ import (
 	"encoding/hex"
 	"math/big"
 	"strings"
-	"sync"
+	"sync/atomic"
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/towns-protocol/towns/core/config"
@@ -25,6 +27,10 @@ import (
 
 const (
 	challengeLength = 16
+	// cleanupInterval is the minimum interval between removing expired challenges and sessions from the stores.
+	cleanupInterval = time.Minute
+	// refreshTokenSecretLength is the number of random bytes in a refresh token.
+	refreshTokenSecretLength = 32
 )
 
 type (
@@ -32,6 +38,10 @@ type (
 		challengePrefix string
 		userID          common.Address
 		expires         time.Time
+		// smartAccounts verifies signatures of smart contract accounts, nil if not supported
+		smartAccounts *crypto.SmartAccountVerifier
+		// siweMessage is the validated Sign-In with Ethereum message that was signed instead of the challenge
+		siweMessage string
 	}
 )
 
@@ -59,34 +69,58 @@ func (c authenticationChallenge) Verify(
 	// ensure that the signature that was calculated with:
 	// ecdsa_sign(client_key, ETH_SIGN(sha256(PREFIX || user_id || expiration || challenge)))
 	// with ETH_SIGN prefix the sha256 digest with \x19Ethereum Signed Message:\n<length>
-	// was created with the private key of c.userID
-	var (
-		buf     bytes.Buffer
-		expires = big.NewInt(c.expires.Unix())
-	)
+	// or for SIWE with:
+	// ecdsa_sign(client_key, ETH_SIGN(siwe_message))
+	// was created with the private key of c.userID, or is accepted by the smart account c.userID
+	message := []byte(c.siweMessage)
+	if c.siweMessage == "" {
+		var (
+			buf     bytes.Buffer
+			expires = big.NewInt(c.expires.Unix())
+		)
 
-	buf.WriteString(c.challengePrefix)
-	buf.Write(c.userID.Bytes())
-	buf.Write(expires.Bytes())
-	buf.Write(challenge[:])
-	hash := sha256.Sum256(buf.Bytes())
+		buf.WriteString(c.challengePrefix)
+		buf.Write(c.userID.Bytes())
+		buf.Write(expires.Bytes())
+		buf.Write(challenge[:])
+		hash := sha256.Sum256(buf.Bytes())
+		message = hash[:]
+	}
 
-	signerPubKey, err := crypto.RecoverEthereumMessageSignerPublicKey(hash[:], signature)
+	if len(delegateSig) == 0 {
+		return c.verifyUserSignature(ctx, message, signature)
+	}
+
+	// the challenge is signed with the delegate key, which must be an EOA
+	signerPubKey, err := crypto.RecoverEthereumMessageSignerPublicKey(message, signature)
 	if err != nil {
 		return RiverError(Err_UNAUTHENTICATED, "error recovering signer public key", "user", c.userID, "error", err)
 	}
 
-	signerAddress := crypto.PublicKeyToAddress(signerPubKey)
+	return crypto.CheckDelegateSigWithVerifier(
+		ctx, c.smartAccounts, c.userID, signerPubKey, delegateSig, delegateExpiryEpochMs)
+}
 
-	if len(delegateSig) == 0 {
-		if c.userID == signerAddress {
-			return nil
-		} else {
-			return RiverError(Err_UNAUTHENTICATED, "user id mismatch", "user", c.userID, "signer", signerAddress)
+// verifyUserSignature verifies that the message was signed by c.userID.
+func (c authenticationChallenge) verifyUserSignature(ctx context.Context, message []byte, signature []byte) error {
+	signerPubKey, err := crypto.RecoverEthereumMessageSignerPublicKey(message, signature)
+	if err == nil && crypto.PublicKeyToAddress(signerPubKey) == c.userID {
+		return nil
+	}
+
+	if c.smartAccounts != nil {
+		ethHash := common.BytesToHash(accounts.TextHash(message))
+		if err := c.smartAccounts.VerifySignature(ctx, c.userID, ethHash, signature); err != nil {
+			return AsRiverError(err, Err_UNAUTHENTICATED).Tag("user", c.userID)
 		}
+		return nil
 	}
 
-	return crypto.CheckDelegateSig(c.userID[:], signerPubKey, delegateSig, delegateExpiryEpochMs)
+	if err != nil {
+		return RiverError(Err_UNAUTHENTICATED, "error recovering signer public key", "user", c.userID, "error", err)
+	}
+	return RiverError(Err_UNAUTHENTICATED, "user id mismatch",
+		"user", c.userID, "signer", crypto.PublicKeyToAddress(signerPubKey))
 }
 
 // AuthServiceMixin can be used by any service requiring authentication to implement authentication
@@ -96,11 +130,14 @@ func (c authenticationChallenge) Verify(
 // authentication interceptor with service metadata derived from the mixin. See notification and app
 // registry services for examples.
 type AuthServiceMixin struct {
-	authConfig                    *config.AuthenticationConfig
-	sessionTokenSigningKey        any
-	sessionTokenSigningAlgo       string
-	pendingAuthenticationRequests sync.Map
-	challengePrefix               string
+	authConfig      *config.AuthenticationConfig
+	sessionKeys     *sessionTokenKeys
+	challenges      ChallengeStore
+	sessions        SessionStore
+	smartAccounts   *crypto.SmartAccountVerifier
+	challengePrefix string
+	// lastCleanup keeps the unix timestamp in seconds when expired challenges and sessions were last removed
+	lastCleanup atomic.Int64
 }
 
 func (s *AuthServiceMixin) ShortServiceName() string {
@@ -114,7 +151,16 @@ func (s *AuthServiceMixin) ShortServiceName() string {
 // are also used to populate service-specific values in the issued jwt token's claim map, so it's best to
 // make sure they are unique compared to other services that implement authentication for the sake
 // of sane debugging.
-func (s *AuthServiceMixin) InitAuthentication(challengePrefix string, config *config.AuthenticationConfig) error {
+// challengeStore keeps pending challenges and sessionStore the authenticated sessions, services that run
+// multiple instances must use shared stores. If nil, challenges and sessions are kept in process memory.
+// smartAccounts verifies signatures of smart contract accounts, if nil only EOAs can authenticate.
+func (s *AuthServiceMixin) InitAuthentication(
+	challengePrefix string,
+	config *config.AuthenticationConfig,
+	challengeStore ChallengeStore,
+	sessionStore SessionStore,
+	smartAccounts *crypto.SmartAccountVerifier,
+) error {
 	if len(challengePrefix) < 2 || len(challengePrefix) > 32 {
 		return RiverError(Err_INVALID_ARGUMENT, "Challenge prefix length is out of range", "prefix", challengePrefix)
 	}
@@ -128,31 +174,47 @@ func (s *AuthServiceMixin) InitAuthentication(challengePrefix string, config *co
 	if s.authConfig.SessionToken.Lifetime <= 0 {
 		s.authConfig.SessionToken.Lifetime = 30 * time.Minute
 	}
+	if s.authConfig.SessionToken.RefreshTokenLifetime <= 0 {
+		s.authConfig.SessionToken.RefreshTokenLifetime = 30 * 24 * time.Hour
+	}
 
-	if len(s.authConfig.SessionToken.Key.Key) != 64 {
-		return RiverError(Err_BAD_CONFIG, "Invalid session token key length",
-			"len", len(s.authConfig.SessionToken.Key.Key)).
-			Func("NewService")
+	if s.authConfig.SIWE.Domain != "" && s.authConfig.SIWE.ChainID == 0 {
+		return RiverError(Err_BAD_CONFIG, "Missing SIWE chain id").Func("NewService")
 	}
 
-	key, err := hex.DecodeString(s.authConfig.SessionToken.Key.Key)
+	keys, err := parseSessionTokenKeys(s.authConfig.SessionToken.Key)
 	if err != nil {
-		return RiverError(Err_BAD_CONFIG, "Invalid session token key (not hex)").Func("NewService")
+		return AsRiverError(err).Func("NewService")
 	}
-
-	if len(key) != 32 {
-		return RiverError(Err_BAD_CONFIG, "Invalid session token key decoded length").Func("NewService")
+	if keys.signingKey == nil {
+		return RiverError(Err_BAD_CONFIG, "Missing session token signing key").Func("NewService")
 	}
 
-	s.sessionTokenSigningAlgo = s.authConfig.SessionToken.Key.Algorithm
-	s.sessionTokenSigningKey = key
+	s.sessionKeys = keys
 	s.challengePrefix = challengePrefix
+	s.smartAccounts = smartAccounts
+
+	s.challenges = challengeStore
+	if s.challenges == nil {
+		s.challenges = NewInMemoryChallengeStore()
+	}
+	s.sessions = sessionStore
+	if s.sessions == nil {
+		s.sessions = NewInMemorySessionStore()
+	}
+	s.lastCleanup.Store(time.Now().Unix())
 
 	return nil
 }
 
+// Sessions returns the store with authenticated sessions. It is passed to the authentication
+// interceptor to reject session tokens of revoked sessions.
+func (s *AuthServiceMixin) Sessions() SessionStore {
+	return s.sessions
+}
+
 func (s *AuthServiceMixin) StartAuthentication(
-	_ context.Context,
+	ctx context.Context,
 	req *connect.Request[StartAuthenticationRequest],
 ) (*connect.Response[StartAuthenticationResponse], error) {
 	var (
@@ -175,15 +237,48 @@ func (s *AuthServiceMixin) StartAuthentication(
 			Message("Unable to generate authentication challenge")
 	}
 
-	s.pendingAuthenticationRequests.Store(challenge, authChallenge)
+	if err := s.challenges.StoreChallenge(ctx, challenge[:], authChallenge.userID, authChallenge.expires); err != nil {
+		return nil, AsRiverError(err).Func("StartAuthentication")
+	}
+
+	s.maybeDeleteExpired(ctx)
 
 	return connect.NewResponse(&StartAuthenticationResponse{
 		UserId:     authChallenge.userID[:],
 		Challenge:  challenge[:],
 		Expiration: timestamppb.New(authChallenge.expires),
+		Nonce:      hex.EncodeToString(challenge[:]),
 	}), nil
 }
 
+// maybeDeleteExpired removes expired challenges and sessions from the stores in the background when the
+// last cleanup was more than cleanupInterval ago. Challenges that are never finished and sessions that are
+// never refreshed would otherwise stay in the store forever.
+func (s *AuthServiceMixin) maybeDeleteExpired(ctx context.Context) {
+	last := s.lastCleanup.Load()
+	now := time.Now()
+	if now.Sub(time.Unix(last, 0)) < cleanupInterval || !s.lastCleanup.CompareAndSwap(last, now.Unix()) {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+		defer cancel()
+
+		if deleted, err := s.challenges.DeleteExpiredChallenges(ctx, now); err != nil {
+			logging.FromCtx(ctx).Warnw("Unable to delete expired authentication challenges", "error", err)
+		} else if deleted > 0 {
+			logging.FromCtx(ctx).Debugw("Deleted expired authentication challenges", "count", deleted)
+		}
+
+		if deleted, err := s.sessions.DeleteExpiredSessions(ctx, now); err != nil {
+			logging.FromCtx(ctx).Warnw("Unable to delete expired sessions", "error", err)
+		} else if deleted > 0 {
+			logging.FromCtx(ctx).Debugw("Deleted expired sessions", "count", deleted)
+		}
+	}()
+}
+
 func (s *AuthServiceMixin) FinishAuthentication(
 	ctx context.Context,
 	req *connect.Request[FinishAuthenticationRequest],
@@ -194,42 +289,295 @@ func (s *AuthServiceMixin) FinishAuthentication(
 		challenge [challengeLength]byte
 	)
 
-	if len(msg.GetChallenge()) != challengeLength {
-		return nil, RiverError(Err_NOT_FOUND, "invalid challenge", "user", userID)
+	if msg.GetSiweMessage() != "" {
+		siweChallenge, err := s.validateSIWEMessage(msg.GetSiweMessage(), userID)
+		if err != nil {
+			return nil, AsRiverError(err).Tag("user", userID).Func("FinishAuthentication")
+		}
+		// the challenge is optional in the request, if given it must match the nonce of the message
+		if len(msg.GetChallenge()) > 0 && !bytes.Equal(msg.GetChallenge(), siweChallenge[:]) {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "challenge doesn't match SIWE message nonce", "user", userID)
+		}
+		challenge = siweChallenge
+	} else {
+		if len(msg.GetChallenge()) != challengeLength {
+			return nil, RiverError(Err_NOT_FOUND, "invalid challenge", "user", userID)
+		}
+		copy(challenge[:], msg.GetChallenge())
 	}
 
-	copy(challenge[:], msg.GetChallenge())
+	// challenge is valid for one attempt, user must start a new authentication process for a second attempt
+	challengeUserID, expires, err := s.challenges.ConsumeChallenge(ctx, challenge[:])
+	if err != nil {
+		return nil, AsRiverError(err).Tag("user", userID).Func("FinishAuthentication")
+	}
 
-	raw, found := s.pendingAuthenticationRequests.Load(challenge)
-	if !found {
+	// a challenge can only be used by the user it was issued for
+	if challengeUserID != userID {
 		return nil, RiverError(Err_NOT_FOUND, "no pending authentication challenge", "user", userID)
 	}
 
-	// challenge is valid for one attempt, user must start a new authentication process for a second attempt
-	s.pendingAuthenticationRequests.Delete(challenge)
-
 	// make sure that the caller has access to the private key from which user id was derived
-	chal := raw.(*authenticationChallenge)
-	err := chal.Verify(ctx, challenge, msg.GetSignature(), msg.GetDelegateSig(), msg.GetDelegateExpiryEpochMs())
+	chal := &authenticationChallenge{
+		challengePrefix: s.challengePrefix,
+		userID:          challengeUserID,
+		expires:         expires,
+		smartAccounts:   s.smartAccounts,
+		siweMessage:     msg.GetSiweMessage(),
+	}
+	err = chal.Verify(ctx, challenge, msg.GetSignature(), msg.GetDelegateSig(), msg.GetDelegateExpiryEpochMs())
 	if err != nil {
 		return nil, RiverError(Err_PERMISSION_DENIED, "bad signature", "user", userID, "error", err)
 	}
 
+	// create a session with a refresh token that the client can use to obtain new session tokens
+	now := time.Now()
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, AsRiverError(err).Tag("user", userID)
+	}
+
+	session := &Session{
+		ID:               uuid.New(),
+		UserID:           userID,
+		RefreshTokenHash: refreshTokenHash,
+		CreatedAt:        now,
+		LastRefreshedAt:  now,
+		ExpiresAt:        now.Add(s.authConfig.SessionToken.RefreshTokenLifetime),
+	}
+	if err := s.sessions.CreateSession(ctx, session); err != nil {
+		return nil, AsRiverError(err).Func("FinishAuthentication")
+	}
+
 	// create a JWT session token that the client can use to make notification service rpc and send it to the client
+	sessionToken, sessionTokenExpiration, err := s.signSessionToken(ctx, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&FinishAuthenticationResponse{
+		SessionToken:           sessionToken,
+		RefreshToken:           formatRefreshToken(session.ID, refreshToken),
+		SessionTokenExpiration: timestamppb.New(sessionTokenExpiration),
+		RefreshTokenExpiration: timestamppb.New(session.ExpiresAt),
+		SessionId:              session.ID[:],
+	}), nil
+}
+
+// validateSIWEMessage parses the Sign-In with Ethereum message, validates it against the service
+// configuration and returns the challenge that is used as nonce.
+func (s *AuthServiceMixin) validateSIWEMessage(
+	message string,
+	userID common.Address,
+) ([challengeLength]byte, error) {
+	var challenge [challengeLength]byte
+
+	if s.authConfig.SIWE.Domain == "" {
+		return challenge, RiverError(Err_FAILED_PRECONDITION, "Sign-In with Ethereum is not enabled")
+	}
+
+	siwe, err := parseSIWEMessage(message)
+	if err != nil {
+		return challenge, err
+	}
+
+	if siwe.Address != userID {
+		return challenge, RiverError(Err_UNAUTHENTICATED, "SIWE message address mismatch", "address", siwe.Address)
+	}
+
+	if err := siwe.validate(&s.authConfig.SIWE, time.Now()); err != nil {
+		return challenge, err
+	}
+
+	nonce, err := hex.DecodeString(siwe.Nonce)
+	if err != nil || len(nonce) != challengeLength {
+		return challenge, RiverError(Err_NOT_FOUND, "no pending authentication challenge", "nonce", siwe.Nonce)
+	}
+	copy(challenge[:], nonce)
+
+	return challenge, nil
+}
+
+// RefreshSession exchanges a refresh token for a new session token and refresh token. If the refresh token
+// is the session's previous, already rotated, refresh token it was either used twice or stolen. In both
+// cases the session is revoked, forcing the user to authenticate again. Any other mismatch is rejected
+// without touching the session since session ids aren't secret.
+func (s *AuthServiceMixin) RefreshSession(
+	ctx context.Context,
+	req *connect.Request[RefreshSessionRequest],
+) (*connect.Response[RefreshSessionResponse], error) {
+	sessionID, prevRefreshToken, err := parseRefreshToken(req.Msg.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
-	shortServiceName := s.ShortServiceName()
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	prevRefreshTokenHash := common.Hash(sha256.Sum256(prevRefreshToken))
+	session, err := s.sessions.RefreshSession(
+		ctx,
+		sessionID,
+		prevRefreshTokenHash,
+		refreshTokenHash,
+		now,
+		now.Add(s.authConfig.SessionToken.RefreshTokenLifetime),
+	)
+	if err != nil {
+		if !IsRiverErrorCode(err, Err_NOT_FOUND) {
+			return nil, AsRiverError(err).Func("RefreshSession")
+		}
+		revoked, err := s.sessions.RevokeReusedSession(ctx, sessionID, prevRefreshTokenHash)
+		if err != nil {
+			logging.FromCtx(ctx).Warnw("Unable to revoke session", "sessionId", sessionID, "error", err)
+		} else if revoked {
+			logging.FromCtx(ctx).Infow("Revoked session after refresh token reuse", "sessionId", sessionID)
+		}
+		return nil, RiverError(Err_UNAUTHENTICATED, "Invalid refresh token", "sessionId", sessionID)
+	}
+
+	sessionToken, sessionTokenExpiration, err := s.signSessionToken(ctx, session, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&RefreshSessionResponse{
+		SessionToken:           sessionToken,
+		RefreshToken:           formatRefreshToken(session.ID, refreshToken),
+		SessionTokenExpiration: timestamppb.New(sessionTokenExpiration),
+		RefreshTokenExpiration: timestamppb.New(session.ExpiresAt),
+	}), nil
+}
+
+// ListSessions returns the active sessions of the authenticated user.
+func (s *AuthServiceMixin) ListSessions(
+	ctx context.Context,
+	_ *connect.Request[ListSessionsRequest],
+) (*connect.Response[ListSessionsResponse], error) {
+	userID := UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_UNAUTHENTICATED, "missing session token").Func("ListSessions")
+	}
+
+	sessions, err := s.sessions.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, AsRiverError(err).Func("ListSessions")
+	}
+
+	current := SessionFromAuthenticatedContext(ctx)
+	resp := &ListSessionsResponse{Sessions: make([]*AuthenticationSession, 0, len(sessions))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &AuthenticationSession{
+			SessionId:       session.ID[:],
+			CreatedAt:       timestamppb.New(session.CreatedAt),
+			LastRefreshedAt: timestamppb.New(session.LastRefreshedAt),
+			ExpiresAt:       timestamppb.New(session.ExpiresAt),
+			Current:         session.ID == current,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeSessions revokes sessions of the authenticated user.
+func (s *AuthServiceMixin) RevokeSessions(
+	ctx context.Context,
+	req *connect.Request[RevokeSessionsRequest],
+) (*connect.Response[RevokeSessionsResponse], error) {
+	userID := UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_UNAUTHENTICATED, "missing session token").Func("RevokeSessions")
+	}
+
+	if !req.Msg.GetAll() && len(req.Msg.GetSessionIds()) == 0 {
+		return connect.NewResponse(&RevokeSessionsResponse{}), nil
+	}
+
+	var sessionIDs []uuid.UUID
+	if !req.Msg.GetAll() {
+		for _, id := range req.Msg.GetSessionIds() {
+			sessionID, err := uuid.FromBytes(id)
+			if err != nil {
+				return nil, RiverError(Err_INVALID_ARGUMENT, "invalid session id").Func("RevokeSessions")
+			}
+			sessionIDs = append(sessionIDs, sessionID)
+		}
+	}
+
+	revoked, err := s.sessions.RevokeSessions(ctx, userID, sessionIDs)
+	if err != nil {
+		return nil, AsRiverError(err).Func("RevokeSessions")
+	}
+
+	return connect.NewResponse(&RevokeSessionsResponse{Revoked: revoked}), nil
+}
+
+// signSessionToken creates a JWT session token for the given session.
+func (s *AuthServiceMixin) signSessionToken(
+	ctx context.Context,
+	session *Session,
+	now time.Time,
+) (string, time.Time, error) {
+	var (
+		shortServiceName = s.ShortServiceName()
+		expiration       = now.Add(s.authConfig.SessionToken.Lifetime)
+	)
+
+	// the session token must not outlive the session
+	if expiration.After(session.ExpiresAt) {
+		expiration = session.ExpiresAt
+	}
+
+	token := jwt.NewWithClaims(s.sessionKeys.method, jwt.MapClaims{
 		"aud": shortServiceName,
 		"iss": shortServiceName,
-		"sub": userID.String(),
-		"exp": now.Add(s.authConfig.SessionToken.Lifetime).Unix(),
+		"sub": session.UserID.String(),
+		"sid": session.ID.String(),
+		"exp": expiration.Unix(),
 	})
 
-	sessionToken, err := token.SignedString(s.sessionTokenSigningKey)
+	sessionToken, err := token.SignedString(s.sessionKeys.signingKey)
 	if err != nil {
 		logging.FromCtx(ctx).Errorw("Unable to sign session token", "error", err)
-		return nil, AsRiverError(err, Err_INTERNAL).Tag("user", userID)
+		return "", time.Time{}, AsRiverError(err, Err_INTERNAL).Tag("user", session.UserID)
+	}
+
+	return sessionToken, expiration, nil
+}
+
+// newRefreshToken generates the secret part of a refresh token and its hash.
+func newRefreshToken() ([]byte, common.Hash, error) {
+	secret := make([]byte, refreshTokenSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, common.Hash{}, AsRiverError(err, Err_INTERNAL).Message("Unable to generate refresh token")
+	}
+	return secret, sha256.Sum256(secret), nil
+}
+
+// formatRefreshToken encodes the refresh token as <session id>.<hex encoded secret>.
+func formatRefreshToken(sessionID uuid.UUID, secret []byte) string {
+	return sessionID.String() + "." + hex.EncodeToString(secret)
+}
+
+// parseRefreshToken decodes a refresh token created by formatRefreshToken.
+func parseRefreshToken(refreshToken string) (uuid.UUID, []byte, error) {
+	sessionIDStr, secretStr, found := strings.Cut(refreshToken, ".")
+	if !found {
+		return uuid.UUID{}, nil, RiverError(Err_UNAUTHENTICATED, "Invalid refresh token")
+	}
+
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		return uuid.UUID{}, nil, RiverError(Err_UNAUTHENTICATED, "Invalid refresh token")
+	}
+
+	secret, err := hex.DecodeString(secretStr)
+	if err != nil || len(secret) != refreshTokenSecretLength {
+		return uuid.UUID{}, nil, RiverError(Err_UNAUTHENTICATED, "Invalid refresh token")
 	}
 
-	return connect.NewResponse(&FinishAuthenticationResponse{SessionToken: sessionToken}), nil
+	return sessionID, secret, nil
 }
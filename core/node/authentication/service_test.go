@@ -0,0 +1,224 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func newTestSessionKeyConfig() config.SessionKeyConfig {
+	secret := make([]byte, 32)
+	_, _ = rand.Read(secret)
+	return config.SessionKeyConfig{Key: hex.EncodeToString(secret)}
+}
+
+func newTestAuthService(t *testing.T, key config.SessionKeyConfig) *AuthServiceMixin {
+	t.Helper()
+
+	var s AuthServiceMixin
+	require.NoError(t, s.InitAuthentication(
+		"TEST_AUTH",
+		&config.AuthenticationConfig{SessionToken: config.SessionTokenConfig{Key: key}},
+		nil,
+		nil,
+		nil,
+	))
+	return &s
+}
+
+// newTestSession creates a session for a random user and returns it with its refresh token.
+func newTestSession(t *testing.T, s *AuthServiceMixin) (*Session, string) {
+	t.Helper()
+
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	require.NoError(t, err)
+
+	now := time.Now()
+	session := &Session{
+		ID:               uuid.New(),
+		UserID:           common.BytesToAddress(refreshToken[:20]),
+		RefreshTokenHash: refreshTokenHash,
+		CreatedAt:        now,
+		LastRefreshedAt:  now,
+		ExpiresAt:        now.Add(time.Hour),
+	}
+	require.NoError(t, s.sessions.CreateSession(context.Background(), session))
+	return session, formatRefreshToken(session.ID, refreshToken)
+}
+
+func refreshSession(s *AuthServiceMixin, refreshToken string) (*RefreshSessionResponse, error) {
+	resp, err := s.RefreshSession(
+		context.Background(),
+		connect.NewRequest(&RefreshSessionRequest{RefreshToken: refreshToken}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg, nil
+}
+
+func TestRefreshSession(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("rotates the refresh token", func(t *testing.T) {
+		req := require.New(t)
+		s := newTestAuthService(t, newTestSessionKeyConfig())
+		session, refreshToken1 := newTestSession(t, s)
+
+		resp, err := refreshSession(s, refreshToken1)
+		req.NoError(err)
+		req.NotEmpty(resp.GetSessionToken())
+		refreshToken2 := resp.GetRefreshToken()
+		req.NotEqual(refreshToken1, refreshToken2)
+
+		resp, err = refreshSession(s, refreshToken2)
+		req.NoError(err)
+		req.NotEqual(refreshToken2, resp.GetRefreshToken())
+
+		_, err = s.sessions.GetSession(ctx, session.ID)
+		req.NoError(err)
+	})
+
+	t.Run("mismatch leaves the session alone", func(t *testing.T) {
+		req := require.New(t)
+		s := newTestAuthService(t, newTestSessionKeyConfig())
+		session, refreshToken := newTestSession(t, s)
+
+		// session ids aren't secret, guessing the secret must not log the user out
+		guess := make([]byte, refreshTokenSecretLength)
+		_, _ = rand.Read(guess)
+		_, err := refreshSession(s, formatRefreshToken(session.ID, guess))
+		req.True(IsRiverErrorCode(err, Err_UNAUTHENTICATED))
+
+		_, err = s.sessions.GetSession(ctx, session.ID)
+		req.NoError(err)
+		_, err = refreshSession(s, refreshToken)
+		req.NoError(err)
+	})
+
+	t.Run("reuse revokes the session", func(t *testing.T) {
+		req := require.New(t)
+		s := newTestAuthService(t, newTestSessionKeyConfig())
+		session, refreshToken1 := newTestSession(t, s)
+
+		resp, err := refreshSession(s, refreshToken1)
+		req.NoError(err)
+		refreshToken2 := resp.GetRefreshToken()
+
+		// the rotated refresh token was either used twice or stolen
+		_, err = refreshSession(s, refreshToken1)
+		req.True(IsRiverErrorCode(err, Err_UNAUTHENTICATED))
+
+		_, err = s.sessions.GetSession(ctx, session.ID)
+		req.True(IsRiverErrorCode(err, Err_NOT_FOUND))
+		_, err = refreshSession(s, refreshToken2)
+		req.True(IsRiverErrorCode(err, Err_UNAUTHENTICATED))
+	})
+
+	t.Run("malformed refresh token", func(t *testing.T) {
+		s := newTestAuthService(t, newTestSessionKeyConfig())
+		for _, refreshToken := range []string{"", "abc", uuid.NewString() + ".xyz", "abc.00"} {
+			_, err := refreshSession(s, refreshToken)
+			require.True(t, IsRiverErrorCode(err, Err_UNAUTHENTICATED), refreshToken)
+		}
+	})
+}
+
+func TestInterceptorRejectsRevokedSessions(t *testing.T) {
+	var (
+		req = require.New(t)
+		ctx = context.Background()
+		key = newTestSessionKeyConfig()
+		s   = newTestAuthService(t, key)
+	)
+
+	interceptor, err := NewAuthenticationInterceptor(s.ShortServiceName(), key, s.Sessions())
+	req.NoError(err)
+	i := interceptor.(*jwtAuthenticationInterceptor)
+
+	session, _ := newTestSession(t, s)
+	sessionToken, _, err := s.signSessionToken(ctx, session, time.Now())
+	req.NoError(err)
+
+	userID, sessionID, err := i.authorize(ctx, sessionToken)
+	req.NoError(err)
+	req.Equal(session.UserID, userID)
+	req.Equal(session.ID, sessionID)
+
+	revoked, err := s.sessions.RevokeSessions(ctx, session.UserID, nil)
+	req.NoError(err)
+	req.EqualValues(1, revoked)
+
+	// the session is cached as active for activeSessionCacheTTL
+	_, _, err = i.authorize(ctx, sessionToken)
+	req.NoError(err)
+
+	i.activeSessions.Delete(session.ID.String())
+	_, _, err = i.authorize(ctx, sessionToken)
+	req.True(IsRiverErrorCode(err, Err_UNAUTHENTICATED))
+
+	// session tokens of revoked sessions are rejected by other instances right away
+	other, err := NewAuthenticationInterceptor(s.ShortServiceName(), key, s.Sessions())
+	req.NoError(err)
+	_, _, err = other.(*jwtAuthenticationInterceptor).authorize(ctx, sessionToken)
+	req.True(IsRiverErrorCode(err, Err_UNAUTHENTICATED))
+}
+
+func TestDeleteExpired(t *testing.T) {
+	var (
+		req        = require.New(t)
+		ctx        = context.Background()
+		s          = newTestAuthService(t, newTestSessionKeyConfig())
+		sessions   = s.sessions.(*inMemorySessionStore)
+		challenges = s.challenges.(*inMemoryChallengeStore)
+		now        = time.Now()
+	)
+
+	counts := func() (int, int) {
+		sessions.mu.Lock()
+		defer sessions.mu.Unlock()
+		challenges.mu.Lock()
+		defer challenges.mu.Unlock()
+		return len(sessions.sessions), len(challenges.challenges)
+	}
+
+	newTestSession(t, s)
+	expired, _ := newTestSession(t, s)
+	expired.ExpiresAt = now.Add(-time.Minute)
+	req.NoError(s.sessions.DeleteSession(ctx, expired.ID))
+	req.NoError(s.sessions.CreateSession(ctx, expired))
+
+	var activeChallenge, expiredChallenge [challengeLength]byte
+	_, _ = rand.Read(activeChallenge[:])
+	_, _ = rand.Read(expiredChallenge[:])
+	user := common.Address{1}
+	req.NoError(s.challenges.StoreChallenge(ctx, activeChallenge[:], user, now.Add(time.Minute)))
+	req.NoError(s.challenges.StoreChallenge(ctx, expiredChallenge[:], user, now.Add(-time.Minute)))
+
+	// cleanup runs at most once per cleanupInterval
+	s.maybeDeleteExpired(ctx)
+	sessionCount, challengeCount := counts()
+	req.Equal(2, sessionCount)
+	req.Equal(2, challengeCount)
+
+	s.lastCleanup.Store(now.Add(-cleanupInterval).Unix())
+	s.maybeDeleteExpired(ctx)
+	req.Eventually(func() bool {
+		sessionCount, challengeCount := counts()
+		return sessionCount == 1 && challengeCount == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	_, _, err := s.challenges.ConsumeChallenge(ctx, activeChallenge[:])
+	req.NoError(err)
+}
@@ -0,0 +1,143 @@
+package authentication
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/hex"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+const (
+	// SessionTokenAlgorithmHS256 signs session tokens with a shared secret.
+	SessionTokenAlgorithmHS256 = "HS256"
+	// SessionTokenAlgorithmES256 signs session tokens with a P-256 private key.
+	SessionTokenAlgorithmES256 = "ES256"
+	// SessionTokenAlgorithmEdDSA signs session tokens with an ed25519 private key.
+	SessionTokenAlgorithmEdDSA = "EdDSA"
+)
+
+// sessionTokenKeys holds the keys to sign and verify session tokens.
+type sessionTokenKeys struct {
+	method jwt.SigningMethod
+	// signingKey is nil when only the public key is configured
+	signingKey      any
+	verificationKey any
+}
+
+// parseSessionTokenKeys decodes the session token keys from the given config. For HS256 the key is
+// the hex encoded 32 byte secret. For ES256 and EdDSA the key is the hex encoded PKCS#8 private key
+// and/or the hex encoded PKIX public key. If only the public key is configured the returned keys can
+// only be used to verify session tokens.
+func parseSessionTokenKeys(cfg config.SessionKeyConfig) (*sessionTokenKeys, error) {
+	switch cfg.Algorithm {
+	case "", SessionTokenAlgorithmHS256:
+		if len(cfg.Key) != 64 {
+			return nil, RiverError(Err_BAD_CONFIG, "Invalid session token key length", "len", len(cfg.Key))
+		}
+		key, err := hex.DecodeString(cfg.Key)
+		if err != nil {
+			return nil, RiverError(Err_BAD_CONFIG, "Invalid session token key (not hex)")
+		}
+		return &sessionTokenKeys{
+			method:          jwt.SigningMethodHS256,
+			signingKey:      key,
+			verificationKey: key,
+		}, nil
+	case SessionTokenAlgorithmES256:
+		keys := &sessionTokenKeys{method: jwt.SigningMethodES256}
+		if cfg.Key != "" {
+			key, err := parsePrivateSessionTokenKey(cfg.Key)
+			if err != nil {
+				return nil, err
+			}
+			ecKey, ok := key.(*ecdsa.PrivateKey)
+			if !ok || ecKey.Curve != elliptic.P256() {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token key is not a P-256 private key")
+			}
+			keys.signingKey, keys.verificationKey = ecKey, &ecKey.PublicKey
+		}
+		if cfg.PublicKey != "" {
+			key, err := parsePublicSessionTokenKey(cfg.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			ecKey, ok := key.(*ecdsa.PublicKey)
+			if !ok || ecKey.Curve != elliptic.P256() {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token public key is not a P-256 public key")
+			}
+			if signingKey, ok := keys.signingKey.(*ecdsa.PrivateKey); ok && !signingKey.PublicKey.Equal(ecKey) {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token public key doesn't match private key")
+			}
+			keys.verificationKey = ecKey
+		}
+		return keys.validate(cfg.Algorithm)
+	case SessionTokenAlgorithmEdDSA:
+		keys := &sessionTokenKeys{method: jwt.SigningMethodEdDSA}
+		if cfg.Key != "" {
+			key, err := parsePrivateSessionTokenKey(cfg.Key)
+			if err != nil {
+				return nil, err
+			}
+			edKey, ok := key.(ed25519.PrivateKey)
+			if !ok {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token key is not an ed25519 private key")
+			}
+			keys.signingKey, keys.verificationKey = edKey, edKey.Public()
+		}
+		if cfg.PublicKey != "" {
+			key, err := parsePublicSessionTokenKey(cfg.PublicKey)
+			if err != nil {
+				return nil, err
+			}
+			edKey, ok := key.(ed25519.PublicKey)
+			if !ok {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token public key is not an ed25519 public key")
+			}
+			if signingKey, ok := keys.signingKey.(ed25519.PrivateKey); ok && !edKey.Equal(signingKey.Public()) {
+				return nil, RiverError(Err_BAD_CONFIG, "Session token public key doesn't match private key")
+			}
+			keys.verificationKey = edKey
+		}
+		return keys.validate(cfg.Algorithm)
+	default:
+		return nil, RiverError(Err_BAD_CONFIG, "Unsupported session token algorithm", "algorithm", cfg.Algorithm)
+	}
+}
+
+func (k *sessionTokenKeys) validate(algorithm string) (*sessionTokenKeys, error) {
+	if k.verificationKey == nil {
+		return nil, RiverError(Err_BAD_CONFIG, "Missing session token key", "algorithm", algorithm)
+	}
+	return k, nil
+}
+
+func parsePrivateSessionTokenKey(encoded string) (any, error) {
+	der, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, RiverError(Err_BAD_CONFIG, "Invalid session token key (not hex)")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).Message("Invalid session token key (not PKCS#8)")
+	}
+	return key, nil
+}
+
+func parsePublicSessionTokenKey(encoded string) (any, error) {
+	der, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, RiverError(Err_BAD_CONFIG, "Invalid session token public key (not hex)")
+	}
+	key, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, AsRiverError(err, Err_BAD_CONFIG).Message("Invalid session token public key (not PKIX)")
+	}
+	return key, nil
+}
@@ -0,0 +1,129 @@
+package authentication
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// encodeSessionTokenKeys returns the hex encoded PKCS#8 private key and PKIX public key.
+func encodeSessionTokenKeys(t *testing.T, privateKey crypto.Signer) (string, string) {
+	t.Helper()
+
+	privateDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicDer, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	return hex.EncodeToString(privateDer), hex.EncodeToString(publicDer)
+}
+
+func signAndVerifySessionToken(t *testing.T, signer *sessionTokenKeys, verifier *sessionTokenKeys) error {
+	t.Helper()
+
+	token, err := jwt.NewWithClaims(signer.method, jwt.MapClaims{"sub": "user"}).SignedString(signer.signingKey)
+	require.NoError(t, err)
+
+	_, err = jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		return verifier.verificationKey, nil
+	}, jwt.WithValidMethods([]string{verifier.method.Alg()}))
+	return err
+}
+
+func TestParseSessionTokenKeys(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherEcKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherEdKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		algorithm string
+		key       crypto.Signer
+		otherKey  crypto.Signer
+		wrongKey  crypto.Signer
+	}{
+		{algorithm: SessionTokenAlgorithmES256, key: ecKey, otherKey: otherEcKey, wrongKey: p384Key},
+		{algorithm: SessionTokenAlgorithmEdDSA, key: edKey, otherKey: otherEdKey, wrongKey: ecKey},
+	} {
+		t.Run(tc.algorithm, func(t *testing.T) {
+			req := require.New(t)
+
+			privateKey, publicKey := encodeSessionTokenKeys(t, tc.key)
+			otherPrivateKey, otherPublicKey := encodeSessionTokenKeys(t, tc.otherKey)
+			wrongPrivateKey, wrongPublicKey := encodeSessionTokenKeys(t, tc.wrongKey)
+
+			signer, err := parseSessionTokenKeys(config.SessionKeyConfig{Algorithm: tc.algorithm, Key: privateKey})
+			req.NoError(err)
+			req.NotNil(signer.signingKey)
+			req.Equal(tc.algorithm, signer.method.Alg())
+
+			// services that only verify session tokens are configured with the public key
+			verifier, err := parseSessionTokenKeys(
+				config.SessionKeyConfig{Algorithm: tc.algorithm, PublicKey: publicKey})
+			req.NoError(err)
+			req.Nil(verifier.signingKey)
+
+			req.NoError(signAndVerifySessionToken(t, signer, signer))
+			req.NoError(signAndVerifySessionToken(t, signer, verifier))
+
+			both, err := parseSessionTokenKeys(
+				config.SessionKeyConfig{Algorithm: tc.algorithm, Key: privateKey, PublicKey: publicKey})
+			req.NoError(err)
+			req.NoError(signAndVerifySessionToken(t, both, verifier))
+
+			// tokens signed with another key are rejected
+			other, err := parseSessionTokenKeys(config.SessionKeyConfig{Algorithm: tc.algorithm, Key: otherPrivateKey})
+			req.NoError(err)
+			req.Error(signAndVerifySessionToken(t, other, verifier))
+
+			for name, cfg := range map[string]config.SessionKeyConfig{
+				"mismatching public key": {Algorithm: tc.algorithm, Key: privateKey, PublicKey: otherPublicKey},
+				"wrong private key type": {Algorithm: tc.algorithm, Key: wrongPrivateKey},
+				"wrong public key type":  {Algorithm: tc.algorithm, PublicKey: wrongPublicKey},
+				"private key not hex":    {Algorithm: tc.algorithm, Key: "xyz"},
+				"public key not pkix":    {Algorithm: tc.algorithm, PublicKey: privateKey},
+				"missing key":            {Algorithm: tc.algorithm},
+			} {
+				_, err := parseSessionTokenKeys(cfg)
+				req.True(IsRiverErrorCode(err, Err_BAD_CONFIG), name)
+			}
+		})
+	}
+
+	t.Run(SessionTokenAlgorithmHS256, func(t *testing.T) {
+		req := require.New(t)
+
+		secret := make([]byte, 32)
+		_, _ = rand.Read(secret)
+
+		keys, err := parseSessionTokenKeys(config.SessionKeyConfig{Key: hex.EncodeToString(secret)})
+		req.NoError(err)
+		req.Equal(SessionTokenAlgorithmHS256, keys.method.Alg())
+		req.NoError(signAndVerifySessionToken(t, keys, keys))
+
+		_, err = parseSessionTokenKeys(config.SessionKeyConfig{Key: hex.EncodeToString(secret[:16])})
+		req.True(IsRiverErrorCode(err, Err_BAD_CONFIG))
+	})
+
+	t.Run("unsupported algorithm", func(t *testing.T) {
+		_, err := parseSessionTokenKeys(config.SessionKeyConfig{Algorithm: "RS256", Key: "00"})
+		require.True(t, IsRiverErrorCode(err, Err_BAD_CONFIG))
+	})
+}
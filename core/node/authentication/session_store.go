@@ -0,0 +1,208 @@
+package authentication
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// Session is created when a user authenticates and lives as long as the user keeps refreshing it
+// and doesn't revoke it. Session tokens reference the session they were issued for through the
+// sid claim which allows the service to reject session tokens of revoked sessions.
+type Session struct {
+	ID     uuid.UUID
+	UserID common.Address
+	// RefreshTokenHash is the sha256 hash of the secret part of the current refresh token.
+	RefreshTokenHash common.Hash
+	// PrevRefreshTokenHash is the hash of the refresh token that was rotated last, zero when the
+	// session was never refreshed.
+	PrevRefreshTokenHash common.Hash
+	CreatedAt            time.Time
+	LastRefreshedAt      time.Time
+	// ExpiresAt is the time the refresh token, and therefore the session, expires.
+	ExpiresAt time.Time
+}
+
+// SessionStore keeps the authenticated sessions. Services that run multiple instances must use
+// a store that is shared between instances, e.g. PostgresSessionStore.
+type SessionStore interface {
+	// CreateSession stores a new session.
+	CreateSession(ctx context.Context, session *Session) error
+
+	// GetSession returns the session with the given id. It returns an Err_NOT_FOUND error when the
+	// session doesn't exist, was revoked or has expired.
+	GetSession(ctx context.Context, sessionID uuid.UUID) (*Session, error)
+
+	// RefreshSession replaces the refresh token hash of the session when prevRefreshTokenHash matches
+	// the current refresh token hash and the session hasn't expired. The replaced hash is kept as the
+	// session's PrevRefreshTokenHash. It returns the refreshed session or an Err_NOT_FOUND error when
+	// no such session exists.
+	RefreshSession(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		prevRefreshTokenHash common.Hash,
+		refreshTokenHash common.Hash,
+		refreshedAt time.Time,
+		expiresAt time.Time,
+	) (*Session, error)
+
+	// RevokeReusedSession removes the session when refreshTokenHash matches the hash of its last
+	// rotated refresh token. It returns true when the session was removed.
+	RevokeReusedSession(ctx context.Context, sessionID uuid.UUID, refreshTokenHash common.Hash) (bool, error)
+
+	// ListSessions returns the active sessions of the given user.
+	ListSessions(ctx context.Context, userID common.Address) ([]*Session, error)
+
+	// RevokeSessions removes the given sessions of the user, or all sessions of the user when
+	// sessionIDs is empty. It returns the number of revoked sessions.
+	RevokeSessions(ctx context.Context, userID common.Address, sessionIDs []uuid.UUID) (int64, error)
+
+	// DeleteSession removes the session with the given id, regardless of the user it belongs to.
+	DeleteSession(ctx context.Context, sessionID uuid.UUID) error
+
+	// DeleteExpiredSessions removes sessions that expired before the given time and returns the
+	// number of removed sessions.
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error)
+}
+
+// inMemorySessionStore keeps sessions in process memory. It is used when no shared store is configured.
+// Sessions are lost when the service restarts.
+type inMemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[uuid.UUID]Session
+}
+
+var _ SessionStore = (*inMemorySessionStore)(nil)
+
+// NewInMemorySessionStore creates a SessionStore that keeps sessions in process memory.
+func NewInMemorySessionStore() SessionStore {
+	return &inMemorySessionStore{
+		sessions: make(map[uuid.UUID]Session),
+	}
+}
+
+func (s *inMemorySessionStore) CreateSession(_ context.Context, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.sessions[session.ID]; exists {
+		return RiverError(Err_ALREADY_EXISTS, "session already exists", "sessionId", session.ID)
+	}
+	s.sessions[session.ID] = *session
+	return nil
+}
+
+func (s *inMemorySessionStore) GetSession(_ context.Context, sessionID uuid.UUID) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, found := s.sessions[sessionID]
+	if !found || !time.Now().Before(session.ExpiresAt) {
+		return nil, RiverError(Err_NOT_FOUND, "session not found", "sessionId", sessionID)
+	}
+	return &session, nil
+}
+
+func (s *inMemorySessionStore) RefreshSession(
+	_ context.Context,
+	sessionID uuid.UUID,
+	prevRefreshTokenHash common.Hash,
+	refreshTokenHash common.Hash,
+	refreshedAt time.Time,
+	expiresAt time.Time,
+) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, found := s.sessions[sessionID]
+	if !found || session.RefreshTokenHash != prevRefreshTokenHash || !refreshedAt.Before(session.ExpiresAt) {
+		return nil, RiverError(Err_NOT_FOUND, "session not found", "sessionId", sessionID)
+	}
+
+	session.PrevRefreshTokenHash = session.RefreshTokenHash
+	session.RefreshTokenHash = refreshTokenHash
+	session.LastRefreshedAt = refreshedAt
+	session.ExpiresAt = expiresAt
+	s.sessions[sessionID] = session
+
+	return &session, nil
+}
+
+func (s *inMemorySessionStore) RevokeReusedSession(
+	_ context.Context,
+	sessionID uuid.UUID,
+	refreshTokenHash common.Hash,
+) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, found := s.sessions[sessionID]
+	if !found || session.PrevRefreshTokenHash == (common.Hash{}) || session.PrevRefreshTokenHash != refreshTokenHash {
+		return false, nil
+	}
+	delete(s.sessions, sessionID)
+	return true, nil
+}
+
+func (s *inMemorySessionStore) ListSessions(_ context.Context, userID common.Address) ([]*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var sessions []*Session
+	for _, session := range s.sessions {
+		if session.UserID == userID && now.Before(session.ExpiresAt) {
+			sessions = append(sessions, &session)
+		}
+	}
+
+	slices.SortFunc(sessions, func(a, b *Session) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return sessions, nil
+}
+
+func (s *inMemorySessionStore) RevokeSessions(
+	_ context.Context,
+	userID common.Address,
+	sessionIDs []uuid.UUID,
+) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var revoked int64
+	for id, session := range s.sessions {
+		if session.UserID == userID && (len(sessionIDs) == 0 || slices.Contains(sessionIDs, id)) {
+			delete(s.sessions, id)
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func (s *inMemorySessionStore) DeleteSession(_ context.Context, sessionID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.sessions, sessionID)
+	return nil
+}
+
+func (s *inMemorySessionStore) DeleteExpiredSessions(_ context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	for id, session := range s.sessions {
+		if session.ExpiresAt.Before(before) {
+			delete(s.sessions, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
@@ -0,0 +1,178 @@
+package authentication
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+const (
+	siweHeaderSuffix = " wants you to sign in with your Ethereum account:"
+	// siweClockSkew is the tolerated difference between the client and server clock.
+	siweClockSkew = time.Minute
+)
+
+// siweMessage is a parsed Sign-In with Ethereum (EIP-4361) message.
+type siweMessage struct {
+	Scheme         string
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        uint64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// parseSIWEMessage parses the given EIP-4361 message.
+func parseSIWEMessage(message string) (*siweMessage, error) {
+	lines := strings.Split(message, "\n")
+	if len(lines) < 3 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message")
+	}
+
+	var msg siweMessage
+
+	// [scheme "://"] domain " wants you to sign in with your Ethereum account:"
+	origin, found := strings.CutSuffix(lines[0], siweHeaderSuffix)
+	if !found || origin == "" {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message header")
+	}
+	if scheme, domain, found := strings.Cut(origin, "://"); found {
+		msg.Scheme, msg.Domain = scheme, domain
+	} else {
+		msg.Domain = origin
+	}
+
+	if !common.IsHexAddress(lines[1]) || !strings.HasPrefix(lines[1], "0x") {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message address")
+	}
+	msg.Address = common.HexToAddress(lines[1])
+
+	// an optional statement surrounded by empty lines precedes the fields
+	i := 2
+	var statement []string
+	for ; i < len(lines) && !strings.HasPrefix(lines[i], "URI: "); i++ {
+		if lines[i] != "" {
+			statement = append(statement, lines[i])
+		}
+	}
+	if len(statement) > 1 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message statement")
+	}
+	if len(statement) == 1 {
+		msg.Statement = statement[0]
+	}
+
+	fields := make(map[string]string)
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "Resources:" {
+			for i++; i < len(lines); i++ {
+				resource, found := strings.CutPrefix(lines[i], "- ")
+				if !found {
+					return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message resource")
+				}
+				msg.Resources = append(msg.Resources, resource)
+			}
+			break
+		}
+
+		key, value, found := strings.Cut(line, ": ")
+		if !found {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message field", "line", line)
+		}
+		if _, exists := fields[key]; exists {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Duplicate SIWE message field", "field", key)
+		}
+		fields[key] = value
+	}
+
+	for key, value := range fields {
+		var err error
+		switch key {
+		case "URI":
+			msg.URI = value
+		case "Version":
+			msg.Version = value
+		case "Chain ID":
+			msg.ChainID, err = strconv.ParseUint(value, 10, 64)
+		case "Nonce":
+			msg.Nonce = value
+		case "Issued At":
+			msg.IssuedAt, err = time.Parse(time.RFC3339, value)
+		case "Expiration Time":
+			var expirationTime time.Time
+			expirationTime, err = time.Parse(time.RFC3339, value)
+			msg.ExpirationTime = &expirationTime
+		case "Not Before":
+			var notBefore time.Time
+			notBefore, err = time.Parse(time.RFC3339, value)
+			msg.NotBefore = &notBefore
+		case "Request ID":
+			msg.RequestID = value
+		default:
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Unknown SIWE message field", "field", key)
+		}
+		if err != nil {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid SIWE message field", "field", key, "error", err)
+		}
+	}
+
+	for _, required := range []string{"URI", "Version", "Chain ID", "Nonce", "Issued At"} {
+		if _, ok := fields[required]; !ok {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Missing SIWE message field", "field", required)
+		}
+	}
+
+	return &msg, nil
+}
+
+// validate checks that the message is meant for this service and is valid at the given time.
+func (m *siweMessage) validate(cfg *config.SIWEConfig, now time.Time) error {
+	if m.Domain != cfg.Domain {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message domain mismatch", "domain", m.Domain)
+	}
+
+	if cfg.URI != "" {
+		if m.URI != cfg.URI {
+			return RiverError(Err_UNAUTHENTICATED, "SIWE message uri mismatch", "uri", m.URI)
+		}
+	} else if uri, err := url.Parse(m.URI); err != nil || uri.Host != cfg.Domain {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message uri mismatch", "uri", m.URI)
+	}
+
+	if m.Version != "1" {
+		return RiverError(Err_UNAUTHENTICATED, "Unsupported SIWE message version", "version", m.Version)
+	}
+
+	if m.ChainID != cfg.ChainID {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message chain id mismatch", "chainId", m.ChainID)
+	}
+
+	if m.IssuedAt.After(now.Add(siweClockSkew)) {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message issued in the future", "issuedAt", m.IssuedAt)
+	}
+
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message expired", "expirationTime", *m.ExpirationTime)
+	}
+
+	if m.NotBefore != nil && now.Add(siweClockSkew).Before(*m.NotBefore) {
+		return RiverError(Err_UNAUTHENTICATED, "SIWE message not yet valid", "notBefore", *m.NotBefore)
+	}
+
+	return nil
+}
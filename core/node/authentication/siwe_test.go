@@ -0,0 +1,89 @@
+package authentication
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+)
+
+const testSIWEMessage = `https://app.towns.com wants you to sign in with your Ethereum account:
+0x71C7656EC7ab88b098defB751B7401B5f6d8976F
+
+Sign in to Towns notifications.
+
+URI: https://app.towns.com/login
+Version: 1
+Chain ID: 8453
+Nonce: 00112233445566778899aabbccddeeff
+Issued At: 2026-01-01T12:00:00Z
+Expiration Time: 2026-01-01T12:10:00Z
+Resources:
+- https://towns.com/terms`
+
+func TestParseSIWEMessage(t *testing.T) {
+	req := require.New(t)
+
+	msg, err := parseSIWEMessage(testSIWEMessage)
+	req.NoError(err)
+	req.Equal("https", msg.Scheme)
+	req.Equal("app.towns.com", msg.Domain)
+	req.Equal(common.HexToAddress("0x71C7656EC7ab88b098defB751B7401B5f6d8976F"), msg.Address)
+	req.Equal("Sign in to Towns notifications.", msg.Statement)
+	req.Equal("https://app.towns.com/login", msg.URI)
+	req.Equal("1", msg.Version)
+	req.EqualValues(8453, msg.ChainID)
+	req.Equal("00112233445566778899aabbccddeeff", msg.Nonce)
+	req.Equal(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), msg.IssuedAt.UTC())
+	req.NotNil(msg.ExpirationTime)
+	req.Nil(msg.NotBefore)
+	req.Equal([]string{"https://towns.com/terms"}, msg.Resources)
+
+	for name, invalid := range map[string]string{
+		"header":        strings.Replace(testSIWEMessage, "wants you to sign in", "wants to sign in", 1),
+		"address":       strings.Replace(testSIWEMessage, "0x71C7656EC7ab88b098defB751B7401B5f6d8976F", "0x71C7", 1),
+		"missing nonce": strings.Replace(testSIWEMessage, "Nonce: 00112233445566778899aabbccddeeff\n", "", 1),
+		"unknown field": strings.Replace(testSIWEMessage, "Version: 1", "Version: 1\nFoo: bar", 1),
+		"duplicate":     strings.Replace(testSIWEMessage, "Version: 1", "Version: 1\nVersion: 1", 1),
+		"issued at":     strings.Replace(testSIWEMessage, "2026-01-01T12:00:00Z", "yesterday", 1),
+	} {
+		_, err := parseSIWEMessage(invalid)
+		req.Error(err, name)
+	}
+}
+
+func TestValidateSIWEMessage(t *testing.T) {
+	req := require.New(t)
+
+	msg, err := parseSIWEMessage(testSIWEMessage)
+	req.NoError(err)
+
+	var (
+		cfg      = &config.SIWEConfig{Domain: "app.towns.com", ChainID: 8453}
+		issuedAt = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	req.NoError(msg.validate(cfg, issuedAt.Add(time.Minute)))
+	req.NoError(msg.validate(&config.SIWEConfig{
+		Domain:  "app.towns.com",
+		URI:     "https://app.towns.com/login",
+		ChainID: 8453,
+	}, issuedAt))
+
+	// expired and issued in the future
+	req.Error(msg.validate(cfg, issuedAt.Add(10*time.Minute)))
+	req.Error(msg.validate(cfg, issuedAt.Add(-2*time.Minute)))
+
+	// not meant for this service
+	req.Error(msg.validate(&config.SIWEConfig{Domain: "evil.com", ChainID: 8453}, issuedAt))
+	req.Error(msg.validate(&config.SIWEConfig{Domain: "app.towns.com", ChainID: 1}, issuedAt))
+	req.Error(msg.validate(&config.SIWEConfig{
+		Domain:  "app.towns.com",
+		URI:     "https://app.towns.com/other",
+		ChainID: 8453,
+	}, issuedAt))
+}
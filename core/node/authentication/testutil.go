@@ -42,6 +42,18 @@ func GetAuthenticationToken[T any](
 	primaryWallet *crypto.Wallet,
 	request *connect.Request[T],
 ) string {
+	return FinishAuthentication(ctx, challengePrefix, req, authClient, primaryWallet).GetSessionToken()
+}
+
+// FinishAuthentication completes the authentication challenge-response for the supplied wallet and
+// returns the issued session token, refresh token and session id.
+func FinishAuthentication(
+	ctx context.Context,
+	challengePrefix string,
+	req *require.Assertions,
+	authClient protocolconnect.AuthenticationServiceClient,
+	primaryWallet *crypto.Wallet,
+) *FinishAuthenticationResponse {
 	resp, err := authClient.StartAuthentication(ctx, connect.NewRequest(&StartAuthenticationRequest{
 		UserId: primaryWallet.Address[:],
 	}))
@@ -90,5 +102,5 @@ func GetAuthenticationToken[T any](
 
 	req.NoError(err)
 
-	return resp2.Msg.SessionToken
+	return resp2.Msg
 }
@@ -50,6 +50,7 @@ const (
 	XChainBlockchainsConfigKey                      = "xchain.blockchains"
 	StreamEphemeralStreamTTLMsKey                   = "stream.ephemeralStreamTTLMs"
 	NodeBlocklistConfigKey                          = "node.blocklist"
+	NodeServiceAddressesConfigKey                   = "node.serviceaddresses"
 	StreamSnapshotIntervalInMiniblocksConfigKey     = "stream.snapshotIntervalInMiniblocks"
 	StreamTrimActivationFactorConfigKey             = "stream.trimactivationfactor"
 	StreamTrimByStreamIdConfigKey                   = "stream.trimbystreamid"
@@ -70,6 +71,20 @@ const (
 	StreamUserInboxStreamHistoryMiniblocksConfigKey    = "stream.historyminiblocks.a1"
 	StreamUserSettingsStreamHistoryMiniblocksConfigKey = "stream.historyminiblocks.a5"
 
+	StreamDefaultStreamHistoryRetentionConfigKey      = "stream.historyretention.default.ageSeconds"
+	StreamChannelStreamHistoryRetentionConfigKey      = "stream.historyretention.20.ageSeconds"
+	StreamDMStreamHistoryRetentionConfigKey           = "stream.historyretention.88.ageSeconds"
+	StreamGDMStreamHistoryRetentionConfigKey          = "stream.historyretention.77.ageSeconds"
+	StreamMetadataStreamHistoryRetentionConfigKey     = "stream.historyretention.dd.ageSeconds"
+	StreamSpaceStreamHistoryRetentionConfigKey        = "stream.historyretention.10.ageSeconds"
+	StreamUserStreamHistoryRetentionConfigKey         = "stream.historyretention.a8.ageSeconds"
+	StreamUserDeviceStreamHistoryRetentionConfigKey   = "stream.historyretention.ad.ageSeconds"
+	StreamUserInboxStreamHistoryRetentionConfigKey    = "stream.historyretention.a1.ageSeconds"
+	StreamUserSettingsStreamHistoryRetentionConfigKey = "stream.historyretention.a5.ageSeconds"
+
+	// StreamHistoryRetentionBySpaceConfigKey is the key for the list of per-space history retention policies.
+	StreamHistoryRetentionBySpaceConfigKey = "stream.historyretentionbyspace"
+
 	// StreamMaxEventsPerMiniblockKey is the maximum number of events in a miniblock.
 	StreamMaxEventsPerMiniblockKey = "stream.maxeventsperminiblock"
 
@@ -97,6 +112,11 @@ const (
 	// loaded nodes when selecting from required operator nodes.
 	StreamDistributionMaxBalancingAdvantageConfigKey = "stream.distribution.maxbalancingadvantage"
 
+	// StreamDistributionNodeLabelsConfigKey is the key for the per-node placement labels (capacity,
+	// region and zone) that the distributor uses to weight nodes by capacity and to spread the
+	// replicas of a stream over failure domains.
+	StreamDistributionNodeLabelsConfigKey = "stream.distribution.nodelabels"
+
 	// StreamDistributionMinBalancingAdvantageDefault is the default minimum balancing advantage (5%).
 	StreamDistributionMinBalancingAdvantageDefault = 500
 
@@ -178,6 +198,10 @@ type OnChainSettings struct {
 
 	NodeBlocklist []common.Address `mapstructure:"node.blocklist"`
 
+	// NodeServiceAddresses are the wallet addresses of node services, e.g. archivers and notification
+	// services, that are allowed to read full miniblocks including expired messages next to registered nodes.
+	NodeServiceAddresses []common.Address `mapstructure:"node.serviceaddresses"`
+
 	// StreamSnapshotIntervalInMiniblocks is the interval in miniblocks between snapshots.
 	StreamSnapshotIntervalInMiniblocks uint64 `mapstructure:"stream.snapshotIntervalInMiniblocks"`
 
@@ -207,6 +231,16 @@ type OnChainSettings struct {
 	// 0 means keep all miniblocks.
 	StreamHistoryMiniblocks StreamHistoryMiniblocks `mapstructure:",squash"`
 
+	// Maximum age of the miniblocks to keep for each type of stream, based on the miniblock timestamp.
+	// 0 means miniblocks are not trimmed by age.
+	StreamHistoryRetention StreamHistoryRetention `mapstructure:",squash"`
+
+	// StreamHistoryRetentionBySpace is a list of per-space history retention policies.
+	// A policy applies to the space stream and its channels and can only shorten the per-type retention.
+	// Policies are set by the operators through the on-chain configuration, space owners can't opt in
+	// themselves.
+	StreamHistoryRetentionBySpace []StreamIdRetention `mapstructure:"stream.historyretentionbyspace"`
+
 	// StreamMaxEventsPerMiniblock is the maximum number of events that can be included in a miniblock.
 	StreamMaxEventsPerMiniblock uint64 `mapstructure:"stream.maxeventsperminiblock"`
 	// StreamMaxTotalEventsSizePerMiniblock is the maximum size (in bytes) of all protobuf encoded events
@@ -256,6 +290,72 @@ func (s StreamHistoryMiniblocks) ForType(streamType byte) uint64 {
 	return ret
 }
 
+type StreamHistoryRetention struct {
+	Default      time.Duration `mapstructure:"stream.historyretention.default.ageSeconds"`
+	Channel      time.Duration `mapstructure:"stream.historyretention.20.ageSeconds"`
+	DM           time.Duration `mapstructure:"stream.historyretention.88.ageSeconds"`
+	GDM          time.Duration `mapstructure:"stream.historyretention.77.ageSeconds"`
+	Metadata     time.Duration `mapstructure:"stream.historyretention.dd.ageSeconds"`
+	Space        time.Duration `mapstructure:"stream.historyretention.10.ageSeconds"`
+	User         time.Duration `mapstructure:"stream.historyretention.a8.ageSeconds"`
+	UserDevice   time.Duration `mapstructure:"stream.historyretention.ad.ageSeconds"`
+	UserInbox    time.Duration `mapstructure:"stream.historyretention.a1.ageSeconds"`
+	UserSettings time.Duration `mapstructure:"stream.historyretention.a5.ageSeconds"`
+}
+
+func (s StreamHistoryRetention) ForType(streamType byte) time.Duration {
+	var ret time.Duration
+	switch streamType {
+	case shared.STREAM_CHANNEL_BIN:
+		ret = s.Channel
+	case shared.STREAM_DM_CHANNEL_BIN:
+		ret = s.DM
+	case shared.STREAM_GDM_CHANNEL_BIN:
+		ret = s.GDM
+	case shared.STREAM_METADATA_BIN:
+		ret = s.Metadata
+	case shared.STREAM_SPACE_BIN:
+		ret = s.Space
+	case shared.STREAM_USER_BIN:
+		ret = s.User
+	case shared.STREAM_USER_METADATA_KEY_BIN:
+		ret = s.UserDevice
+	case shared.STREAM_USER_INBOX_BIN:
+		ret = s.UserInbox
+	case shared.STREAM_USER_SETTINGS_BIN:
+		ret = s.UserSettings
+	}
+	// If value for streamType is not explicitly set, fallback to the default for all streams.
+	if ret == 0 {
+		ret = s.Default
+	}
+	return ret
+}
+
+// HistoryRetentionForStream returns the maximum age of the miniblocks to keep for the given stream.
+// It is the shortest of the per-type retention and the retention of the space the stream belongs to,
+// 0 means miniblocks are not trimmed by age.
+func (s *OnChainSettings) HistoryRetentionForStream(streamId shared.StreamId) time.Duration {
+	retention := s.StreamHistoryRetention.ForType(streamId.Type())
+
+	var spaceId shared.StreamId
+	switch streamId.Type() {
+	case shared.STREAM_SPACE_BIN:
+		spaceId = streamId
+	case shared.STREAM_CHANNEL_BIN:
+		spaceId = streamId.SpaceID()
+	default:
+		return retention
+	}
+
+	for _, entry := range s.StreamHistoryRetentionBySpace {
+		if entry.StreamId == spaceId && entry.Age > 0 && (retention == 0 || entry.Age < retention) {
+			retention = entry.Age
+		}
+	}
+	return retention
+}
+
 type XChainSettings struct {
 	Blockchains []uint64 `mapstructure:"xchain.blockchains"`
 }
@@ -315,6 +415,11 @@ type StreamDistribution struct {
 	// less-loaded node has over more loaded nodes when selecting from required operator nodes.
 	// Defaults to 750 (7.5%) when not set.
 	MaxBalancingAdvantage uint64 `mapstructure:"stream.distribution.maxbalancingadvantage"`
+	// NodeLabels declares the capacity and failure domain of nodes as a semicolon separated list of
+	// `<node address>:<key>=<value>,...` entries with the keys capacity, region and zone, e.g.
+	// `0x12..ab:capacity=4,region=us-east,zone=us-east-1a;0x34..cd:capacity=2,region=eu-west`.
+	// Nodes without labels have capacity 1 and belong to no region/zone.
+	NodeLabels string `mapstructure:"stream.distribution.nodelabels"`
 }
 
 // StreamIdMiniblock represents a per-stream trim target configuration.
@@ -328,6 +433,17 @@ func (s StreamIdMiniblock) MarshalText() (text []byte, err error) {
 	return []byte(fmt.Sprintf("%x@%d", s.StreamId, s.MiniblockNum)), nil
 }
 
+// StreamIdRetention represents a per-space history retention policy.
+// StreamId is the 32-byte space stream identifier, Age is the maximum age of the miniblocks to keep.
+type StreamIdRetention struct {
+	StreamId [32]byte
+	Age      time.Duration
+}
+
+func (s StreamIdRetention) MarshalText() (text []byte, err error) {
+	return []byte(fmt.Sprintf("%x@%s", s.StreamId, s.Age)), nil
+}
+
 func DefaultOnChainSettings() *OnChainSettings {
 	return &OnChainSettings{
 		MediaMaxChunkCount: 21,
@@ -353,6 +469,7 @@ func DefaultOnChainSettings() *OnChainSettings {
 		StreamSnapshotIntervalInMiniblocks: 0, // 0 means snapshots trimming is disabled
 		StreamTrimActivationFactor:         0, // 0 means snapshots trimming is disabled
 		StreamTrimByStreamId:               []StreamIdMiniblock{},
+		StreamHistoryRetentionBySpace:      []StreamIdRetention{},
 
 		StreamHistoryMiniblocks: StreamHistoryMiniblocks{
 			UserInbox:    5000,
@@ -694,6 +811,83 @@ func (occ *onChainConfiguration) applyEvent(ctx context.Context, event *river.Ri
 	occ.processRawSettings(ctx, blockchain.BlockNumber(event.Block))
 }
 
+// NewOnChainConfigFromSettings creates an on-chain configuration from the given settings in the same way as
+// the node loads them from the registry contract. The configuration is not updated when settings change on chain.
+func NewOnChainConfigFromSettings(
+	ctx context.Context,
+	settings []river.Setting,
+	activeBlock blockchain.BlockNumber,
+) (OnChainConfiguration, error) {
+	return makeOnChainConfig(ctx, settings, nil, activeBlock)
+}
+
+// DecodeOnChainSettings applies the given ABI encoded setting values on top of base and returns the result.
+// Unlike the node, which ignores settings it can't decode, it returns an error for unknown keys and for
+// values that can't be decoded into the setting type.
+func DecodeOnChainSettings(
+	ctx context.Context,
+	base *OnChainSettings,
+	values map[string][]byte,
+) (*OnChainSettings, error) {
+	knownKeys := make(map[string]string)
+	for key := range AllKnownOnChainSettingKeys() {
+		knownKeys[strings.ToLower(key)] = key
+	}
+
+	input := make(map[string]any, len(values))
+	for key, value := range values {
+		name, ok := knownKeys[strings.ToLower(key)]
+		if !ok {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "key is not known", "key", key).
+				Func("DecodeOnChainSettings")
+		}
+		input[name] = value
+	}
+
+	setting := *base
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:      &setting,
+		DecodeHook:  abiBytesDecodeHook(ctx, true),
+		ErrorUnused: true,
+	})
+	if err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Func("DecodeOnChainSettings")
+	}
+	if err := decoder.Decode(input); err != nil {
+		return nil, AsRiverError(err, Err_INVALID_ARGUMENT).Func("DecodeOnChainSettings")
+	}
+	return &setting, nil
+}
+
+// OnChainSettingChange describes the change of a single on-chain setting.
+type OnChainSettingChange struct {
+	Key string
+	Old any
+	New any
+}
+
+// DiffOnChainSettings returns the settings that differ between from and to, sorted by key.
+func DiffOnChainSettings(from *OnChainSettings, to *OnChainSettings) ([]OnChainSettingChange, error) {
+	var fromMap, toMap map[string]any
+	if err := mapstructure.Decode(from, &fromMap); err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Func("DiffOnChainSettings")
+	}
+	if err := mapstructure.Decode(to, &toMap); err != nil {
+		return nil, AsRiverError(err, Err_INTERNAL).Func("DiffOnChainSettings")
+	}
+
+	var changes []OnChainSettingChange
+	for key, newValue := range toMap {
+		if oldValue := fromMap[key]; !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, OnChainSettingChange{Key: key, Old: oldValue, New: newValue})
+		}
+	}
+	slices.SortFunc(changes, func(a, b OnChainSettingChange) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return changes, nil
+}
+
 var (
 	AbiTypeName_Int64        = "int64"
 	AbiTypeName_Uint64       = "uint64"
@@ -729,6 +923,13 @@ var (
 		{Name: "streamId", Type: "bytes32"},
 		{Name: "miniblockNum", Type: "uint64"},
 	})
+
+	// streamIdRetentionArrayType is the ABI type for encoding/decoding StreamIdRetention arrays.
+	// Encoded as tuple[](bytes32 streamId, uint64 ageSeconds).
+	streamIdRetentionArrayType, _ = abi.NewType("tuple[]", "StreamIdRetention[]", []abi.ArgumentMarshaling{
+		{Name: "streamId", Type: "bytes32"},
+		{Name: "ageSeconds", Type: "uint64"},
+	})
 )
 
 // ABIEncodeInt64 returns Solidity abi.encode(i)
@@ -865,17 +1066,61 @@ func ABIDecodeStreamIdMiniblockArray(data []byte) ([]StreamIdMiniblock, error) {
 	return result, nil
 }
 
+// streamIdRetentionTuple is an internal type used for ABI encoding/decoding.
+// The field names and types must match the ABI definition in streamIdRetentionArrayType.
+type streamIdRetentionTuple struct {
+	StreamId   [32]byte
+	AgeSeconds uint64
+}
+
+func ABIEncodeStreamIdRetentionArray(items []StreamIdRetention) []byte {
+	tuples := make([]streamIdRetentionTuple, len(items))
+	for i, item := range items {
+		tuples[i].StreamId = item.StreamId
+		tuples[i].AgeSeconds = uint64(item.Age / time.Second)
+	}
+	value, err := abi.Arguments{{Type: streamIdRetentionArrayType}}.Pack(tuples)
+	if err != nil {
+		return nil
+	}
+	return value
+}
+
+func ABIDecodeStreamIdRetentionArray(data []byte) ([]StreamIdRetention, error) {
+	args, err := abi.Arguments{{Type: streamIdRetentionArrayType}}.Unpack(data)
+	if err != nil {
+		return nil, err
+	}
+	unpacked := args[0].([]struct {
+		StreamId   [32]byte `json:"streamId"`
+		AgeSeconds uint64   `json:"ageSeconds"`
+	})
+	result := make([]StreamIdRetention, len(unpacked))
+	for i, item := range unpacked {
+		result[i].StreamId = item.StreamId
+		result[i].Age = time.Duration(item.AgeSeconds) * time.Second
+	}
+	return result, nil
+}
+
 var (
 	commonAddressType              = reflect.TypeOf(common.Address{})
 	commonAddressArrayType         = reflect.TypeOf([]common.Address{})
 	streamIdMiniblockArrayReflType = reflect.TypeOf([]StreamIdMiniblock{})
+	streamIdRetentionArrayReflType = reflect.TypeOf([]StreamIdRetention{})
 )
 
 func abiBytesToTypeDecoder(ctx context.Context) mapstructure.DecodeHookFuncValue {
+	return abiBytesDecodeHook(ctx, false)
+}
+
+// abiBytesDecodeHook returns a decode hook that decodes ABI encoded setting values into the setting type.
+// If strict is false decoding errors are logged and the setting is left unchanged, a bad setting value
+// on chain must not prevent the node from loading its configuration. If strict is true decoding errors
+// are returned.
+func abiBytesDecodeHook(ctx context.Context, strict bool) mapstructure.DecodeHookFuncValue {
 	log := logging.FromCtx(ctx)
 	return func(from reflect.Value, to reflect.Value) (interface{}, error) {
-		// This function ignores decoding errors.
-		// If there is bad setting value on chain, it will be ignored.
 		if from.Kind() == reflect.Map {
 			// Preprocess durations based on name suffix.
 			mapValue, ok := from.Interface().(map[string]interface{})
@@ -887,7 +1132,15 @@ func abiBytesToTypeDecoder(ctx context.Context) mapstructure.DecodeHookFuncValue
 					bb, ok := value.([]byte)
 					if (ms || sec) && ok {
 						vv, err := ABIDecodeInt64(bb)
+						if err == nil && strict && len(bb) != 32 {
+							err = RiverError(Err_INVALID_ARGUMENT, "integer setting is not encoded in 32 bytes")
+						}
 						if err != nil {
+							if strict {
+								return nil, AsRiverError(err, Err_INVALID_ARGUMENT).
+									Message("failed to decode int64").
+									Tags("key", key, "bytes", bb)
+							}
 							log.Errorw("failed to decode int64", "key", key, "error", err, "bytes", bb)
 							badKeys = append(badKeys, key)
 							continue
@@ -906,54 +1159,49 @@ func abiBytesToTypeDecoder(ctx context.Context) mapstructure.DecodeHookFuncValue
 				}
 			}
 		} else if from.Kind() == reflect.Slice && from.Type().Elem().Kind() == reflect.Uint8 {
+			var (
+				v   any
+				err error
+			)
 			if to.Kind() == reflect.Int64 || to.Kind() == reflect.Int {
-				v, err := ABIDecodeInt64(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode int64", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeInt64(from.Bytes())
 			} else if to.Kind() == reflect.Uint64 || to.Kind() == reflect.Uint {
-				v, err := ABIDecodeUint64(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode uint64", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeUint64(from.Bytes())
 			} else if to.Kind() == reflect.String {
-				v, err := ABIDecodeString(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode string", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeString(from.Bytes())
 			} else if to.Kind() == reflect.Slice && to.Type().Elem().Kind() == reflect.Uint64 {
-				v, err := ABIDecodeUint64Array(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode []uint64", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeUint64Array(from.Bytes())
 			} else if to.Type() == commonAddressType {
-				v, err := ABIDecodeAddress(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode []address", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeAddress(from.Bytes())
 			} else if to.Type() == commonAddressArrayType {
-				v, err := ABIDecodeAddressArray(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode []address", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeAddressArray(from.Bytes())
 			} else if to.Type() == streamIdMiniblockArrayReflType {
-				v, err := ABIDecodeStreamIdMiniblockArray(from.Bytes())
-				if err == nil {
-					return v, nil
-				}
-				log.Errorw("failed to decode []StreamIdMiniblock", "error", err, "bytes", from.Bytes())
+				v, err = ABIDecodeStreamIdMiniblockArray(from.Bytes())
+			} else if to.Type() == streamIdRetentionArrayReflType {
+				v, err = ABIDecodeStreamIdRetentionArray(from.Bytes())
 			} else {
-				log.Errorw("unsupported type for setting decoding", "type", to.Kind(), "bytes", from.Bytes())
+				err = RiverError(Err_INVALID_ARGUMENT, "unsupported type for setting decoding")
+			}
+			// Decoding ignores trailing bytes, integers are expected to be encoded in exactly one word.
+			if err == nil && strict && isIntegerKind(to.Kind()) && len(from.Bytes()) != 32 {
+				err = RiverError(Err_INVALID_ARGUMENT, "integer setting is not encoded in 32 bytes")
+			}
+			if err == nil {
+				return v, nil
 			}
+			if strict {
+				return nil, AsRiverError(err, Err_INVALID_ARGUMENT).
+					Message("failed to decode setting").
+					Tags("type", to.Type().String(), "bytes", from.Bytes())
+			}
+			log.Errorw("failed to decode setting", "type", to.Type().String(), "error", err, "bytes", from.Bytes())
 			// Failed to decode, return unchanged value.
 			return to.Interface(), nil
 		}
 		return from.Interface(), nil
 	}
 }
+
+func isIntegerKind(kind reflect.Kind) bool {
+	return kind == reflect.Int64 || kind == reflect.Int || kind == reflect.Uint64 || kind == reflect.Uint
+}
@@ -492,6 +492,62 @@ func TestStreamIdMiniblockEncoding(t *testing.T) {
 	require.Len(emptyDecoded, 0)
 }
 
+func TestStreamIdRetentionEncoding(t *testing.T) {
+	require := require.New(t)
+
+	spaceId1 := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+	spaceId2 := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+
+	items := []StreamIdRetention{
+		{StreamId: spaceId1, Age: 30 * 24 * time.Hour},
+		{StreamId: spaceId2, Age: 90 * time.Minute},
+	}
+
+	encoded := ABIEncodeStreamIdRetentionArray(items)
+	require.NotEmpty(encoded)
+
+	decoded, err := ABIDecodeStreamIdRetentionArray(encoded)
+	require.NoError(err)
+	require.Equal(items, decoded)
+
+	emptyDecoded, err := ABIDecodeStreamIdRetentionArray(ABIEncodeStreamIdRetentionArray(nil))
+	require.NoError(err)
+	require.Len(emptyDecoded, 0)
+}
+
+func TestHistoryRetentionForStream(t *testing.T) {
+	assert := assert.New(t)
+
+	spaceId := testutils.FakeStreamId(shared.STREAM_SPACE_BIN)
+	channelId := testutils.MakeChannelId(spaceId)
+	otherChannelId := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	dmId := testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+
+	s := &OnChainSettings{
+		StreamHistoryRetention: StreamHistoryRetention{
+			Default: 365 * 24 * time.Hour,
+			Channel: 90 * 24 * time.Hour,
+		},
+		StreamHistoryRetentionBySpace: []StreamIdRetention{
+			{StreamId: spaceId, Age: 7 * 24 * time.Hour},
+		},
+	}
+
+	assert.Equal(7*24*time.Hour, s.HistoryRetentionForStream(spaceId))
+	assert.Equal(7*24*time.Hour, s.HistoryRetentionForStream(channelId))
+	assert.Equal(90*24*time.Hour, s.HistoryRetentionForStream(otherChannelId))
+	assert.Equal(365*24*time.Hour, s.HistoryRetentionForStream(dmId))
+
+	// a space policy can't extend the per-type retention
+	s.StreamHistoryRetentionBySpace[0].Age = 180 * 24 * time.Hour
+	assert.Equal(90*24*time.Hour, s.HistoryRetentionForStream(channelId))
+
+	// a space policy applies when there is no per-type retention
+	s.StreamHistoryRetention = StreamHistoryRetention{}
+	assert.Equal(180*24*time.Hour, s.HistoryRetentionForStream(channelId))
+	assert.Zero(s.HistoryRetentionForStream(dmId))
+}
+
 func TestStreamTrimByStreamIdConfig(t *testing.T) {
 	require := require.New(t)
 	assert := assert.New(t)
@@ -523,3 +579,55 @@ func TestStreamTrimByStreamIdConfig(t *testing.T) {
 	assert.EqualValues(streamId2, s.StreamTrimByStreamId[1].StreamId)
 	assert.EqualValues(1000, s.StreamTrimByStreamId[1].MiniblockNum)
 }
+
+func TestDecodeOnChainSettings(t *testing.T) {
+	require := require.New(t)
+	ctx := test.NewTestContext(t)
+
+	base := DefaultOnChainSettings()
+	s, err := DecodeOnChainSettings(ctx, base, map[string][]byte{
+		"STREAM.REPLICATIONFACTOR":       ABIEncodeUint64(5),
+		StreamCacheExpirationMsConfigKey: ABIEncodeInt64(2000),
+		NodeBlocklistConfigKey:           ABIEncodeAddressArray(addresses),
+	})
+	require.NoError(err)
+	require.EqualValues(5, s.ReplicationFactor)
+	require.Equal(2*time.Second, s.StreamCacheExpiration)
+	require.Equal(addresses, s.NodeBlocklist)
+	require.Equal(base.StreamMaxEventsPerMiniblock, s.StreamMaxEventsPerMiniblock)
+	require.Equal(DefaultOnChainSettings(), base)
+
+	_, err = DecodeOnChainSettings(ctx, base, map[string][]byte{
+		"stream.maxeventsperminiblok": ABIEncodeUint64(100),
+	})
+	require.ErrorContains(err, "key is not known")
+
+	_, err = DecodeOnChainSettings(ctx, base, map[string][]byte{
+		StreamMaxEventsPerMiniblockKey: ABIEncodeString("100"),
+	})
+	require.Error(err)
+
+	_, err = DecodeOnChainSettings(ctx, base, map[string][]byte{
+		StreamTrimByStreamIdConfigKey: ABIEncodeUint64(100),
+	})
+	require.Error(err)
+}
+
+func TestDiffOnChainSettings(t *testing.T) {
+	require := require.New(t)
+
+	from := DefaultOnChainSettings()
+	to := DefaultOnChainSettings()
+	changes, err := DiffOnChainSettings(from, to)
+	require.NoError(err)
+	require.Empty(changes)
+
+	to.ReplicationFactor = from.ReplicationFactor + 2
+	to.StreamCacheExpiration = time.Minute
+	changes, err = DiffOnChainSettings(from, to)
+	require.NoError(err)
+	require.Equal([]OnChainSettingChange{
+		{Key: StreamCacheExpirationMsConfigKey, Old: from.StreamCacheExpiration, New: time.Minute},
+		{Key: StreamReplicationFactorConfigKey, Old: from.ReplicationFactor, New: from.ReplicationFactor + 2},
+	}, changes)
+}
@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"bytes"
+	"context"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
@@ -75,3 +76,35 @@ func CheckDelegateSig(expectedAddress []byte, devicePubKey []byte, delegateSig [
 	}
 	return nil
 }
+
+// CheckDelegateSigWithVerifier checks the delegate signature like CheckDelegateSig, but also accepts delegate
+// signatures of smart contract accounts. These are verified through ERC-1271, or ERC-6492 for accounts that
+// are not deployed yet, against the chain the verifier is connected to. If verifier is nil only delegate
+// signatures of EOAs are accepted.
+func CheckDelegateSigWithVerifier(
+	ctx context.Context,
+	verifier *SmartAccountVerifier,
+	expectedAddress common.Address,
+	devicePubKey []byte,
+	delegateSig []byte,
+	expiryEpochMs int64,
+) error {
+	err := CheckDelegateSig(expectedAddress[:], devicePubKey, delegateSig, expiryEpochMs)
+	if err == nil || verifier == nil {
+		return err
+	}
+
+	hashSrc, hashErr := RiverDelegateHashSrc(devicePubKey, expiryEpochMs)
+	if hashErr != nil {
+		return hashErr
+	}
+
+	hash := common.BytesToHash(accounts.TextHash(hashSrc))
+	if verifyErr := verifier.VerifySignature(ctx, expectedAddress, hash, delegateSig); verifyErr != nil {
+		return AsRiverError(verifyErr).
+			Tag("deviceAddress", PublicKeyToAddress(devicePubKey)).
+			Func("CheckDelegateSigWithVerifier")
+	}
+
+	return nil
+}
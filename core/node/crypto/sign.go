@@ -59,6 +59,10 @@ var TownsHashForSnapshots = TownsHash{83, 78, 65, 80, 83, 72, 79, 84} // Prefix
 // TownsHashForCert is a TownsHash with the prefix 'INTRCERT' as bytes for hashing node-2-node mTLS certificate hash.
 var TownsHashForCert = TownsHash{73, 78, 84, 82, 67, 69, 82, 84} // Prefix 'INTRCERT' as bytes.
 
+// TownsHashForFullMiniblocks is a TownsHash with the prefix 'FULLMBLK' as bytes for hashing full miniblock
+// request tokens.
+var TownsHashForFullMiniblocks = TownsHash{70, 85, 76, 76, 77, 66, 76, 75} // Prefix 'FULLMBLK' as bytes.
+
 // Hash computes the hash of the given buffer using the Towns hashing algorithm.
 // It uses Keccak256 to ensure compatability with the EVM and uses a header, separator,
 // and footer to ensure that the hash is unique to Towns.
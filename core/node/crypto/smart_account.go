@@ -0,0 +1,287 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+var (
+	// erc1271MagicValue is the isValidSignature(bytes32,bytes) selector that ERC-1271 accounts return
+	// for valid signatures.
+	erc1271MagicValue = []byte{0x16, 0x26, 0xba, 0x7e}
+	// erc6492MagicSuffix is appended to signatures of accounts that are not deployed yet.
+	erc6492MagicSuffix = common.FromHex("0x6492649264926492649264926492649264926492649264926492649264926492")
+
+	isValidSignatureArgs = abi.Arguments{
+		{Type: mustNewABIType("bytes32")},
+		{Type: mustNewABIType("bytes")},
+	}
+	erc6492SignatureArgs = abi.Arguments{
+		{Type: mustNewABIType("address")},
+		{Type: mustNewABIType("bytes")},
+		{Type: mustNewABIType("bytes")},
+	}
+)
+
+// EVM opcodes used in the ERC-6492 validation code.
+const (
+	opPop        = 0x50
+	opMstore     = 0x52
+	opCodeCopy   = 0x39
+	opGas        = 0x5a
+	opPush1      = 0x60
+	opPush2      = 0x61
+	opPush20     = 0x73
+	opCall       = 0xf1
+	opReturn     = 0xf3
+	opStaticCall = 0xfa
+)
+
+func mustNewABIType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// SmartAccountVerifier verifies signatures of smart contract accounts such as Safe or ERC-4337 wallets.
+// Signatures of deployed accounts are verified by calling isValidSignature on the account (ERC-1271).
+// Accounts that are not deployed yet can provide an ERC-6492 wrapped signature that contains the
+// factory call that deploys the account, the deployment is simulated before isValidSignature is called.
+type SmartAccountVerifier struct {
+	caller bind.ContractCaller
+}
+
+// NewSmartAccountVerifier creates a SmartAccountVerifier that verifies signatures against the chain
+// the given caller is connected to.
+func NewSmartAccountVerifier(caller bind.ContractCaller) *SmartAccountVerifier {
+	return &SmartAccountVerifier{caller: caller}
+}
+
+// VerifySignature returns nil if signature is a valid signature of account over hash. Signatures of
+// accounts without code are verified through ecrecover, unless the signature is ERC-6492 wrapped.
+func (v *SmartAccountVerifier) VerifySignature(
+	ctx context.Context,
+	account common.Address,
+	hash common.Hash,
+	signature []byte,
+) error {
+	factory, factoryCalldata, innerSignature, wrapped, err := parseERC6492Signature(signature)
+	if err != nil {
+		return err
+	}
+
+	code, err := v.caller.CodeAt(ctx, account, nil)
+	if err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).
+			Message("Unable to load account code").
+			Tag("account", account).
+			Func("VerifySignature")
+	}
+
+	// account is deployed, the deployment data of a wrapped signature can be ignored
+	if len(code) > 0 {
+		return v.isValidSignature(ctx, account, hash, innerSignature)
+	}
+
+	if wrapped {
+		return v.isValidCounterfactualSignature(ctx, account, factory, factoryCalldata, hash, innerSignature)
+	}
+
+	return verifyEOASignature(account, hash, signature)
+}
+
+// isValidSignature calls isValidSignature(hash, signature) on the deployed account.
+func (v *SmartAccountVerifier) isValidSignature(
+	ctx context.Context,
+	account common.Address,
+	hash common.Hash,
+	signature []byte,
+) error {
+	calldata, err := isValidSignatureCalldata(hash, signature)
+	if err != nil {
+		return err
+	}
+
+	result, err := v.caller.CallContract(ctx, ethereum.CallMsg{To: &account, Data: calldata}, nil)
+	if err != nil {
+		return WrapRiverError(Err_BAD_EVENT_SIGNATURE, err).
+			Message("Smart account rejected signature").
+			Tag("account", account)
+	}
+
+	if len(result) < len(erc1271MagicValue) || !bytes.Equal(result[:len(erc1271MagicValue)], erc1271MagicValue) {
+		return RiverError(Err_BAD_EVENT_SIGNATURE, "Smart account rejected signature", "account", account)
+	}
+
+	return nil
+}
+
+// isValidCounterfactualSignature simulates the deployment of an account that is not deployed yet and
+// calls isValidSignature on it. This is done in a single eth_call without a target address, the
+// constructor code deploys the account through the factory and returns the isValidSignature result.
+func (v *SmartAccountVerifier) isValidCounterfactualSignature(
+	ctx context.Context,
+	account common.Address,
+	factory common.Address,
+	factoryCalldata []byte,
+	hash common.Hash,
+	signature []byte,
+) error {
+	calldata, err := isValidSignatureCalldata(hash, signature)
+	if err != nil {
+		return err
+	}
+
+	code, err := erc6492ValidationCode(factory, factoryCalldata, account, calldata)
+	if err != nil {
+		return err
+	}
+
+	result, err := v.caller.CallContract(ctx, ethereum.CallMsg{Data: code}, nil)
+	if err != nil {
+		return WrapRiverError(Err_BAD_EVENT_SIGNATURE, err).
+			Message("Unable to verify counterfactual smart account signature").
+			Tag("account", account).
+			Tag("factory", factory)
+	}
+
+	// result holds the isValidSignature return value followed by the staticcall success flag
+	if len(result) != 64 || result[63] != 1 || !bytes.Equal(result[:len(erc1271MagicValue)], erc1271MagicValue) {
+		return RiverError(Err_BAD_EVENT_SIGNATURE, "Smart account rejected signature",
+			"account", account, "factory", factory)
+	}
+
+	return nil
+}
+
+// parseERC6492Signature unwraps an ERC-6492 signature into the factory, the factory calldata that deploys
+// the account and the signature. If the signature isn't wrapped it is returned as is.
+func parseERC6492Signature(
+	signature []byte,
+) (factory common.Address, factoryCalldata []byte, innerSignature []byte, wrapped bool, err error) {
+	if len(signature) < len(erc6492MagicSuffix) || !bytes.HasSuffix(signature, erc6492MagicSuffix) {
+		return common.Address{}, nil, signature, false, nil
+	}
+
+	values, err := erc6492SignatureArgs.Unpack(signature[:len(signature)-len(erc6492MagicSuffix)])
+	if err != nil {
+		return common.Address{}, nil, nil, false, AsRiverError(err, Err_BAD_EVENT_SIGNATURE).
+			Message("Invalid ERC-6492 signature")
+	}
+
+	return values[0].(common.Address), values[1].([]byte), values[2].([]byte), true, nil
+}
+
+func isValidSignatureCalldata(hash common.Hash, signature []byte) ([]byte, error) {
+	args, err := isValidSignatureArgs.Pack(hash, signature)
+	if err != nil {
+		return nil, AsRiverError(err, Err_BAD_EVENT_SIGNATURE).Message("Unable to encode isValidSignature call")
+	}
+	return append(bytes.Clone(erc1271MagicValue), args...), nil
+}
+
+// erc6492ValidationCode returns contract creation code that deploys the account by calling factory with
+// factoryCalldata, calls the account with validateCalldata and returns the first 32 bytes of the result
+// followed by a word that indicates if the call succeeded. The calldata is appended to the code and
+// copied into memory before each call. A failing deployment is ignored, calling an account that wasn't
+// deployed succeeds without result and therefore doesn't validate.
+func erc6492ValidationCode(
+	factory common.Address,
+	factoryCalldata []byte,
+	account common.Address,
+	validateCalldata []byte,
+) ([]byte, error) {
+	program := func(factoryOffset, validateOffset, resultOffset int) []byte {
+		var code []byte
+		push1 := func(v byte) { code = append(code, opPush1, v) }
+		push2 := func(v int) { code = append(code, opPush2, byte(v>>8), byte(v)) }
+		push20 := func(addr common.Address) { code = append(append(code, opPush20), addr[:]...) }
+
+		// codecopy(0, factoryOffset, len(factoryCalldata))
+		push2(len(factoryCalldata))
+		push2(factoryOffset)
+		push1(0)
+		code = append(code, opCodeCopy)
+		// pop(call(gas, factory, 0, 0, len(factoryCalldata), 0, 0))
+		push1(0)
+		push1(0)
+		push2(len(factoryCalldata))
+		push1(0)
+		push1(0)
+		push20(factory)
+		code = append(code, opGas, opCall, opPop)
+		// codecopy(0, validateOffset, len(validateCalldata))
+		push2(len(validateCalldata))
+		push2(validateOffset)
+		push1(0)
+		code = append(code, opCodeCopy)
+		// mstore(resultOffset+32, staticcall(gas, account, 0, len(validateCalldata), resultOffset, 32))
+		push1(32)
+		push2(resultOffset)
+		push2(len(validateCalldata))
+		push1(0)
+		push20(account)
+		code = append(code, opGas, opStaticCall)
+		push2(resultOffset + 32)
+		code = append(code, opMstore)
+		// return(resultOffset, 64)
+		push1(64)
+		push2(resultOffset)
+		code = append(code, opReturn)
+		return code
+	}
+
+	var (
+		programLength  = len(program(0, 0, 0))
+		factoryOffset  = programLength
+		validateOffset = factoryOffset + len(factoryCalldata)
+		codeLength     = validateOffset + len(validateCalldata)
+		// the result is written after the copied calldata in memory that is still zero
+		resultOffset = (max(len(factoryCalldata), len(validateCalldata)) + 31) / 32 * 32
+	)
+
+	if codeLength > math.MaxUint16 || resultOffset+32 > math.MaxUint16 {
+		return nil, RiverError(Err_BAD_EVENT_SIGNATURE, "ERC-6492 signature too large", "len", codeLength)
+	}
+
+	code := program(factoryOffset, validateOffset, resultOffset)
+	code = append(code, factoryCalldata...)
+	code = append(code, validateCalldata...)
+	return code, nil
+}
+
+// verifyEOASignature verifies that signature was created by the private key of account over hash.
+func verifyEOASignature(account common.Address, hash common.Hash, signature []byte) error {
+	if len(signature) != 65 {
+		return RiverError(Err_BAD_EVENT_SIGNATURE, "Bad signature provided, expected 65 bytes",
+			"len", len(signature))
+	}
+
+	sig := bytes.Clone(signature)
+	if sig[64] == 27 || sig[64] == 28 {
+		sig[64] -= 27
+	}
+
+	pubKey, err := crypto.Ecrecover(hash[:], sig)
+	if err != nil {
+		return AsRiverError(err, Err_BAD_EVENT_SIGNATURE).Message("Unable to recover public key")
+	}
+
+	if signer := PublicKeyToAddress(pubKey); signer != account {
+		return RiverError(Err_BAD_EVENT_SIGNATURE, "Bad signature provided", "account", account, "signer", signer)
+	}
+
+	return nil
+}
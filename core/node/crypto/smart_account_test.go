@@ -0,0 +1,154 @@
+package crypto
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+)
+
+// evmContractCaller implements bind.ContractCaller on top of an in-memory EVM. State changes made by
+// calls are reverted, like eth_call does.
+type evmContractCaller struct {
+	state *state.StateDB
+}
+
+func newEvmContractCaller(t *testing.T) *evmContractCaller {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	return &evmContractCaller{state: statedb}
+}
+
+func (c *evmContractCaller) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	return c.state.GetCode(account), nil
+}
+
+func (c *evmContractCaller) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	snapshot := c.state.Snapshot()
+	defer c.state.RevertToSnapshot(snapshot)
+
+	cfg := &runtime.Config{State: c.state}
+	if msg.To == nil {
+		result, _, _, err := runtime.Create(msg.Data, cfg)
+		return result, err
+	}
+	result, _, err := runtime.Call(*msg.To, msg.Data, cfg)
+	return result, err
+}
+
+// mockSmartAccountCode returns the runtime code of an ERC-1271 account that only accepts signatures over
+// the given hash.
+func mockSmartAccountCode(validHash common.Hash) []byte {
+	code := []byte{0x60, 0x04, 0x35, 0x7f} // calldataload(4) == validHash
+	code = append(code, validHash[:]...)
+	return append(code,
+		0x14, 0x60, 0x2d, 0x57, // jumpi(45, eq)
+		0x60, 0x20, 0x60, 0x00, 0xf3, // return(0, 32)
+		0x5b,                                           // jumpdest
+		0x63, 0x16, 0x26, 0xba, 0x7e, 0x60, 0xe0, 0x1b, // magic << 224
+		0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3, // mstore(0, magic), return(0, 32)
+	)
+}
+
+// mockSmartAccountInitCode returns the creation code that deploys the given runtime code.
+func mockSmartAccountInitCode(runtimeCode []byte) []byte {
+	code := []byte{
+		0x60, byte(len(runtimeCode)), 0x80, // push len, dup
+		0x60, 0x0b, 0x60, 0x00, 0x39, // codecopy(0, 11, len)
+		0x60, 0x00, 0xf3, // return(0, len)
+	}
+	return append(code, runtimeCode...)
+}
+
+// mockFactoryCode is the runtime code of a factory that deploys the creation code it is called with.
+var mockFactoryCode = []byte{
+	0x36, 0x60, 0x00, 0x60, 0x00, 0x37, // calldatacopy(0, 0, calldatasize)
+	0x36, 0x60, 0x00, 0x60, 0x00, 0xf0, 0x50, // pop(create(0, 0, calldatasize))
+	0x00, // stop
+}
+
+func wrapERC6492Signature(t *testing.T, factory common.Address, factoryCalldata []byte, signature []byte) []byte {
+	wrapped, err := erc6492SignatureArgs.Pack(factory, factoryCalldata, signature)
+	require.NoError(t, err)
+	return append(wrapped, erc6492MagicSuffix...)
+}
+
+func TestSmartAccountVerifier(t *testing.T) {
+	var (
+		ctx       = test.NewTestContext(t)
+		req       = require.New(t)
+		caller    = newEvmContractCaller(t)
+		verifier  = NewSmartAccountVerifier(caller)
+		validHash = common.BytesToHash(accounts.TextHash([]byte("valid")))
+		otherHash = common.BytesToHash(accounts.TextHash([]byte("other")))
+		signature = []byte("smart account signature")
+	)
+
+	t.Run("DeployedAccount", func(t *testing.T) {
+		account := common.HexToAddress("0x1271")
+		caller.state.SetCode(account, mockSmartAccountCode(validHash), tracing.CodeChangeUnspecified)
+
+		req.NoError(verifier.VerifySignature(ctx, account, validHash, signature))
+		req.Error(verifier.VerifySignature(ctx, account, otherHash, signature))
+	})
+
+	t.Run("CounterfactualAccount", func(t *testing.T) {
+		factory := common.HexToAddress("0x6492")
+		caller.state.SetCode(factory, mockFactoryCode, tracing.CodeChangeUnspecified)
+		account := crypto.CreateAddress(factory, caller.state.GetNonce(factory))
+		initCode := mockSmartAccountInitCode(mockSmartAccountCode(validHash))
+
+		wrapped := wrapERC6492Signature(t, factory, initCode, signature)
+		req.NoError(verifier.VerifySignature(ctx, account, validHash, wrapped))
+		req.Error(verifier.VerifySignature(ctx, account, otherHash, wrapped))
+
+		// the deployment is only simulated
+		req.Empty(caller.state.GetCode(account))
+
+		// a signature for an account that the factory doesn't deploy is rejected
+		req.Error(verifier.VerifySignature(ctx, common.HexToAddress("0x1234"), validHash, wrapped))
+
+		// signatures of undeployed accounts must be wrapped
+		req.Error(verifier.VerifySignature(ctx, account, validHash, signature))
+	})
+
+	t.Run("EOA", func(t *testing.T) {
+		wallet, err := NewWallet(ctx)
+		req.NoError(err)
+
+		sig, err := crypto.Sign(validHash[:], wallet.PrivateKeyStruct)
+		req.NoError(err)
+		sig[64] += 27
+
+		req.NoError(verifier.VerifySignature(ctx, wallet.Address, validHash, sig))
+		req.Error(verifier.VerifySignature(ctx, wallet.Address, otherHash, sig))
+	})
+
+	t.Run("DelegateSig", func(t *testing.T) {
+		deviceWallet, err := NewWallet(ctx)
+		req.NoError(err)
+		devicePubKey := crypto.FromECDSAPub(&deviceWallet.PrivateKeyStruct.PublicKey)
+
+		hashSrc, err := RiverDelegateHashSrc(devicePubKey, 0)
+		req.NoError(err)
+		delegateHash := common.BytesToHash(accounts.TextHash(hashSrc))
+
+		account := common.HexToAddress("0x4337")
+		caller.state.SetCode(account, mockSmartAccountCode(delegateHash), tracing.CodeChangeUnspecified)
+
+		req.NoError(CheckDelegateSigWithVerifier(ctx, verifier, account, devicePubKey, signature, 0))
+		req.Error(CheckDelegateSigWithVerifier(ctx, nil, account, devicePubKey, signature, 0))
+		req.Error(CheckDelegateSigWithVerifier(ctx, verifier, account, devicePubKey, signature, 1))
+	})
+}
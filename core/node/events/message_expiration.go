@@ -0,0 +1,232 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// MessageExpiration returns the duration after which messages expire as configured in the given stream settings.
+// Only messages in DM and GDM streams expire, 0 means messages don't expire.
+func MessageExpiration(streamId StreamId, settings *StreamSettings) time.Duration {
+	if !messagesCanExpire(streamId) {
+		return 0
+	}
+	return time.Duration(settings.GetMessageExpirationSeconds()) * time.Second
+}
+
+func messagesCanExpire(streamId StreamId) bool {
+	return streamId.Type() == STREAM_DM_CHANNEL_BIN || streamId.Type() == STREAM_GDM_CHANNEL_BIN
+}
+
+// MessageExpiration returns the duration after which messages in the stream expire, 0 means messages don't expire.
+func (r *StreamView) MessageExpiration() time.Duration {
+	return MessageExpiration(r.streamId, r.snapshot.GetInceptionPayload().GetSettings())
+}
+
+// MessageExpiration returns the duration after which messages in the stream expire, 0 means messages don't expire.
+// Settings are read from the local view, or from the genesis miniblock on a stream node if the stream isn't local.
+// Settings are immutable, the result is cached on the stream.
+func (s *Stream) MessageExpiration(ctx context.Context) (time.Duration, error) {
+	if !messagesCanExpire(s.streamId) {
+		return 0, nil
+	}
+
+	s.mu.RLock()
+	cached := s.messageExpiration
+	s.mu.RUnlock()
+	if cached != nil {
+		return *cached, nil
+	}
+
+	var expiration time.Duration
+	view, err := s.GetViewIfLocalEx(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+	if view != nil {
+		expiration = view.MessageExpiration()
+	} else if expiration, err = s.remoteMessageExpiration(ctx); err != nil {
+		return 0, err
+	}
+
+	s.mu.Lock()
+	s.messageExpiration = &expiration
+	s.mu.Unlock()
+	return expiration, nil
+}
+
+func (s *Stream) remoteMessageExpiration(ctx context.Context) (time.Duration, error) {
+	var lastErr error
+	for _, node := range s.GetQuorumNodes() {
+		mbs, err := s.params.RemoteMiniblockProvider.GetMbs(ctx, node, s.streamId, 0, 1)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if len(mbs) == 0 {
+			lastErr = RiverError(Err_NOT_FOUND, "Genesis miniblock not found", "node", node)
+			continue
+		}
+		return GenesisMessageExpiration(s.streamId, mbs[0]), nil
+	}
+	if lastErr == nil {
+		lastErr = RiverError(Err_UNAVAILABLE, "No stream nodes")
+	}
+	return 0, AsRiverError(lastErr).Tag("streamId", s.streamId).Func("Stream.MessageExpiration")
+}
+
+// GenesisMessageExpiration returns the message expiration configured in the inception event
+// of the given genesis miniblock.
+func GenesisMessageExpiration(streamId StreamId, genesis *MiniblockInfo) time.Duration {
+	for _, e := range genesis.Events() {
+		if inception := e.Event.GetInceptionPayload(); inception != nil {
+			return MessageExpiration(streamId, inception.GetSettings())
+		}
+	}
+	return 0
+}
+
+// IsExpiredMessage returns true if the given event is a DM or GDM message that is expired at the given time.
+// Other events, such as membership and key material, never expire.
+func IsExpiredMessage(e *ParsedEvent, expiration time.Duration, now time.Time) bool {
+	if expiration <= 0 {
+		return false
+	}
+	if e.Event.GetDmChannelPayload().GetMessage() == nil && e.Event.GetGdmChannelPayload().GetMessage() == nil {
+		return false
+	}
+	return !time.UnixMilli(e.Event.GetCreatedAtEpochMs()).Add(expiration).After(now)
+}
+
+// RemoveExpiredMessages returns the given miniblock without the messages that are expired at the given time.
+// The returned miniblock is marked partial if messages were removed.
+func RemoveExpiredMessages(info *MiniblockInfo, expiration time.Duration, now time.Time) *MiniblockInfo {
+	if expiration <= 0 {
+		return info
+	}
+
+	events := info.Events()
+	envelopes := make([]*Envelope, 0, len(events))
+	for _, e := range events {
+		if !IsExpiredMessage(e, expiration, now) {
+			envelopes = append(envelopes, e.Envelope)
+		}
+	}
+	if len(envelopes) == len(events) {
+		return info
+	}
+
+	return &MiniblockInfo{
+		Ref: info.Ref,
+		Proto: &Miniblock{
+			Events:  envelopes,
+			Header:  info.Proto.GetHeader(),
+			Partial: true,
+		},
+		Snapshot:         info.Snapshot,
+		SnapshotEnvelope: info.SnapshotEnvelope,
+	}
+}
+
+// RemoveExpiredMessages returns the given stream, as loaded from this view, without the messages that are
+// expired at the given time. Miniblocks of which messages are removed are marked partial.
+// The stream is returned as is if messages in the stream don't expire.
+func (r *StreamView) RemoveExpiredMessages(stream *StreamAndCookie, now time.Time) *StreamAndCookie {
+	expiration := r.MessageExpiration()
+	if expiration <= 0 || stream == nil {
+		return stream
+	}
+
+	expired := make(map[common.Hash]struct{})
+	for _, mb := range r.blocks {
+		for _, e := range mb.Events() {
+			if IsExpiredMessage(e, expiration, now) {
+				expired[e.Hash] = struct{}{}
+			}
+		}
+	}
+	for _, e := range r.minipool.events.Values {
+		if IsExpiredMessage(e, expiration, now) {
+			expired[e.Hash] = struct{}{}
+		}
+	}
+	if len(expired) == 0 {
+		return stream
+	}
+
+	return removeEvents(stream, expired)
+}
+
+// RemoveExpiredMessagesFromStream returns the given stream without the messages that are expired at the given
+// time. Unlike StreamView.RemoveExpiredMessages it doesn't need a view, events are parsed from the stream but
+// their signatures are not verified. Miniblocks of which messages are removed are marked partial.
+func RemoveExpiredMessagesFromStream(
+	stream *StreamAndCookie,
+	expiration time.Duration,
+	now time.Time,
+) *StreamAndCookie {
+	if expiration <= 0 || stream == nil {
+		return stream
+	}
+
+	expired := make(map[common.Hash]struct{})
+	collect := func(envelopes []*Envelope) {
+		for _, env := range envelopes {
+			var event StreamEvent
+			if err := proto.Unmarshal(env.GetEvent(), &event); err != nil {
+				continue
+			}
+			if IsExpiredMessage(&ParsedEvent{Event: &event}, expiration, now) {
+				expired[common.BytesToHash(env.GetHash())] = struct{}{}
+			}
+		}
+	}
+	for _, mb := range stream.GetMiniblocks() {
+		collect(mb.GetEvents())
+	}
+	collect(stream.GetEvents())
+	if len(expired) == 0 {
+		return stream
+	}
+
+	return removeEvents(stream, expired)
+}
+
+// removeEvents returns a copy of the given stream without the events with the given hashes.
+func removeEvents(stream *StreamAndCookie, hashes map[common.Hash]struct{}) *StreamAndCookie {
+	keep := func(envelopes []*Envelope) []*Envelope {
+		kept := make([]*Envelope, 0, len(envelopes))
+		for _, env := range envelopes {
+			if _, ok := hashes[common.BytesToHash(env.GetHash())]; !ok {
+				kept = append(kept, env)
+			}
+		}
+		return kept
+	}
+
+	miniblocks := make([]*Miniblock, len(stream.GetMiniblocks()))
+	for i, mb := range stream.GetMiniblocks() {
+		events := keep(mb.GetEvents())
+		if len(events) == len(mb.GetEvents()) {
+			miniblocks[i] = mb
+			continue
+		}
+		miniblocks[i] = &Miniblock{Events: events, Header: mb.GetHeader(), Partial: true}
+	}
+
+	return &StreamAndCookie{
+		Events:                 keep(stream.GetEvents()),
+		NextSyncCookie:         stream.GetNextSyncCookie(),
+		Miniblocks:             miniblocks,
+		SyncReset:              stream.GetSyncReset(),
+		Snapshot:               stream.GetSnapshot(),
+		SnapshotMiniblockIndex: stream.GetSnapshotMiniblockIndex(),
+	}
+}
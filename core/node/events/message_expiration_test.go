@@ -0,0 +1,169 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeEventCreatedAt(
+	t *testing.T,
+	wallet *crypto.Wallet,
+	payload IsStreamEvent_Payload,
+	createdAt time.Time,
+) *ParsedEvent {
+	event, err := MakeStreamEvent(wallet, payload, nil)
+	require.NoError(t, err)
+	event.CreatedAtEpochMs = createdAt.UnixMilli()
+	envelope, err := MakeEnvelopeWithEvent(wallet, event)
+	require.NoError(t, err)
+	return parsedEvent(t, envelope)
+}
+
+func TestIsExpiredMessage(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+	wallet, _ := crypto.NewWallet(ctx)
+
+	now := time.Now()
+	oldMessage := makeEventCreatedAt(t, wallet, Make_DMChannelPayload_Message("old"), now.Add(-2*time.Hour))
+	newMessage := makeEventCreatedAt(t, wallet, Make_GDMChannelPayload_Message("new"), now.Add(-time.Minute))
+	oldMembership := makeEventCreatedAt(
+		t,
+		wallet,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, wallet.Address[:], wallet.Address[:], nil, common.Address{}),
+		now.Add(-2*time.Hour),
+	)
+
+	require.True(IsExpiredMessage(oldMessage, time.Hour, now))
+	require.False(IsExpiredMessage(newMessage, time.Hour, now))
+	require.False(IsExpiredMessage(oldMembership, time.Hour, now))
+	require.False(IsExpiredMessage(oldMessage, 0, now))
+}
+
+func TestMessageExpiration(t *testing.T) {
+	require := require.New(t)
+
+	settings := &StreamSettings{MessageExpirationSeconds: 3600}
+	require.Equal(time.Hour, MessageExpiration(testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN), settings))
+	require.Equal(time.Hour, MessageExpiration(testutils.FakeStreamId(STREAM_GDM_CHANNEL_BIN), settings))
+	require.Zero(MessageExpiration(testutils.FakeStreamId(STREAM_CHANNEL_BIN), settings))
+	require.Zero(MessageExpiration(testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN), nil))
+}
+
+func TestRemoveExpiredMessages(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+
+	wallet, _ := crypto.NewWallet(ctx)
+	otherWallet, _ := crypto.NewWallet(ctx)
+	nodeWallet, _ := crypto.NewWallet(ctx)
+	streamId, err := DMStreamIdForUsers(wallet.Address[:], otherWallet.Address[:])
+	require.NoError(err)
+
+	now := time.Now()
+	inception := makeEventCreatedAt(
+		t,
+		wallet,
+		Make_DmChannelPayload_Inception(
+			streamId,
+			wallet.Address,
+			otherWallet.Address,
+			&StreamSettings{MessageExpirationSeconds: 3600},
+		),
+		now.Add(-3*time.Hour),
+	)
+	join := makeEventCreatedAt(
+		t,
+		wallet,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, wallet.Address[:], wallet.Address[:], nil, common.Address{}),
+		now.Add(-3*time.Hour),
+	)
+
+	genesisMb, err := MakeGenesisMiniblock(wallet, []*ParsedEvent{inception, join})
+	require.NoError(err)
+	genesisMbBytes, err := proto.Marshal(genesisMb)
+	require.NoError(err)
+
+	oldMessage := makeEventCreatedAt(t, wallet, Make_DMChannelPayload_Message("old"), now.Add(-2*time.Hour))
+	newMessage := makeEventCreatedAt(t, wallet, Make_DMChannelPayload_Message("new"), now.Add(-time.Minute))
+	var minipool [][]byte
+	for _, e := range []*ParsedEvent{oldMessage, newMessage} {
+		b, err := proto.Marshal(e.Envelope)
+		require.NoError(err)
+		minipool = append(minipool, b)
+	}
+
+	view, err := MakeStreamView(
+		ctx,
+		streamId,
+		&storage.ReadStreamFromLastSnapshotResult{
+			Miniblocks:        []*storage.MiniblockDescriptor{{Data: genesisMbBytes}},
+			MinipoolEnvelopes: minipool,
+		},
+	)
+	require.NoError(err)
+	require.Equal(time.Hour, view.MessageExpiration())
+	require.Equal(time.Hour, GenesisMessageExpiration(streamId, view.blocks[0]))
+
+	// expired messages are removed, membership and the snapshot are kept
+	stream := view.RemoveExpiredMessages(view.GetResetStreamAndCookie(nodeWallet.Address), now)
+	require.Len(stream.GetEvents(), 1)
+	require.Equal(newMessage.Envelope.Hash, stream.GetEvents()[0].GetHash())
+	require.Len(stream.GetMiniblocks(), 1)
+	require.Len(stream.GetMiniblocks()[0].GetEvents(), 2)
+	require.False(stream.GetMiniblocks()[0].GetPartial())
+	require.True(stream.GetSyncReset())
+
+	// nothing is removed before messages expire
+	stream = view.GetResetStreamAndCookie(nodeWallet.Address)
+	require.Same(stream, view.RemoveExpiredMessages(stream, now.Add(-2*time.Hour)))
+
+	// the same messages are removed without a view
+	withoutView := RemoveExpiredMessagesFromStream(stream, time.Hour, now)
+	require.Len(withoutView.GetEvents(), 1)
+	require.Equal(newMessage.Envelope.Hash, withoutView.GetEvents()[0].GetHash())
+	require.Len(withoutView.GetMiniblocks()[0].GetEvents(), 2)
+	require.Same(stream, RemoveExpiredMessagesFromStream(stream, 0, now))
+
+	// miniblocks with expired messages are marked partial
+	mbEvents := []*ParsedEvent{join, oldMessage, newMessage}
+	header := &MiniblockHeader{
+		MiniblockNum: 1,
+		Timestamp:    timestamppb.New(now),
+		Content:      &MiniblockHeader_None{None: &emptypb.Empty{}},
+	}
+	mbEnvelopes := make([]*Envelope, len(mbEvents))
+	for i, e := range mbEvents {
+		header.EventHashes = append(header.EventHashes, e.Hash[:])
+		mbEnvelopes[i] = e.Envelope
+	}
+	headerEnvelope, err := MakeEnvelopeWithPayload(wallet, Make_MiniblockHeader(header), nil)
+	require.NoError(err)
+	mb, err := NewMiniblockInfoFromProto(
+		&Miniblock{Header: headerEnvelope, Events: mbEnvelopes},
+		nil,
+		NewParsedMiniblockInfoOpts(),
+	)
+	require.NoError(err)
+
+	filtered := RemoveExpiredMessages(mb, time.Hour, now)
+	require.True(filtered.Proto.GetPartial())
+	require.Equal(
+		[]*Envelope{join.Envelope, newMessage.Envelope},
+		filtered.Proto.GetEvents(),
+	)
+	require.Same(mb, RemoveExpiredMessages(mb, 0, now))
+}
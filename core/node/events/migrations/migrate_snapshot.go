@@ -1,6 +1,9 @@
 package migrations
 
 import (
+	"fmt"
+
+	. "github.com/towns-protocol/towns/core/node/base"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 )
 
@@ -31,3 +34,23 @@ func MigrateSnapshot(iSnapshot *Snapshot) {
 	}
 	iSnapshot.SnapshotVersion = currentVersion
 }
+
+// ApplyMigration applies the migration with the given version to the snapshot, the snapshot version
+// is not updated. A panic in the migration is returned as error, this allows to dry-run migrations
+// against stored snapshots that don't hold the assumptions the migration makes.
+func ApplyMigration(iSnapshot *Snapshot, version int32) (err error) {
+	if version < 0 || version >= CurrentSnapshotVersion() {
+		return RiverError(Err_INVALID_ARGUMENT, "Unknown snapshot migration", "version", version).
+			Func("ApplyMigration")
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = RiverError(Err_INTERNAL, "Snapshot migration panicked", "version", version, "panic", fmt.Sprint(r)).
+				Func("ApplyMigration")
+		}
+	}()
+
+	MIGRATIONS[version](iSnapshot)
+	return nil
+}
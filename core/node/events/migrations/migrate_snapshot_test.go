@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestApplyMigration(t *testing.T) {
+	require := require.New(t)
+
+	snapshot := &Snapshot{}
+	for version := range CurrentSnapshotVersion() {
+		require.NoError(ApplyMigration(snapshot, version))
+	}
+	require.EqualValues(0, snapshot.SnapshotVersion)
+
+	err := ApplyMigration(snapshot, CurrentSnapshotVersion())
+	require.True(IsRiverErrorCode(err, Err_INVALID_ARGUMENT))
+
+	MIGRATIONS = append(MIGRATIONS, func(*Snapshot) { panic("bad migration") })
+	defer func() { MIGRATIONS = MIGRATIONS[:len(MIGRATIONS)-1] }()
+
+	err = ApplyMigration(snapshot, CurrentSnapshotVersion()-1)
+	require.True(IsRiverErrorCode(err, Err_INTERNAL))
+	require.Contains(err.Error(), "bad migration")
+}
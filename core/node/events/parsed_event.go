@@ -55,6 +55,8 @@ func ParseEvent(envelope *Envelope) (*ParsedEvent, error) {
 			Func("ParseEvent")
 	}
 
+	// Delegate signatures of smart accounts (CheckDelegateSigWithVerifier) are not accepted for events,
+	// event validation must not depend on chain state.
 	if len(streamEvent.DelegateSig) > 0 {
 		err := CheckDelegateSig(
 			streamEvent.CreatorAddress,
@@ -87,6 +87,7 @@ func (s *remoteProviderImpl) GetMbs(
 		ToExclusive:   toExclusive,
 	})
 	req.Header().Set(RiverNoForwardHeader, RiverHeaderTrueValue)
+	req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
 	resp, err := remote.GetMiniblocks(ctx, req)
 	if err != nil {
 		return nil, err
@@ -157,6 +158,7 @@ func (s *remoteProviderImpl) GetStream(
 
 	req := connect.NewRequest(request)
 	req.Header().Set(RiverNoForwardHeader, RiverHeaderTrueValue)
+	req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
 	resp, err := remote.GetStream(ctx, req)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,152 @@
+package events
+
+import (
+	"bytes"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events/migrations"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// SnapshotMigrationStep is the result of applying a single snapshot migration.
+	SnapshotMigrationStep struct {
+		// Version of the migration, migration N upgrades a snapshot from version N to N+1.
+		Version int32
+		// Changes are the fields the migration changed.
+		Changes []SnapshotFieldChange
+		// Err is set when the migration failed, later migrations are not applied in that case.
+		Err error
+	}
+
+	// SnapshotMigrationCheck is the result of dry-running the pending snapshot migrations
+	// against a stored snapshot.
+	SnapshotMigrationCheck struct {
+		StreamId     StreamId
+		MiniblockNum int64
+		// FromVersion is the version of the stored snapshot.
+		FromVersion int32
+		// Steps holds the results of the pending migrations in the order they are applied.
+		Steps []SnapshotMigrationStep
+		// Violations holds the invariants that don't hold for the migrated snapshot.
+		Violations []string
+	}
+)
+
+// Failed returns true if a migration failed or an invariant doesn't hold for the migrated snapshot.
+func (c *SnapshotMigrationCheck) Failed() bool {
+	for _, step := range c.Steps {
+		if step.Err != nil {
+			return true
+		}
+	}
+	return len(c.Violations) > 0
+}
+
+// CheckSnapshotMigrations applies the pending migrations to a copy of the snapshot in the given miniblock,
+// the stored snapshot is not modified. It reports the changes of each migration and verifies that:
+//   - the migrated snapshot has the current snapshot version,
+//   - the migrated snapshot belongs to the stream,
+//   - joined members are unique,
+//   - the migrated snapshot survives serialization,
+//   - migrating the stored snapshot again yields the same snapshot, otherwise nodes would
+//     disagree on the snapshot hash.
+func CheckSnapshotMigrations(streamId StreamId, mb *MiniblockInfo) (*SnapshotMigrationCheck, error) {
+	if mb.Snapshot == nil {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "miniblock has no snapshot").
+			Tags("streamId", streamId, "miniblockNum", mb.Ref.Num).
+			Func("CheckSnapshotMigrations")
+	}
+
+	check := &SnapshotMigrationCheck{
+		StreamId:     streamId,
+		MiniblockNum: mb.Ref.Num,
+		FromVersion:  mb.Snapshot.GetSnapshotVersion(),
+	}
+
+	migrated, err := check.migrate(mb.Snapshot, true)
+	if err != nil {
+		return nil, err
+	}
+	if migrated == nil {
+		return check, nil
+	}
+
+	if migrated.GetSnapshotVersion() != migrations.CurrentSnapshotVersion() {
+		check.violationf(
+			"snapshot version is %d, expected %d",
+			migrated.GetSnapshotVersion(),
+			migrations.CurrentSnapshotVersion(),
+		)
+	}
+
+	if inception := migrated.GetInceptionPayload(); inception == nil {
+		check.violationf("snapshot has no inception")
+	} else if !bytes.Equal(inception.GetStreamId(), streamId[:]) {
+		check.violationf("inception stream id is %x, expected %s", inception.GetStreamId(), streamId)
+	}
+
+	seen := make(map[string]bool)
+	for _, member := range migrated.GetMembers().GetJoined() {
+		if seen[string(member.GetUserAddress())] {
+			check.violationf("member %x is joined more than once", member.GetUserAddress())
+		}
+		seen[string(member.GetUserAddress())] = true
+	}
+
+	if data, err := proto.Marshal(migrated); err != nil {
+		check.violationf("migrated snapshot can't be serialized: %v", err)
+	} else {
+		var parsed Snapshot
+		if err := proto.Unmarshal(data, &parsed); err != nil {
+			check.violationf("migrated snapshot can't be parsed: %v", err)
+		} else if !proto.Equal(migrated, &parsed) {
+			check.violationf("migrated snapshot changes when serialized")
+		}
+	}
+
+	again, err := check.migrate(mb.Snapshot, false)
+	if err != nil {
+		return nil, err
+	}
+	if again == nil || !proto.Equal(migrated, again) {
+		check.violationf("migrations are not deterministic")
+	}
+
+	return check, nil
+}
+
+// migrate applies the pending migrations to a copy of the given snapshot. If record is set the results
+// of the migrations are added to the check. Nil is returned if a migration failed.
+func (c *SnapshotMigrationCheck) migrate(stored *Snapshot, record bool) (*Snapshot, error) {
+	snapshot := proto.Clone(stored).(*Snapshot)
+	for version := c.FromVersion; version < migrations.CurrentSnapshotVersion(); version++ {
+		before := proto.Clone(snapshot).(*Snapshot)
+		if err := migrations.ApplyMigration(snapshot, version); err != nil {
+			if record {
+				c.Steps = append(c.Steps, SnapshotMigrationStep{Version: version, Err: err})
+			}
+			return nil, nil
+		}
+		if !record {
+			continue
+		}
+		changes, err := DiffSnapshots(before, snapshot)
+		if err != nil {
+			return nil, AsRiverError(err).Func("CheckSnapshotMigrations")
+		}
+		c.Steps = append(c.Steps, SnapshotMigrationStep{Version: version, Changes: changes})
+	}
+	if c.FromVersion < migrations.CurrentSnapshotVersion() {
+		snapshot.SnapshotVersion = migrations.CurrentSnapshotVersion()
+	}
+	return snapshot, nil
+}
+
+func (c *SnapshotMigrationCheck) violationf(format string, args ...any) {
+	c.Violations = append(c.Violations, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,61 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events/migrations"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestCheckSnapshotMigrations(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+
+	alice, _ := crypto.NewWallet(ctx)
+	node, _ := crypto.NewWallet(ctx)
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	genesis := MakeGenesisMiniblockForSpaceStream(t, alice, node, spaceId, nil)
+
+	// an up to date snapshot has no pending migrations
+	check, err := CheckSnapshotMigrations(spaceId, genesis)
+	require.NoError(err)
+	require.False(check.Failed())
+	require.Empty(check.Steps)
+	require.Empty(check.Violations)
+
+	// an old snapshot gets all migrations applied
+	old := &MiniblockInfo{Ref: genesis.Ref, Snapshot: proto.Clone(genesis.Snapshot).(*Snapshot)}
+	old.Snapshot.SnapshotVersion = 0
+	check, err = CheckSnapshotMigrations(spaceId, old)
+	require.NoError(err)
+	require.False(check.Failed(), check.Violations)
+	require.Len(check.Steps, int(migrations.CurrentSnapshotVersion()))
+	require.EqualValues(0, old.Snapshot.SnapshotVersion)
+
+	// the snapshot of another stream violates the inception invariant
+	check, err = CheckSnapshotMigrations(testutils.FakeStreamId(STREAM_SPACE_BIN), genesis)
+	require.NoError(err)
+	require.True(check.Failed())
+	require.Len(check.Violations, 1)
+	require.Contains(check.Violations[0], "inception stream id")
+
+	// a failing migration is reported and stops the migration
+	migrations.MIGRATIONS = append(migrations.MIGRATIONS, func(*Snapshot) { panic("bad migration") })
+	defer func() { migrations.MIGRATIONS = migrations.MIGRATIONS[:len(migrations.MIGRATIONS)-1] }()
+
+	check, err = CheckSnapshotMigrations(spaceId, genesis)
+	require.NoError(err)
+	require.True(check.Failed())
+	require.Len(check.Steps, 1)
+	require.Error(check.Steps[0].Err)
+
+	_, err = CheckSnapshotMigrations(spaceId, &MiniblockInfo{Ref: genesis.Ref})
+	require.Error(err)
+}
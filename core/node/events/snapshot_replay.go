@@ -0,0 +1,383 @@
+package events
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events/migrations"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+type (
+	// MiniblockReader reads miniblocks with their snapshots from fromInclusive to toExclusive.
+	// It may return fewer miniblocks than requested, but at least one if the miniblock at fromInclusive exists.
+	MiniblockReader func(ctx context.Context, fromInclusive int64, toExclusive int64) ([]*MiniblockInfo, error)
+
+	// SnapshotReplay is the stream snapshot as of a miniblock, reconstructed by applying the events of the
+	// miniblocks after the nearest earlier snapshot.
+	SnapshotReplay struct {
+		// Snapshot is the reconstructed snapshot.
+		Snapshot *Snapshot
+		// MiniblockNum is the miniblock the snapshot is reconstructed for.
+		MiniblockNum int64
+		// BaseMiniblockNum is the miniblock of the snapshot the replay started from.
+		BaseMiniblockNum int64
+		// UpdateErrors holds the errors of events that couldn't be applied to the snapshot. These events are
+		// skipped, just like the miniblock producer does.
+		UpdateErrors []error
+	}
+
+	// SnapshotFieldChange is a field that differs between two snapshots.
+	SnapshotFieldChange struct {
+		// Path of the field, e.g. members.joined.
+		Path string
+		// Removed holds the values that are only set in the first snapshot.
+		Removed []string
+		// Added holds the values that are only set in the second snapshot.
+		Added []string
+	}
+)
+
+func (c SnapshotFieldChange) String() string {
+	var b strings.Builder
+	b.WriteString(c.Path)
+	b.WriteString(":")
+	for _, v := range c.Removed {
+		b.WriteString("\n  - ")
+		b.WriteString(v)
+	}
+	for _, v := range c.Added {
+		b.WriteString("\n  + ")
+		b.WriteString(v)
+	}
+	return b.String()
+}
+
+// ReplaySnapshot reconstructs the snapshot as of the miniblock with the given number. The first miniblock
+// must have a snapshot, miniblocks must be consecutive and include the miniblock with the given number.
+// Snapshot migrations are applied to the snapshot before events are applied.
+func ReplaySnapshot(miniblocks []*MiniblockInfo, miniblockNum int64) (*SnapshotReplay, error) {
+	if len(miniblocks) == 0 || miniblocks[0].Snapshot == nil {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "first miniblock has no snapshot").Func("ReplaySnapshot")
+	}
+
+	base := miniblocks[0].Ref.Num
+	if miniblockNum < base || miniblockNum > miniblocks[len(miniblocks)-1].Ref.Num {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "miniblock not in range").
+			Tags("miniblockNum", miniblockNum, "base", base, "last", miniblocks[len(miniblocks)-1].Ref.Num).
+			Func("ReplaySnapshot")
+	}
+
+	snapshot := proto.Clone(miniblocks[0].Snapshot).(*Snapshot)
+	migrations.MigrateSnapshot(snapshot)
+
+	replay := &SnapshotReplay{
+		Snapshot:         snapshot,
+		MiniblockNum:     miniblockNum,
+		BaseMiniblockNum: base,
+	}
+
+	for i, mb := range miniblocks[1 : miniblockNum-base+1] {
+		if mb.Ref.Num != base+int64(i)+1 {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "miniblocks are not sequential").
+				Tags("expected", base+int64(i)+1, "actual", mb.Ref.Num).
+				Func("ReplaySnapshot")
+		}
+		offset := mb.Header().GetEventNumOffset()
+		for j, e := range mb.Events() {
+			if err := Update_Snapshot(snapshot, e, mb.Ref.Num, offset+int64(j)); err != nil {
+				replay.UpdateErrors = append(
+					replay.UpdateErrors,
+					AsRiverError(err).Tags("miniblockNum", mb.Ref.Num, "event", e.Hash),
+				)
+			}
+		}
+	}
+
+	return replay, nil
+}
+
+// ReplaySnapshotAt reconstructs the snapshot as of the given miniblock from the nearest snapshot at or
+// before it. Snapshots that are nullified by history trimming are skipped.
+func ReplaySnapshotAt(ctx context.Context, read MiniblockReader, miniblockNum int64) (*SnapshotReplay, error) {
+	target, err := readMiniblocksRange(ctx, read, miniblockNum, miniblockNum+1)
+	if err != nil {
+		return nil, err
+	}
+	if target[0].Snapshot != nil {
+		return ReplaySnapshot(target, miniblockNum)
+	}
+	return replayFromPrevSnapshot(ctx, read, target[0])
+}
+
+// VerifySnapshotAt replays the events since the previous snapshot on top of that snapshot and compares the
+// result with the snapshot that is stored in the given miniblock. Differences point to events that were
+// applied differently when the snapshot was created, or to snapshot migrations that don't match the
+// current code.
+func VerifySnapshotAt(
+	ctx context.Context,
+	read MiniblockReader,
+	miniblockNum int64,
+) (*SnapshotReplay, []SnapshotFieldChange, error) {
+	target, err := readMiniblocksRange(ctx, read, miniblockNum, miniblockNum+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if target[0].Snapshot == nil {
+		return nil, nil, RiverError(Err_INVALID_ARGUMENT, "miniblock has no snapshot").
+			Tag("miniblockNum", miniblockNum).
+			Func("VerifySnapshotAt")
+	}
+	if miniblockNum == 0 {
+		return nil, nil, RiverError(Err_INVALID_ARGUMENT, "genesis snapshot can't be verified").
+			Func("VerifySnapshotAt")
+	}
+
+	replay, err := replayFromPrevSnapshot(ctx, read, target[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes, err := DiffSnapshots(target[0].Snapshot, replay.Snapshot)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return replay, changes, nil
+}
+
+// replayFromPrevSnapshot replays the snapshot for the given miniblock from the nearest earlier snapshot.
+func replayFromPrevSnapshot(ctx context.Context, read MiniblockReader, target *MiniblockInfo) (*SnapshotReplay, error) {
+	candidate := target.Header().GetPrevSnapshotMiniblockNum()
+	for {
+		if candidate >= target.Ref.Num {
+			candidate = target.Ref.Num - 1
+		}
+		if candidate < 0 {
+			return nil, RiverError(Err_NOT_FOUND, "no snapshot found before miniblock").
+				Tag("miniblockNum", target.Ref.Num).
+				Func("replayFromPrevSnapshot")
+		}
+
+		first, err := readMiniblocksRange(ctx, read, candidate, candidate+1)
+		if err != nil {
+			return nil, err
+		}
+
+		if first[0].Snapshot != nil {
+			rest, err := readMiniblocksRange(ctx, read, candidate+1, target.Ref.Num)
+			if err != nil {
+				return nil, err
+			}
+			miniblocks := append(first, rest...)
+			return ReplaySnapshot(append(miniblocks, target), target.Ref.Num)
+		}
+
+		// snapshot is nullified by history trimming, continue with the snapshot before it
+		prev := first[0].Header().GetPrevSnapshotMiniblockNum()
+		if prev >= candidate {
+			prev = candidate - 1
+		}
+		candidate = prev
+	}
+}
+
+// readMiniblocksRange reads all miniblocks from fromInclusive to toExclusive.
+func readMiniblocksRange(
+	ctx context.Context,
+	read MiniblockReader,
+	fromInclusive int64,
+	toExclusive int64,
+) ([]*MiniblockInfo, error) {
+	var miniblocks []*MiniblockInfo
+	for from := fromInclusive; from < toExclusive; {
+		mbs, err := read(ctx, from, toExclusive)
+		if err != nil {
+			return nil, err
+		}
+		if len(mbs) == 0 {
+			return nil, RiverError(Err_NOT_FOUND, "miniblock not found").
+				Tag("miniblockNum", from).
+				Func("readMiniblocksRange")
+		}
+		miniblocks = append(miniblocks, mbs...)
+		from += int64(len(mbs))
+	}
+	return miniblocks, nil
+}
+
+// DiffSnapshots returns the fields that differ between the given snapshots, sorted by path.
+// Repeated fields are compared as sets, values that are only present in one of the snapshots are reported.
+func DiffSnapshots(from *Snapshot, to *Snapshot) ([]SnapshotFieldChange, error) {
+	var changes []SnapshotFieldChange
+	if err := diffMessages("", from.ProtoReflect(), to.ProtoReflect(), &changes); err != nil {
+		return nil, err
+	}
+	slices.SortStableFunc(changes, func(a, b SnapshotFieldChange) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return changes, nil
+}
+
+func diffMessages(path string, a protoreflect.Message, b protoreflect.Message, changes *[]SnapshotFieldChange) error {
+	fields := a.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		fieldPath := string(fd.Name())
+		if path != "" {
+			fieldPath = path + "." + fieldPath
+		}
+
+		switch {
+		case fd.IsList():
+			removed, added, err := diffLists(fd, a.Get(fd).List(), b.Get(fd).List())
+			if err != nil {
+				return err
+			}
+			if len(removed) > 0 || len(added) > 0 {
+				*changes = append(*changes, SnapshotFieldChange{Path: fieldPath, Removed: removed, Added: added})
+			}
+		case fd.IsMap():
+			if err := diffMaps(fieldPath, fd, a.Get(fd).Map(), b.Get(fd).Map(), changes); err != nil {
+				return err
+			}
+		case fd.Message() != nil && a.Has(fd) && b.Has(fd):
+			if err := diffMessages(fieldPath, a.Get(fd).Message(), b.Get(fd).Message(), changes); err != nil {
+				return err
+			}
+		default:
+			if err := diffValues(fieldPath, fd, a.Has(fd), a.Get(fd), b.Has(fd), b.Get(fd), changes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func diffLists(fd protoreflect.FieldDescriptor, a protoreflect.List, b protoreflect.List) ([]string, []string, error) {
+	counts := make(map[string]int)
+	var removed, added []string
+
+	for i := 0; i < b.Len(); i++ {
+		v, err := formatValue(fd, b.Get(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		counts[v]++
+	}
+	for i := 0; i < a.Len(); i++ {
+		v, err := formatValue(fd, a.Get(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		if counts[v] > 0 {
+			counts[v]--
+		} else {
+			removed = append(removed, v)
+		}
+	}
+	for i := 0; i < b.Len(); i++ {
+		v, err := formatValue(fd, b.Get(i))
+		if err != nil {
+			return nil, nil, err
+		}
+		if counts[v] > 0 {
+			counts[v]--
+			added = append(added, v)
+		}
+	}
+
+	return removed, added, nil
+}
+
+func diffMaps(
+	path string,
+	fd protoreflect.FieldDescriptor,
+	a protoreflect.Map,
+	b protoreflect.Map,
+	changes *[]SnapshotFieldChange,
+) error {
+	keys := make(map[string]protoreflect.MapKey)
+	a.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[k.String()] = k
+		return true
+	})
+	b.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys[k.String()] = k
+		return true
+	})
+
+	valueFd := fd.MapValue()
+	for name, key := range keys {
+		keyPath := fmt.Sprintf("%s[%s]", path, name)
+		if valueFd.Message() != nil && a.Has(key) && b.Has(key) {
+			if err := diffMessages(keyPath, a.Get(key).Message(), b.Get(key).Message(), changes); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := diffValues(keyPath, valueFd, a.Has(key), a.Get(key), b.Has(key), b.Get(key), changes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func diffValues(
+	path string,
+	fd protoreflect.FieldDescriptor,
+	hasA bool,
+	a protoreflect.Value,
+	hasB bool,
+	b protoreflect.Value,
+	changes *[]SnapshotFieldChange,
+) error {
+	change := SnapshotFieldChange{Path: path}
+	if hasA {
+		v, err := formatValue(fd, a)
+		if err != nil {
+			return err
+		}
+		change.Removed = []string{v}
+	}
+	if hasB {
+		v, err := formatValue(fd, b)
+		if err != nil {
+			return err
+		}
+		change.Added = []string{v}
+	}
+	if hasA == hasB && (!hasA || change.Removed[0] == change.Added[0]) {
+		return nil
+	}
+	*changes = append(*changes, change)
+	return nil
+}
+
+func formatValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) (string, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		b, err := prototext.MarshalOptions{}.Marshal(v.Message().Interface())
+		if err != nil {
+			return "", AsRiverError(err, Err_INTERNAL).Func("formatValue")
+		}
+		return strings.Join(strings.Fields(string(b)), " "), nil
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(v.Bytes()), nil
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name()), nil
+		}
+		return fmt.Sprint(v.Enum()), nil
+	default:
+		return v.String(), nil
+	}
+}
@@ -0,0 +1,169 @@
+package events
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeReplayTestMiniblock(
+	t *testing.T,
+	wallet *crypto.Wallet,
+	num int64,
+	eventNumOffset int64,
+	events []*ParsedEvent,
+	snapshot *Snapshot,
+) *MiniblockInfo {
+	header := &MiniblockHeader{
+		MiniblockNum:   num,
+		Timestamp:      timestamppb.Now(),
+		EventNumOffset: eventNumOffset,
+		Snapshot:       snapshot,
+		Content:        &MiniblockHeader_None{None: &emptypb.Empty{}},
+	}
+	envelopes := make([]*Envelope, len(events))
+	for i, e := range events {
+		header.EventHashes = append(header.EventHashes, e.Hash[:])
+		envelopes[i] = e.Envelope
+	}
+	headerEnvelope, err := MakeEnvelopeWithPayload(wallet, Make_MiniblockHeader(header), nil)
+	require.NoError(t, err)
+	mb, err := NewMiniblockInfoFromProto(
+		&Miniblock{Header: headerEnvelope, Events: envelopes},
+		nil,
+		NewParsedMiniblockInfoOpts().WithExpectedBlockNumber(num),
+	)
+	require.NoError(t, err)
+	return mb
+}
+
+func sliceMiniblockReader(miniblocks []*MiniblockInfo) MiniblockReader {
+	return func(_ context.Context, fromInclusive int64, toExclusive int64) ([]*MiniblockInfo, error) {
+		if fromInclusive >= int64(len(miniblocks)) {
+			return nil, nil
+		}
+		// return a single miniblock per call to exercise paging
+		return miniblocks[fromInclusive : fromInclusive+1], nil
+	}
+}
+
+func joinedMembers(snapshot *Snapshot) []common.Address {
+	var members []common.Address
+	for _, m := range snapshot.GetMembers().GetJoined() {
+		members = append(members, common.BytesToAddress(m.GetUserAddress()))
+	}
+	return members
+}
+
+func TestReplaySnapshot(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+
+	alice, _ := crypto.NewWallet(ctx)
+	bob, _ := crypto.NewWallet(ctx)
+	node, _ := crypto.NewWallet(ctx)
+	genesis := MakeGenesisMiniblockForSpaceStream(t, alice, node, testutils.FakeStreamId(STREAM_SPACE_BIN), nil)
+
+	aliceJoin, err := MakeParsedEventWithPayload(
+		alice,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, alice.Address[:], alice.Address[:], nil, common.Address{}),
+		&MiniblockRef{},
+	)
+	require.NoError(err)
+
+	bobJoin, err := MakeParsedEventWithPayload(
+		bob,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, bob.Address[:], bob.Address[:], nil, common.Address{}),
+		&MiniblockRef{},
+	)
+	require.NoError(err)
+	aliceLeave, err := MakeParsedEventWithPayload(
+		alice,
+		Make_MemberPayload_Membership(MembershipOp_SO_LEAVE, alice.Address[:], alice.Address[:], nil, common.Address{}),
+		&MiniblockRef{},
+	)
+	require.NoError(err)
+
+	mb1 := makeReplayTestMiniblock(t, node, 1, 2, []*ParsedEvent{aliceJoin, bobJoin}, nil)
+	mb2 := makeReplayTestMiniblock(t, node, 2, 5, []*ParsedEvent{aliceLeave}, nil)
+	miniblocks := []*MiniblockInfo{genesis, mb1, mb2}
+	read := sliceMiniblockReader(miniblocks)
+
+	// who was a member at miniblock N
+	at0, err := ReplaySnapshotAt(ctx, read, 0)
+	require.NoError(err)
+	require.Empty(joinedMembers(at0.Snapshot))
+
+	at1, err := ReplaySnapshotAt(ctx, read, 1)
+	require.NoError(err)
+	require.EqualValues(0, at1.BaseMiniblockNum)
+	require.ElementsMatch([]common.Address{alice.Address, bob.Address}, joinedMembers(at1.Snapshot))
+	require.Empty(at1.UpdateErrors)
+
+	at2, err := ReplaySnapshotAt(ctx, read, 2)
+	require.NoError(err)
+	require.Equal([]common.Address{bob.Address}, joinedMembers(at2.Snapshot))
+
+	// the genesis snapshot is not modified by the replay
+	require.Empty(genesis.Snapshot.GetMembers().GetJoined())
+
+	changes, err := DiffSnapshots(at1.Snapshot, at2.Snapshot)
+	require.NoError(err)
+	require.Len(changes, 1)
+	require.Equal("members.joined", changes[0].Path)
+	require.Len(changes[0].Removed, 1)
+	require.Empty(changes[0].Added)
+	require.Contains(changes[0].String(), "members.joined:\n  - ")
+
+	changes, err = DiffSnapshots(at2.Snapshot, at2.Snapshot)
+	require.NoError(err)
+	require.Empty(changes)
+
+	_, err = ReplaySnapshotAt(ctx, read, 3)
+	require.Error(err)
+
+	// a snapshot stored in miniblock 2 that matches the replay verifies without changes
+	mb2WithSnapshot := makeReplayTestMiniblock(
+		t, node, 2, 5, []*ParsedEvent{aliceLeave}, proto.Clone(at2.Snapshot).(*Snapshot),
+	)
+	replay, changes, err := VerifySnapshotAt(
+		ctx,
+		sliceMiniblockReader([]*MiniblockInfo{genesis, mb1, mb2WithSnapshot}),
+		2,
+	)
+	require.NoError(err)
+	require.EqualValues(0, replay.BaseMiniblockNum)
+	require.Empty(changes)
+
+	// a snapshot that missed the leave event is reported
+	mb2WithBadSnapshot := makeReplayTestMiniblock(
+		t, node, 2, 5, []*ParsedEvent{aliceLeave}, proto.Clone(at1.Snapshot).(*Snapshot),
+	)
+	_, changes, err = VerifySnapshotAt(
+		ctx,
+		sliceMiniblockReader([]*MiniblockInfo{genesis, mb1, mb2WithBadSnapshot}),
+		2,
+	)
+	require.NoError(err)
+	require.Len(changes, 1)
+	require.Equal("members.joined", changes[0].Path)
+
+	// replay at a miniblock with a snapshot starts from that snapshot
+	at2, err = ReplaySnapshotAt(ctx, sliceMiniblockReader([]*MiniblockInfo{genesis, mb1, mb2WithBadSnapshot}), 2)
+	require.NoError(err)
+	require.EqualValues(2, at2.BaseMiniblockNum)
+
+	_, _, err = VerifySnapshotAt(ctx, read, 1)
+	require.Error(err)
+}
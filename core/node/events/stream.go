@@ -79,6 +79,9 @@ type Stream struct {
 
 	// local is not nil if stream is local to current node. local and all fields of local are protected by mu.
 	local *localStreamState
+
+	// messageExpiration caches the immutable message expiration of DM and GDM streams once it is known.
+	messageExpiration *time.Duration
 }
 
 // NewStream creates a new stream with the given streamId and lastAppliedBlockNum.
@@ -1320,9 +1323,9 @@ func (s *Stream) GetRemotesAndIsLocal() ([]common.Address, bool) {
 }
 
 // GetQuorumAndReconcileNodesAndIsLocal returns
-// quorumNodes - a list of non-local nodes that participate in the stream quorum
-// reconcileNodes - a list of non-local nodes that reconcile the stream into local storage but don't participate in
-// quorum (yet)
+// quorumNodes - a list of nodes that participate in the stream quorum, including the local node
+// reconcileNodes - a list of nodes that reconcile the stream into local storage but don't participate in
+// quorum (yet), including the local node
 // isLocal - boolean, whether the stream is hosted on this node
 // GetQuorumAndReconcileNodesAndIsLocal is thread-safe.
 func (s *Stream) GetQuorumAndReconcileNodesAndIsLocal() ([]common.Address, []common.Address, bool) {
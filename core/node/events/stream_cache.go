@@ -201,6 +201,10 @@ func (s *StreamCache) Start(ctx context.Context, opts *MiniblockProducerOpts) er
 
 	go s.runCacheCleanup(ctx)
 
+	if s.params.Config.StreamReconciliation.ConsistencyCheck.Enabled {
+		go newStreamConsistencyChecker(s).run(ctx)
+	}
+
 	go func() {
 		<-ctx.Done()
 		s.stoppedMu.Lock()
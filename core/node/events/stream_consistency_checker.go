@@ -0,0 +1,281 @@
+package events
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// Divergence kinds reported by the stream consistency checker.
+const (
+	// divergenceForked means the replica has a different miniblock hash at the same miniblock number.
+	divergenceForked = "forked"
+	// divergenceLocalBehind means the local replica lags behind the remote replica.
+	divergenceLocalBehind = "local_behind"
+	// divergenceRemoteBehind means the remote replica lags behind the local replica.
+	divergenceRemoteBehind = "remote_behind"
+)
+
+// streamConsistencyChecker periodically samples local streams and compares the last miniblock
+// with the other replicas of the stream. Streams for which the local replica is forked or
+// lagging are queued for reconciliation. Remote replicas that lag behind are only reported,
+// the consistency checker on the remote node is responsible for reconciling its own copy.
+type streamConsistencyChecker struct {
+	cache *StreamCache
+	cfg   *config.StreamConsistencyCheckConfig
+
+	checks           *prometheus.CounterVec
+	divergences      *prometheus.CounterVec
+	divergentStreams prometheus.Gauge
+	reconcileQueued  prometheus.Counter
+}
+
+// replicaDivergence describes how a remote replica differs from the local replica.
+type replicaDivergence struct {
+	node   common.Address
+	kind   string
+	remote *MiniblockRef
+}
+
+// streamConsistencyReport is the result of checking a single stream.
+type streamConsistencyReport struct {
+	local *MiniblockRef
+	// replicas is the number of remote replicas that were checked.
+	replicas    int
+	divergences []replicaDivergence
+	// unreachable is the number of replicas that couldn't provide their last miniblock.
+	unreachable int
+}
+
+// needsReconciliation returns true when the local replica is forked or behind one of the remotes.
+func (r *streamConsistencyReport) needsReconciliation() bool {
+	for _, d := range r.divergences {
+		if d.kind == divergenceForked || d.kind == divergenceLocalBehind {
+			return true
+		}
+	}
+	return false
+}
+
+func newStreamConsistencyChecker(cache *StreamCache) *streamConsistencyChecker {
+	metrics := cache.params.Metrics
+	return &streamConsistencyChecker{
+		cache: cache,
+		cfg:   &cache.params.Config.StreamReconciliation.ConsistencyCheck,
+		checks: metrics.NewCounterVecEx(
+			"stream_consistency_checks",
+			"Number of streams checked for cross-replica consistency by result",
+			"result",
+		),
+		divergences: metrics.NewCounterVecEx(
+			"stream_consistency_divergences",
+			"Number of replica divergences detected by kind",
+			"kind",
+		),
+		divergentStreams: metrics.NewGaugeEx(
+			"stream_consistency_divergent_streams",
+			"Number of diverged streams found in the last consistency check round",
+		),
+		reconcileQueued: metrics.NewCounterEx(
+			"stream_consistency_reconcile_queued",
+			"Number of diverged streams queued for reconciliation",
+		),
+	}
+}
+
+func (c *streamConsistencyChecker) run(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+
+	for {
+		select {
+		case <-time.After(c.cfg.GetInterval()):
+			c.checkSample(ctx)
+		case <-ctx.Done():
+			log.Debugw("stream consistency checker shutdown")
+			return
+		}
+	}
+}
+
+// checkSample checks a random sample of local replicated streams.
+func (c *streamConsistencyChecker) checkSample(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+
+	diverged := 0
+	for _, stream := range c.sampleStreams() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		report, err := c.checkStream(ctx, stream)
+		if err != nil {
+			log.Infow("Stream consistency check failed", "streamId", stream.streamId, "error", err)
+			c.checks.WithLabelValues("error").Inc()
+			continue
+		}
+
+		switch {
+		case len(report.divergences) > 0:
+			c.checks.WithLabelValues("diverged").Inc()
+		case report.unreachable > 0:
+			c.checks.WithLabelValues("unreachable").Inc()
+		default:
+			c.checks.WithLabelValues("consistent").Inc()
+		}
+
+		if len(report.divergences) == 0 {
+			continue
+		}
+
+		diverged++
+		for _, d := range report.divergences {
+			c.divergences.WithLabelValues(d.kind).Inc()
+			log.Warnw("Stream replica diverged",
+				"streamId", stream.streamId,
+				"node", d.node,
+				"kind", d.kind,
+				"local", report.local,
+				"remote", d.remote,
+			)
+		}
+
+		if report.needsReconciliation() {
+			// Let the reconciler fetch the latest stream record from the registry and
+			// bring the local replica up to date.
+			c.cache.SubmitReconcileStreamTask(stream, nil)
+			c.reconcileQueued.Inc()
+		}
+	}
+
+	c.divergentStreams.Set(float64(diverged))
+}
+
+// sampleStreams returns up to SampleSize randomly selected streams that are replicated and
+// for which the local node participates in quorum.
+func (c *streamConsistencyChecker) sampleStreams() []*Stream {
+	size := c.cfg.GetSampleSize()
+	sample := make([]*Stream, 0, size)
+	seen := 0
+
+	c.cache.cache.Range(func(_ StreamId, stream *Stream) bool {
+		if !stream.IsLocalInQuorum() || len(stream.GetQuorumNodes()) < 2 {
+			return true
+		}
+
+		// reservoir sampling
+		seen++
+		if len(sample) < size {
+			sample = append(sample, stream)
+		} else if i := rand.IntN(seen); i < size {
+			sample[i] = stream
+		}
+		return true
+	})
+
+	return sample
+}
+
+// checkStream compares the local last miniblock for the given stream with the last miniblock of
+// the remote quorum and reconcile nodes. The local node is never checked against itself.
+func (c *streamConsistencyChecker) checkStream(
+	ctx context.Context,
+	stream *Stream,
+) (*streamConsistencyReport, error) {
+	local, err := stream.getLastMiniblockRefSkipLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	localNode := c.cache.params.Wallet.Address
+	quorumNodes, reconcileNodes, _ := stream.GetQuorumAndReconcileNodesAndIsLocal()
+	remotes := slices.DeleteFunc(
+		slices.Concat(quorumNodes, reconcileNodes),
+		func(node common.Address) bool { return node == localNode },
+	)
+
+	report := &streamConsistencyReport{local: local, replicas: len(remotes)}
+	maxLag := c.cfg.GetMaxLagMiniblocks()
+
+	for _, node := range remotes {
+		reqCtx, cancel := context.WithTimeout(ctx, c.cfg.GetRequestTimeout())
+		remote, err := c.cache.params.RemoteMiniblockProvider.GetLastMiniblockHash(reqCtx, node, stream.streamId)
+		cancel()
+		if err != nil {
+			logging.FromCtx(ctx).Debugw("Unable to get last miniblock hash from replica",
+				"streamId", stream.streamId, "node", node, "error", err)
+			report.unreachable++
+			continue
+		}
+
+		if kind := compareReplicaMiniblocks(local, remote, maxLag); kind != "" {
+			report.divergences = append(report.divergences, replicaDivergence{
+				node:   node,
+				kind:   kind,
+				remote: remote,
+			})
+		}
+	}
+
+	return report, nil
+}
+
+// compareReplicaMiniblocks compares the last miniblock of the local and a remote replica.
+// It returns the divergence kind or an empty string when the replicas are consistent.
+// Replicas that lag at most maxLag miniblocks are considered consistent since miniblocks
+// are produced while the check is in progress.
+func compareReplicaMiniblocks(local *MiniblockRef, remote *MiniblockRef, maxLag int64) string {
+	switch {
+	case local.Num == remote.Num && local.Hash != remote.Hash:
+		return divergenceForked
+	case remote.Num-local.Num > maxLag:
+		return divergenceLocalBehind
+	case local.Num-remote.Num > maxLag:
+		return divergenceRemoteBehind
+	default:
+		return ""
+	}
+}
+
+// getLastMiniblockRefSkipLoad returns the last miniblock reference for the given stream from the view
+// if loaded, or from storage otherwise.
+// getLastMiniblockRefSkipLoad is thread-safe.
+func (s *Stream) getLastMiniblockRefSkipLoad(ctx context.Context) (*MiniblockRef, error) {
+	s.mu.RLock()
+	view := s.getViewLocked()
+	s.mu.RUnlock()
+
+	if view != nil {
+		return view.LastBlock().Ref, nil
+	}
+
+	lastMbNum, err := s.params.Storage.GetLastMiniblockNumber(ctx, s.streamId)
+	if err != nil {
+		return nil, err
+	}
+
+	mbs, _, err := s.params.Storage.ReadMiniblocks(ctx, s.streamId, lastMbNum, lastMbNum+1, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(mbs) == 0 {
+		return nil, RiverError(Err_MINIBLOCKS_NOT_FOUND, "Last miniblock not found").
+			Tags("streamId", s.streamId, "miniblockNum", lastMbNum)
+	}
+
+	mb, err := NewMiniblockInfoFromDescriptor(mbs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return mb.Ref, nil
+}
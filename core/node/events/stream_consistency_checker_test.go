@@ -0,0 +1,94 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+func TestCompareReplicaMiniblocks(t *testing.T) {
+	hashA := common.HexToHash("0x0a")
+	hashB := common.HexToHash("0x0b")
+
+	tests := []struct {
+		name   string
+		local  *MiniblockRef
+		remote *MiniblockRef
+		want   string
+	}{
+		{"equal", &MiniblockRef{Num: 10, Hash: hashA}, &MiniblockRef{Num: 10, Hash: hashA}, ""},
+		{"forked", &MiniblockRef{Num: 10, Hash: hashA}, &MiniblockRef{Num: 10, Hash: hashB}, divergenceForked},
+		{"remote ahead within lag", &MiniblockRef{Num: 10, Hash: hashA}, &MiniblockRef{Num: 12, Hash: hashB}, ""},
+		{"local ahead within lag", &MiniblockRef{Num: 12, Hash: hashA}, &MiniblockRef{Num: 10, Hash: hashB}, ""},
+		{"local behind", &MiniblockRef{Num: 10, Hash: hashA}, &MiniblockRef{Num: 13, Hash: hashB}, divergenceLocalBehind},
+		{"remote behind", &MiniblockRef{Num: 13, Hash: hashA}, &MiniblockRef{Num: 10, Hash: hashB}, divergenceRemoteBehind},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, compareReplicaMiniblocks(tc.local, tc.remote, 2))
+		})
+	}
+}
+
+func TestStreamConsistencyChecker(t *testing.T) {
+	cfg := config.GetDefaultConfig()
+	cfg.StreamReconciliation.InitialWorkerPoolSize = 0
+	cfg.StreamReconciliation.OnlineWorkerPoolSize = 0
+
+	ctx, tc := makeCacheTestContext(
+		t,
+		testParams{
+			config:                      cfg,
+			replFactor:                  3,
+			numInstances:                3,
+			disableStreamCacheCallbacks: true,
+		},
+	)
+	require := tc.require
+
+	tc.initAllCaches(&MiniblockProducerOpts{TestDisableMbProdcutionOnBlock: true})
+
+	streamId, streamNodes, prevMb := tc.createReplStream()
+	upToDate := streamNodes[0:2]
+	lagging := streamNodes[2]
+
+	// Only promote miniblocks on the first two replicas, the third replica stays at genesis.
+	for range 4 {
+		tc.addReplEvent(streamId, prevMb, upToDate)
+		prevMb = tc.makeMiniblockNoCallbacks(upToDate, streamId, false)
+	}
+
+	// The lagging replica detects it is behind both other replicas and needs to reconcile.
+	laggingCache := tc.instancesByAddr[lagging].cache
+	stream, err := laggingCache.GetStreamNoWait(ctx, streamId)
+	require.NoError(err)
+	report, err := newStreamConsistencyChecker(laggingCache).checkStream(ctx, stream)
+	require.NoError(err)
+	require.EqualValues(0, report.local.Num)
+	require.Equal(2, report.replicas)
+	require.Zero(report.unreachable)
+	require.Len(report.divergences, 2)
+	for _, d := range report.divergences {
+		require.Equal(divergenceLocalBehind, d.kind)
+		require.Equal(prevMb.Num, d.remote.Num)
+	}
+	require.True(report.needsReconciliation())
+
+	// An up-to-date replica only reports the lagging remote and doesn't reconcile itself.
+	upToDateCache := tc.instancesByAddr[upToDate[0]].cache
+	stream, err = upToDateCache.GetStreamNoWait(ctx, streamId)
+	require.NoError(err)
+	report, err = newStreamConsistencyChecker(upToDateCache).checkStream(ctx, stream)
+	require.NoError(err)
+	require.Equal(prevMb, report.local)
+	require.Equal(2, report.replicas)
+	require.Len(report.divergences, 1)
+	require.Equal(lagging, report.divergences[0].node)
+	require.Equal(divergenceRemoteBehind, report.divergences[0].kind)
+	require.False(report.needsReconciliation())
+}
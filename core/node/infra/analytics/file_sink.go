@@ -0,0 +1,129 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// FileSink writes events as JSON lines to a file. When the file exceeds its maximum size it is renamed to
+// <path>.1, existing backups are shifted and backups beyond the maximum number of backups are removed.
+type FileSink struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFileSink opens or creates the file in cfg for appending.
+func NewFileSink(cfg config.AnalyticsFileSinkConfig) (*FileSink, error) {
+	s := &FileSink{
+		path:       cfg.Path,
+		maxSize:    cfg.GetMaxSizeBytes(),
+		maxBackups: cfg.GetMaxBackups(),
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+func (s *FileSink) Write(_ context.Context, events []Event) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return AsRiverError(err, Err_INTERNAL).Message("Unable to encode analytics event").Tag("event", event.Event)
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return RiverError(Err_UNAVAILABLE, "Analytics file sink closed", "path", s.path)
+	}
+
+	if s.size > 0 && s.size+int64(buf.Len()) > s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.file.Write(buf.Bytes())
+	s.size += int64(n)
+	if err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to write analytics events").Tag("path", s.path)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+func (s *FileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return AsRiverError(err, Err_BAD_CONFIG).Message("Unable to open analytics file").Tag("path", s.path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return AsRiverError(err, Err_BAD_CONFIG).Message("Unable to stat analytics file").Tag("path", s.path)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *FileSink) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+// rotate closes the current file, shifts the backups and opens a new file.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to close analytics file").Tag("path", s.path)
+	}
+	s.file = nil
+
+	if err := os.Remove(s.backupPath(s.maxBackups)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to remove analytics file backup").Tag("path", s.path)
+	}
+	for i := s.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(s.backupPath(i), s.backupPath(i+1)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to rotate analytics file").Tag("path", s.path)
+		}
+	}
+	if err := os.Rename(s.path, s.backupPath(1)); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to rotate analytics file").Tag("path", s.path)
+	}
+
+	return s.open()
+}
@@ -0,0 +1,165 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// ProducerMessage is a single message published by a Producer.
+type ProducerMessage struct {
+	Key   []byte
+	Value []byte
+}
+
+// Producer publishes messages to a Kafka-style topic. It decouples the KafkaSink from a specific client
+// library, deployments plug in the producer of their broker.
+type Producer interface {
+	// Produce publishes the messages to the topic and returns after they are acknowledged.
+	Produce(ctx context.Context, topic string, messages []ProducerMessage) error
+	// Close flushes and closes the producer.
+	Close() error
+}
+
+// KafkaSink publishes events as JSON messages to a topic. Messages are keyed by account so that the events
+// of an account end up in the same partition and retain their order.
+type KafkaSink struct {
+	producer Producer
+	topic    string
+}
+
+var _ Sink = (*KafkaSink)(nil)
+
+// NewKafkaSink creates a KafkaSink that publishes events to topic through producer.
+func NewKafkaSink(producer Producer, topic string) *KafkaSink {
+	return &KafkaSink{producer: producer, topic: topic}
+}
+
+func (s *KafkaSink) Name() string {
+	return "kafka"
+}
+
+func (s *KafkaSink) Write(ctx context.Context, events []Event) error {
+	messages := make([]ProducerMessage, len(events))
+	for i, event := range events {
+		value, err := json.Marshal(event)
+		if err != nil {
+			return AsRiverError(err, Err_INTERNAL).Message("Unable to encode analytics event").Tag("event", event.Event)
+		}
+		messages[i] = ProducerMessage{Key: event.AccountId.Bytes(), Value: value}
+	}
+
+	if err := s.producer.Produce(ctx, s.topic, messages); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to publish analytics events").Tag("topic", s.topic)
+	}
+	return nil
+}
+
+func (s *KafkaSink) Close() error {
+	return s.producer.Close()
+}
+
+// restProxyContentType is the content type of the Kafka REST proxy v2 API for base64 encoded binary records.
+const restProxyContentType = "application/vnd.kafka.binary.v2+json"
+
+// RestProxyProducer is a Producer that publishes messages through the REST proxy API (v2) of a Kafka broker,
+// which is served by Confluent REST Proxy and compatible brokers such as Redpanda.
+type RestProxyProducer struct {
+	client  *http.Client
+	baseURL string
+	headers map[string]string
+}
+
+var _ Producer = (*RestProxyProducer)(nil)
+
+// NewRestProxyProducer creates a RestProxyProducer. If client is nil a client with the configured timeout is used.
+func NewRestProxyProducer(cfg config.AnalyticsKafkaSinkConfig, client *http.Client) *RestProxyProducer {
+	if client == nil {
+		client = &http.Client{Timeout: cfg.GetTimeout()}
+	}
+	return &RestProxyProducer{
+		client:  client,
+		baseURL: strings.TrimSuffix(cfg.RestProxyURL, "/"),
+		headers: cfg.Headers,
+	}
+}
+
+// restProxyRecord is a record of a produce request, []byte values are base64 encoded by encoding/json as the
+// binary embedded format requires.
+type restProxyRecord struct {
+	Key   []byte `json:"key,omitempty"`
+	Value []byte `json:"value"`
+}
+
+type restProxyProduceRequest struct {
+	Records []restProxyRecord `json:"records"`
+}
+
+type restProxyProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *RestProxyProducer) Produce(ctx context.Context, topic string, messages []ProducerMessage) error {
+	records := make([]restProxyRecord, len(messages))
+	for i, msg := range messages {
+		records[i] = restProxyRecord{Key: msg.Key, Value: msg.Value}
+	}
+	body, err := json.Marshal(restProxyProduceRequest{Records: records})
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Message("Unable to encode Kafka records")
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		p.baseURL+"/topics/"+url.PathEscape(topic),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return AsRiverError(err, Err_BAD_CONFIG).Message("Invalid Kafka REST proxy url")
+	}
+	req.Header.Set("Content-Type", restProxyContentType)
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	for k, v := range p.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to produce Kafka records")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return RiverError(Err_UNAVAILABLE, "Kafka REST proxy rejected records", "status", resp.StatusCode)
+	}
+
+	// records are acknowledged individually, a successful response can still contain failed records
+	var produced restProxyProduceResponse
+	if err := json.NewDecoder(resp.Body).Decode(&produced); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to decode Kafka REST proxy response")
+	}
+	for _, offset := range produced.Offsets {
+		if offset.ErrorCode != nil || offset.Error != "" {
+			return RiverError(Err_UNAVAILABLE, "Kafka REST proxy failed to produce record", "error", offset.Error)
+		}
+	}
+	return nil
+}
+
+func (p *RestProxyProducer) Close() error {
+	p.client.CloseIdleConnections()
+	return nil
+}
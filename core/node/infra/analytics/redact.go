@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+const (
+	// redactAllEvents is the event name of redaction rules that apply to all events.
+	redactAllEvents = "*"
+
+	redactActionRemove = "remove"
+	redactActionHash   = "hash"
+)
+
+// redactor applies the configured redaction rules to event properties.
+type redactor struct {
+	// rules maps event names to property names and whether the property is hashed instead of removed
+	rules map[string]map[string]bool
+	// hashKey is the HMAC key of hashed properties, a plain hash of low entropy values such as emails or
+	// addresses is trivially reversed
+	hashKey []byte
+}
+
+func newRedactor(rules []config.AnalyticsRedactionRule, hashKey string) (*redactor, error) {
+	r := &redactor{rules: make(map[string]map[string]bool), hashKey: []byte(hashKey)}
+	for _, rule := range rules {
+		if rule.Event == "" {
+			return nil, RiverError(Err_BAD_CONFIG, "Analytics redaction rule without event")
+		}
+
+		var hash bool
+		switch rule.Action {
+		case "", redactActionRemove:
+		case redactActionHash:
+			if hashKey == "" {
+				return nil, RiverError(Err_BAD_CONFIG, "Analytics hash redaction rule requires a hash key",
+					"event", rule.Event)
+			}
+			hash = true
+		default:
+			return nil, RiverError(Err_BAD_CONFIG, "Unknown analytics redaction action",
+				"event", rule.Event, "action", rule.Action)
+		}
+
+		properties, ok := r.rules[rule.Event]
+		if !ok {
+			properties = make(map[string]bool)
+			r.rules[rule.Event] = properties
+		}
+		for _, property := range rule.Properties {
+			// removal takes precedence over hashing when rules overlap
+			if prev, exists := properties[property]; exists && !prev {
+				continue
+			}
+			properties[property] = hash
+		}
+	}
+	return r, nil
+}
+
+// redact returns a copy of properties with the redaction rules for the event applied.
+func (r *redactor) redact(event string, properties map[string]any) map[string]any {
+	result := make(map[string]any, len(properties))
+	for k, v := range properties {
+		result[k] = v
+	}
+
+	for _, rules := range []map[string]bool{r.rules[redactAllEvents], r.rules[event]} {
+		for property, hash := range rules {
+			value, ok := result[property]
+			if !ok {
+				continue
+			}
+			if hash {
+				mac := hmac.New(sha256.New, r.hashKey)
+				mac.Write([]byte(fmt.Sprint(value)))
+				result[property] = hex.EncodeToString(mac.Sum(nil))
+			} else {
+				delete(result, property)
+			}
+		}
+	}
+
+	return result
+}
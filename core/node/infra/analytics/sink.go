@@ -0,0 +1,210 @@
+package analytics
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// Event is a tracked analytics event as it is written to a Sink.
+type Event struct {
+	AccountId  common.Address `json:"accountId"`
+	Event      string         `json:"event"`
+	Properties map[string]any `json:"properties,omitempty"`
+	Timestamp  time.Time      `json:"timestamp"`
+}
+
+// Sink writes batches of analytics events to a destination. Write is never called concurrently for the
+// same sink.
+type Sink interface {
+	// Name identifies the sink in metrics and logs.
+	Name() string
+	// Write writes the given events, events of a failed write are dropped.
+	Write(ctx context.Context, events []Event) error
+	// Close releases the resources held by the sink, it is called after the last Write.
+	Close() error
+}
+
+// Reasons for which events are dropped.
+const (
+	dropReasonBufferFull  = "buffer_full"
+	dropReasonWriteFailed = "write_failed"
+)
+
+type sinkMetrics struct {
+	written *prometheus.CounterVec
+	dropped *prometheus.CounterVec
+}
+
+func newSinkMetrics(metrics infra.MetricsFactory) *sinkMetrics {
+	return &sinkMetrics{
+		written: metrics.NewCounterVecEx(
+			"analytics_events_written", "Number of analytics events written to a sink", "sink"),
+		dropped: metrics.NewCounterVecEx(
+			"analytics_events_dropped", "Number of analytics events dropped by a sink", "sink", "reason"),
+	}
+}
+
+// New creates an Analytics implementation that sends events to RudderStack if writeKey and dataPlaneURL are
+// set and to all sinks enabled in cfg. Sinks that can't be created from configuration, e.g. a KafkaSink with
+// the producer of a native client library, are passed through extraSinks. Property redaction rules in cfg apply to all destinations. Sinks are flushed and
+// closed when the context is cancelled.
+func New(
+	ctx context.Context,
+	writeKey string,
+	dataPlaneURL string,
+	cfg config.AnalyticsConfig,
+	metrics infra.MetricsFactory,
+	extraSinks ...Sink,
+) (Analytics, error) {
+	redactor, err := newRedactor(cfg.Redact, cfg.RedactHashKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sinks := extraSinks
+	if cfg.File.Path != "" {
+		fileSink, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.Webhook, nil))
+	}
+	if cfg.Kafka.RestProxyURL != "" {
+		if cfg.Kafka.Topic == "" {
+			return nil, RiverError(Err_BAD_CONFIG, "Analytics Kafka sink without topic")
+		}
+		sinks = append(sinks, NewKafkaSink(NewRestProxyProducer(cfg.Kafka, nil), cfg.Kafka.Topic))
+	}
+
+	var targets []Analytics
+	if writeKey != "" && dataPlaneURL != "" {
+		targets = append(targets, NewRudderstack(ctx, writeKey, dataPlaneURL))
+	}
+
+	sm := newSinkMetrics(metrics)
+	for _, sink := range sinks {
+		targets = append(targets, newSinkWriter(ctx, sink, &cfg, sm))
+	}
+
+	if len(targets) == 0 {
+		return &noopAnalytics{}, nil
+	}
+
+	return &multiAnalytics{targets: targets, redactor: redactor}, nil
+}
+
+// multiAnalytics redacts events and forwards them to all targets.
+type multiAnalytics struct {
+	targets  []Analytics
+	redactor *redactor
+}
+
+func (m *multiAnalytics) Track(ctx context.Context, accountId common.Address, event string, properties map[string]any) {
+	// redact copies properties, which protects targets from callers that reuse the map
+	props := m.redactor.redact(event, properties)
+	for _, target := range m.targets {
+		target.Track(ctx, accountId, event, props)
+	}
+}
+
+// sinkWriter buffers events in a bounded queue and writes them in batches to its sink from a single goroutine.
+// Events are dropped when the queue is full.
+type sinkWriter struct {
+	sink          Sink
+	events        chan Event
+	batchSize     int
+	flushInterval time.Duration
+	written       prometheus.Counter
+	droppedFull   prometheus.Counter
+	droppedWrite  prometheus.Counter
+	// done is closed after the sink is flushed and closed
+	done chan struct{}
+}
+
+func newSinkWriter(ctx context.Context, sink Sink, cfg *config.AnalyticsConfig, metrics *sinkMetrics) *sinkWriter {
+	w := &sinkWriter{
+		sink:          sink,
+		events:        make(chan Event, cfg.GetBufferSize()),
+		batchSize:     cfg.GetBatchSize(),
+		flushInterval: cfg.GetFlushInterval(),
+		written:       metrics.written.WithLabelValues(sink.Name()),
+		droppedFull:   metrics.dropped.WithLabelValues(sink.Name(), dropReasonBufferFull),
+		droppedWrite:  metrics.dropped.WithLabelValues(sink.Name(), dropReasonWriteFailed),
+		done:          make(chan struct{}),
+	}
+	go w.run(ctx)
+	return w
+}
+
+func (w *sinkWriter) Track(_ context.Context, accountId common.Address, event string, properties map[string]any) {
+	select {
+	case w.events <- Event{AccountId: accountId, Event: event, Properties: properties, Timestamp: time.Now()}:
+	default:
+		w.droppedFull.Inc()
+	}
+}
+
+func (w *sinkWriter) run(ctx context.Context) {
+	defer close(w.done)
+
+	ticker := time.NewTicker(w.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, w.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		// flush pending events on shutdown as well
+		writeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), trackTimeout)
+		defer cancel()
+		if err := w.sink.Write(writeCtx, batch); err != nil {
+			w.droppedWrite.Add(float64(len(batch)))
+			logging.FromCtx(ctx).Errorw("Failed to write analytics events",
+				"sink", w.sink.Name(), "events", len(batch), "error", err)
+		} else {
+			w.written.Add(float64(len(batch)))
+		}
+		batch = make([]Event, 0, w.batchSize)
+	}
+
+	for {
+		select {
+		case event := <-w.events:
+			batch = append(batch, event)
+			if len(batch) >= w.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-ctx.Done():
+			for {
+				select {
+				case event := <-w.events:
+					batch = append(batch, event)
+					if len(batch) >= w.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					if err := w.sink.Close(); err != nil {
+						logging.FromCtx(ctx).Errorw("Failed to close analytics sink", "sink", w.sink.Name(), "error", err)
+					}
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,276 @@
+package analytics
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/infra"
+)
+
+// captureSink records written batches. Writes fail while fail is set and block until block is closed.
+type captureSink struct {
+	mu      sync.Mutex
+	batches [][]Event
+	fail    bool
+	closed  bool
+	block   chan struct{}
+}
+
+func (s *captureSink) Name() string {
+	return "capture"
+}
+
+func (s *captureSink) Write(_ context.Context, events []Event) error {
+	if s.block != nil {
+		<-s.block
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail {
+		return context.DeadlineExceeded
+	}
+	s.batches = append(s.batches, events)
+	return nil
+}
+
+func (s *captureSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *captureSink) events() []Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var events []Event
+	for _, batch := range s.batches {
+		events = append(events, batch...)
+	}
+	return events
+}
+
+type testProducer struct {
+	topic    string
+	messages []ProducerMessage
+}
+
+func (p *testProducer) Produce(_ context.Context, topic string, messages []ProducerMessage) error {
+	p.topic = topic
+	p.messages = append(p.messages, messages...)
+	return nil
+}
+
+func (p *testProducer) Close() error {
+	return nil
+}
+
+func TestSinkWriterBatching(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.NewTestContext(t))
+	req := require.New(t)
+
+	sink := &captureSink{}
+	cfg := config.AnalyticsConfig{BatchSize: 3, FlushInterval: time.Hour}
+	w := newSinkWriter(ctx, sink, &cfg, newSinkMetrics(infra.NewMetricsFactory(prometheus.NewRegistry(), "", "")))
+
+	account := common.HexToAddress("0x1")
+	for range 4 {
+		w.Track(ctx, account, "posted message", map[string]any{"n": 1})
+	}
+
+	// a full batch is written without waiting for the flush interval
+	req.Eventually(func() bool { return len(sink.events()) == 3 }, 5*time.Second, 10*time.Millisecond)
+
+	// pending events are flushed on shutdown
+	cancel()
+	<-w.done
+	req.Len(sink.events(), 4)
+	req.True(sink.closed)
+	req.EqualValues(4, testutil.ToFloat64(w.written))
+}
+
+func TestSinkWriterDrops(t *testing.T) {
+	ctx, cancel := context.WithCancel(test.NewTestContext(t))
+	req := require.New(t)
+
+	sink := &captureSink{block: make(chan struct{})}
+	cfg := config.AnalyticsConfig{BatchSize: 1, BufferSize: 2, FlushInterval: time.Hour}
+	w := newSinkWriter(ctx, sink, &cfg, newSinkMetrics(infra.NewMetricsFactory(prometheus.NewRegistry(), "", "")))
+
+	// the first event blocks the writer, the next two fill the buffer and the rest is dropped
+	for range 10 {
+		w.Track(ctx, common.Address{}, "event", nil)
+		time.Sleep(time.Millisecond)
+	}
+	req.Positive(testutil.ToFloat64(w.droppedFull))
+
+	sink.mu.Lock()
+	sink.fail = true
+	sink.mu.Unlock()
+	close(sink.block)
+
+	cancel()
+	<-w.done
+	req.Equal(10.0, testutil.ToFloat64(w.droppedFull)+testutil.ToFloat64(w.droppedWrite))
+}
+
+func TestRedactor(t *testing.T) {
+	req := require.New(t)
+
+	_, err := newRedactor(
+		[]config.AnalyticsRedactionRule{{Event: "*", Properties: []string{"x"}, Action: "mask"}},
+		"key",
+	)
+	req.Error(err)
+
+	rules := []config.AnalyticsRedactionRule{
+		{Event: "*", Properties: []string{"email"}},
+		{Event: "posted message", Properties: []string{"channelId"}, Action: "hash"},
+	}
+
+	// hashing requires a key
+	_, err = newRedactor(rules, "")
+	req.Error(err)
+
+	r, err := newRedactor(rules, "key")
+	req.NoError(err)
+
+	props := map[string]any{"email": "a@b.c", "channelId": "abc", "length": 5}
+	redacted := r.redact("posted message", props)
+	req.NotContains(redacted, "email")
+	req.Len(redacted["channelId"], 64)
+	req.NotEqual("abc", redacted["channelId"])
+	req.Equal(5, redacted["length"])
+
+	// the input is not modified
+	req.Equal("a@b.c", props["email"])
+
+	other := r.redact("joined space", props)
+	req.NotContains(other, "email")
+	req.Equal("abc", other["channelId"])
+
+	// hashes are keyed, they can't be reproduced without the key
+	r2, err := newRedactor(rules, "other key")
+	req.NoError(err)
+	req.Equal(redacted["channelId"], r.redact("posted message", props)["channelId"])
+	req.NotEqual(redacted["channelId"], r2.redact("posted message", props)["channelId"])
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	sink, err := NewFileSink(config.AnalyticsFileSinkConfig{Path: path, MaxSizeBytes: 200, MaxBackups: 2})
+	req.NoError(err)
+
+	event := Event{AccountId: common.HexToAddress("0x1"), Event: "posted message", Timestamp: time.Now()}
+	for range 10 {
+		req.NoError(sink.Write(ctx, []Event{event, event}))
+	}
+	req.NoError(sink.Close())
+
+	for _, p := range []string{path, path + ".1", path + ".2"} {
+		f, err := os.Open(p)
+		req.NoError(err)
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var decoded Event
+			req.NoError(json.Unmarshal(scanner.Bytes(), &decoded))
+			req.Equal(event.Event, decoded.Event)
+		}
+		req.NoError(f.Close())
+	}
+	req.NoFileExists(path + ".3")
+}
+
+func TestWebhookSink(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	var received []Event
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req.Equal("Bearer secret", r.Header.Get("Authorization"))
+		var events []Event
+		req.NoError(json.NewDecoder(r.Body).Decode(&events))
+		received = append(received, events...)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(config.AnalyticsWebhookSinkConfig{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	}, nil)
+
+	events := []Event{{Event: "a"}, {Event: "b"}}
+	req.NoError(sink.Write(ctx, events))
+	req.Len(received, 2)
+
+	status = http.StatusInternalServerError
+	req.Error(sink.Write(ctx, events))
+}
+
+func TestKafkaSink(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	producer := &testProducer{}
+	sink := NewKafkaSink(producer, "analytics")
+
+	account := common.HexToAddress("0x1")
+	req.NoError(sink.Write(ctx, []Event{{AccountId: account, Event: "a"}}))
+	req.Equal("analytics", producer.topic)
+	req.Len(producer.messages, 1)
+	req.Equal(account.Bytes(), producer.messages[0].Key)
+}
+
+func TestRestProxyProducer(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	var received restProxyProduceRequest
+	response := `{"offsets":[{"partition":0,"offset":1}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req.Equal("/topics/analytics", r.URL.Path)
+		req.Equal(restProxyContentType, r.Header.Get("Content-Type"))
+		req.Equal("Bearer secret", r.Header.Get("Authorization"))
+		req.NoError(json.NewDecoder(r.Body).Decode(&received))
+		_, _ = w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	producer := NewRestProxyProducer(config.AnalyticsKafkaSinkConfig{
+		RestProxyURL: server.URL + "/",
+		Headers:      map[string]string{"Authorization": "Bearer secret"},
+	}, nil)
+	sink := NewKafkaSink(producer, "analytics")
+
+	account := common.HexToAddress("0x1")
+	req.NoError(sink.Write(ctx, []Event{{AccountId: account, Event: "a"}}))
+	req.Len(received.Records, 1)
+	req.Equal(account.Bytes(), received.Records[0].Key)
+	var event Event
+	req.NoError(json.Unmarshal(received.Records[0].Value, &event))
+	req.Equal("a", event.Event)
+
+	// records that fail are reported in a successful response
+	response = `{"offsets":[{"partition":null,"offset":null,"error_code":50301,"error":"unavailable"}]}`
+	req.Error(sink.Write(ctx, []Event{{AccountId: account, Event: "a"}}))
+}
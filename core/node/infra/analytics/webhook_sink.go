@@ -0,0 +1,67 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// WebhookSink posts each batch of events as a JSON array to an HTTP endpoint.
+type WebhookSink struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink creates a WebhookSink. If client is nil a client with the configured timeout is used.
+func NewWebhookSink(cfg config.AnalyticsWebhookSinkConfig, client *http.Client) *WebhookSink {
+	if client == nil {
+		client = &http.Client{Timeout: cfg.GetTimeout()}
+	}
+	return &WebhookSink{client: client, url: cfg.URL, headers: cfg.Headers}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Write(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Message("Unable to encode analytics events")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return AsRiverError(err, Err_BAD_CONFIG).Message("Invalid analytics webhook url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to post analytics events")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return RiverError(Err_UNAVAILABLE, "Analytics webhook rejected events", "status", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *WebhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
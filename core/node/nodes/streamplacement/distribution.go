@@ -54,6 +54,8 @@ type (
 		NodeStreamCount() map[common.Address]int64
 		// AddNewNode adds a new node to the distributor.
 		AddNewNode(operator common.Address, node common.Address)
+		// SetNodeLabels overrides the node placement labels from on-chain configuration.
+		SetNodeLabels(labels map[common.Address]NodeLabels)
 	}
 
 	// streamNode represents a registered operation node in the system that is placed
@@ -91,6 +93,8 @@ type (
 		nodeRegistryUpdated atomic.Bool
 		// onHeaderMu guards the onHeader callback to run in parallel
 		onHeaderMu deadlock.Mutex
+		// labels holds the node placement labels parsed from the on-chain configuration.
+		labels atomic.Pointer[placementLabels]
 	}
 )
 
@@ -123,6 +127,7 @@ func NewDistributor(
 	d := &streamsDistributor{cfg: cfg, riverRegistry: riverRegistry}
 	d.nodeRegistryUpdated.Store(false)
 	d.impl.Store(impl)
+	d.labels.Store(emptyPlacementLabels)
 
 	// onHeader is a ticker that reloads the distributor state when needed
 	chainMonitor.OnHeader(func(ctx context.Context, header *types.Header) {
@@ -175,6 +180,12 @@ func (d *streamsDistributor) ChooseStreamNodes(
 		extraCandidatesCount = defaultExtraCandidatesCount
 	}
 
+	labels := d.placementLabels(ctx, cfg.StreamDistribution.NodeLabels)
+	if labels.spreadDomains {
+		// pick enough candidates to be able to place each replica in a different failure domain
+		extraCandidatesCount = max(extraCandidatesCount, replFactor)
+	}
+
 	// Select a node from required operators if configured
 	requiredNode := selectRequiredOperatorNode(
 		impl.nodes,
@@ -191,18 +202,78 @@ func (d *streamsDistributor) ChooseStreamNodes(
 
 	// Select candidate nodes using pseudo-random selection
 	candidates, candidatesFound := selectCandidateNodes(
-		impl.nodes, streamID, candidatesWanted, uniqueOperators, requiredNode,
+		impl.nodes, streamID, candidatesWanted, uniqueOperators, requiredNode, labels,
 	)
 
-	// Sort candidates by stream count (the least loaded first)
+	// Sort candidates by stream count relative to their capacity (the least loaded first)
 	slices.SortFunc(candidates[:candidatesFound], func(x, y *streamNode) int {
-		return cmp.Compare(x.streamCount.Load(), y.streamCount.Load())
+		return cmp.Compare(labels.weightedLoad(x), labels.weightedLoad(y))
 	})
 
+	// Spread candidates over failure domains while preferring the least loaded nodes
+	if labels.spreadDomains {
+		spreadOverFailureDomains(candidates[:candidatesFound], labels)
+	}
+
 	// Build final node list, ensuring required operator node is included
 	return buildFinalNodeList(candidates[:candidatesFound], replFactor, requiredNode), nil
 }
 
+// placementLabels returns the node labels for the given on-chain setting value. Labels are only
+// parsed when the setting changed. Invalid settings are logged and ignored.
+func (d *streamsDistributor) placementLabels(ctx context.Context, source string) *placementLabels {
+	labels := d.labels.Load()
+	if labels.simulated || labels.source == source {
+		return labels
+	}
+
+	parsed, err := ParseNodeLabels(source)
+	if err != nil {
+		logging.FromCtx(ctx).Errorw("Invalid stream distribution node labels, ignoring", "err", err)
+		parsed = nil
+	}
+
+	newLabels := newPlacementLabels(source, parsed)
+	d.labels.CompareAndSwap(labels, newLabels)
+	return newLabels
+}
+
+// spreadOverFailureDomains reorders the given candidates, which are sorted by load, in such a way
+// that each next candidate is the least loaded candidate from a region not yet used by previous
+// candidates. If no such candidate exists the least loaded candidate from an unused zone is picked.
+// Candidates without region/zone labels never count as a new failure domain.
+func spreadOverFailureDomains(candidates []*streamNode, labels *placementLabels) {
+	var (
+		usedRegions = make(map[string]struct{}, len(candidates))
+		usedZones   = make(map[string]struct{}, len(candidates))
+	)
+
+	for i := range candidates {
+		bestIndex, bestScore := i, -1
+		for j := i; j < len(candidates); j++ {
+			region, zone := labels.domain(candidates[j])
+			score := 0
+			if _, used := usedRegions[region]; region != "" && !used {
+				score = 2
+			} else if _, used := usedZones[zone]; zone != "" && !used {
+				score = 1
+			}
+			if score > bestScore {
+				bestIndex, bestScore = j, score
+			}
+		}
+
+		// keep the load order for the remaining candidates
+		best := candidates[bestIndex]
+		copy(candidates[i+1:bestIndex+1], candidates[i:bestIndex])
+		candidates[i] = best
+
+		region, zone := labels.domain(best)
+		usedRegions[region] = struct{}{}
+		usedZones[zone] = struct{}{}
+	}
+}
+
 // selectRequiredOperatorNode selects a node from required operators using weighted scoring.
 // Returns nil if no required operators are configured or none have operational nodes.
 // minBalancingAdvantageBps and maxBalancingAdvantageBps are in basis points (e.g., 500 = 5%).
@@ -335,14 +406,15 @@ func calculateCandidatesCount(
 	return candidatesWanted, uniqueOperators
 }
 
-// selectCandidateNodes selects candidate nodes using pseudo-random selection.
-// If requiredNode is provided, it is included as the first candidate.
+// selectCandidateNodes selects candidate nodes using pseudo-random selection weighted by node
+// capacity. If requiredNode is provided, it is included as the first candidate.
 func selectCandidateNodes(
 	implNodes []*streamNode,
 	streamID StreamId,
 	candidatesWanted int,
 	uniqueOperators bool,
 	requiredNode *streamNode,
+	labels *placementLabels,
 ) (nodes []*streamNode, candidatesFound int) {
 	nodes = slices.Clone(implNodes)
 	selectedOperators := make([]common.Address, 0, candidatesWanted)
@@ -360,11 +432,69 @@ func selectCandidateNodes(
 		candidatesFound = 1
 	}
 
+	// When nodes declare failure domains first try to select candidates from different regions
+	// and only then fall back to selecting candidates without this constraint.
+	if labels.spreadDomains {
+		selectedRegions := make(map[string]struct{}, candidatesWanted)
+		if requiredNode != nil {
+			if region, _ := labels.domain(requiredNode); region != "" {
+				selectedRegions[region] = struct{}{}
+			}
+		}
+
+		candidatesFound = selectRemainingCandidates(
+			nodes, h, streamID, candidatesFound, candidatesWanted, labels,
+			func(node *streamNode) bool {
+				if uniqueOperators && slices.Contains(selectedOperators, node.Operator) {
+					return false
+				}
+				region, _ := labels.domain(node)
+				if _, selected := selectedRegions[region]; region != "" && selected {
+					return false
+				}
+				selectedOperators = append(selectedOperators, node.Operator)
+				if region != "" {
+					selectedRegions[region] = struct{}{}
+				}
+				return true
+			},
+		)
+	}
+
 	// Select remaining candidates pseudo-randomly
+	candidatesFound = selectRemainingCandidates(
+		nodes, h, streamID, candidatesFound, candidatesWanted, labels,
+		func(node *streamNode) bool {
+			// Check unique operators constraint
+			if uniqueOperators && slices.Contains(selectedOperators, node.Operator) {
+				return false
+			}
+			selectedOperators = append(selectedOperators, node.Operator)
+			return true
+		},
+	)
+
+	return nodes, candidatesFound
+}
+
+// selectRemainingCandidates picks pseudo-random nodes from nodes[candidatesFound:] and moves them
+// to the front until candidatesWanted candidates are found or all nodes are considered. Nodes for
+// which accept returns false are moved to the end and not considered again in this call. It returns
+// the number of candidates found.
+func selectRemainingCandidates(
+	nodes []*streamNode,
+	h *xxhash.Digest,
+	streamID StreamId,
+	candidatesFound int,
+	candidatesWanted int,
+	labels *placementLabels,
+	accept func(node *streamNode) bool,
+) int {
+	end := len(nodes)
 	for candidatesFound < candidatesWanted {
 		// Check if we've exhausted all available nodes (can happen when uniqueOperators
 		// is true and all remaining nodes belong to already-selected operators)
-		remaining := len(nodes) - candidatesFound
+		remaining := end - candidatesFound
 		if remaining <= 0 {
 			break
 		}
@@ -372,24 +502,45 @@ func selectCandidateNodes(
 		_, _ = h.Write(streamID[:])
 
 		// Pick a pseudo-random node from remaining nodes
-		index := candidatesFound + int(h.Sum64()%uint64(remaining))
+		index := pickCandidateIndex(nodes[:end], candidatesFound, h.Sum64(), labels)
 		selectedNode := nodes[index]
 
-		// Check unique operators constraint
-		if uniqueOperators && slices.Contains(selectedOperators, selectedNode.Operator) {
-			// Swap invalid candidate with last node and truncate
-			nodes[index] = nodes[len(nodes)-1]
-			nodes = nodes[:len(nodes)-1]
+		if !accept(selectedNode) {
+			// Swap invalid candidate with last remaining node
+			end--
+			nodes[index], nodes[end] = nodes[end], nodes[index]
 			continue
 		}
 
 		// Valid candidate: move to front of remaining nodes
 		nodes[candidatesFound], nodes[index] = selectedNode, nodes[candidatesFound]
-		selectedOperators = append(selectedOperators, selectedNode.Operator)
 		candidatesFound++
 	}
+	return candidatesFound
+}
 
-	return nodes, candidatesFound
+// pickCandidateIndex picks a node from nodes[from:] using the given pseudo-random number.
+// The probability that a node is picked is proportional to its capacity.
+func pickCandidateIndex(nodes []*streamNode, from int, random uint64, labels *placementLabels) int {
+	if labels.uniformCapacity {
+		return from + int(random%uint64(len(nodes)-from))
+	}
+
+	var totalCapacity uint64
+	for _, node := range nodes[from:] {
+		totalCapacity += labels.capacity(node)
+	}
+
+	target := random % totalCapacity
+	for i := from; i < len(nodes); i++ {
+		capacity := labels.capacity(nodes[i])
+		if target < capacity {
+			return i
+		}
+		target -= capacity
+	}
+
+	return len(nodes) - 1
 }
 
 // buildFinalNodeList builds the final list of node addresses, ensuring the
@@ -542,6 +693,13 @@ func (d *streamsDistributor) AddNewNode(operator common.Address, node common.Add
 	d.impl.Store(impl)
 }
 
+// SetNodeLabels is a debug endpoint for simulations/tests and must not be used in production code.
+func (d *streamsDistributor) SetNodeLabels(labels map[common.Address]NodeLabels) {
+	pl := newPlacementLabels("", maps.Clone(labels))
+	pl.simulated = true
+	d.labels.Store(pl)
+}
+
 func (d *streamsDistributor) NodeStreamLoad(node common.Address) uint64 {
 	impl := d.impl.Load()
 	if streamNode, found := impl.nodesMap[node]; found {
@@ -0,0 +1,147 @@
+package streamplacement
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+const (
+	// defaultNodeCapacity is the capacity of a node that doesn't declare one.
+	defaultNodeCapacity = 1
+	// maxNodeCapacity limits the declared node capacity to keep weighted selection arithmetic
+	// far away from overflows.
+	maxNodeCapacity = 1_000
+)
+
+// NodeLabels holds the placement labels of a node. Capacity is a relative weight, a node
+// with capacity 4 is expected to hold 4 times as many streams as a node with capacity 1.
+// Region and Zone describe the failure domain of the node.
+type NodeLabels struct {
+	Capacity uint64
+	Region   string
+	Zone     string
+}
+
+// placementLabels holds the parsed node labels the distributor uses for stream placement.
+// It is immutable after creation and therefore safe to use concurrently.
+type placementLabels struct {
+	// source is the on-chain setting value these labels are parsed from.
+	source string
+	// simulated is true when the labels were set through the DistributorSimulator and must
+	// not be overwritten by on-chain configuration.
+	simulated bool
+	labels    map[common.Address]NodeLabels
+	// uniformCapacity is true when all nodes have the same capacity.
+	uniformCapacity bool
+	// spreadDomains is true when at least one node declares a region or zone.
+	spreadDomains bool
+}
+
+// emptyPlacementLabels is used when no node labels are configured.
+var emptyPlacementLabels = newPlacementLabels("", nil)
+
+func newPlacementLabels(source string, labels map[common.Address]NodeLabels) *placementLabels {
+	pl := &placementLabels{
+		source:          source,
+		labels:          labels,
+		uniformCapacity: true,
+	}
+	for node, l := range labels {
+		if l.Capacity == 0 {
+			l.Capacity = defaultNodeCapacity
+			labels[node] = l
+		}
+		if l.Capacity != defaultNodeCapacity {
+			pl.uniformCapacity = false
+		}
+		if l.Region != "" || l.Zone != "" {
+			pl.spreadDomains = true
+		}
+	}
+	return pl
+}
+
+// capacity returns the declared capacity of the given node.
+func (pl *placementLabels) capacity(node *streamNode) uint64 {
+	if l, ok := pl.labels[node.NodeAddress]; ok {
+		return l.Capacity
+	}
+	return defaultNodeCapacity
+}
+
+// weightedLoad returns the number of streams on the node relative to its capacity.
+func (pl *placementLabels) weightedLoad(node *streamNode) float64 {
+	return float64(node.streamCount.Load()) / float64(pl.capacity(node))
+}
+
+// domain returns the region and zone of the given node.
+func (pl *placementLabels) domain(node *streamNode) (region string, zone string) {
+	l := pl.labels[node.NodeAddress]
+	return l.Region, l.Zone
+}
+
+// ParseNodeLabels parses the `stream.distribution.nodelabels` on-chain setting. The value is a
+// semicolon separated list of `<node address>:<key>=<value>,...` entries where key is one of
+// capacity, region or zone.
+func ParseNodeLabels(value string) (map[common.Address]NodeLabels, error) {
+	result := make(map[common.Address]NodeLabels)
+	for entry := range strings.SplitSeq(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		addr, attrs, found := strings.Cut(entry, ":")
+		addr = strings.TrimSpace(addr)
+		if !found || !common.IsHexAddress(addr) {
+			return nil, RiverError(Err_BAD_CONFIG, "Invalid node labels entry", "entry", entry).
+				Func("ParseNodeLabels")
+		}
+
+		node := common.HexToAddress(addr)
+		if _, exists := result[node]; exists {
+			return nil, RiverError(Err_BAD_CONFIG, "Duplicate node labels entry", "node", node).
+				Func("ParseNodeLabels")
+		}
+
+		labels := NodeLabels{Capacity: defaultNodeCapacity}
+		for attr := range strings.SplitSeq(attrs, ",") {
+			attr = strings.TrimSpace(attr)
+			if attr == "" {
+				continue
+			}
+			key, val, found := strings.Cut(attr, "=")
+			key, val = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(val)
+			if !found || val == "" {
+				return nil, RiverError(Err_BAD_CONFIG, "Invalid node label", "node", node, "label", attr).
+					Func("ParseNodeLabels")
+			}
+
+			switch key {
+			case "capacity":
+				capacity, err := strconv.ParseUint(val, 10, 64)
+				if err != nil || capacity == 0 || capacity > maxNodeCapacity {
+					return nil, RiverError(Err_BAD_CONFIG, "Invalid node capacity",
+						"node", node, "capacity", val, "max", maxNodeCapacity).
+						Func("ParseNodeLabels")
+				}
+				labels.Capacity = capacity
+			case "region":
+				labels.Region = val
+			case "zone":
+				labels.Zone = val
+			default:
+				return nil, RiverError(Err_BAD_CONFIG, "Unknown node label", "node", node, "label", key).
+					Func("ParseNodeLabels")
+			}
+		}
+
+		result[node] = labels
+	}
+	return result, nil
+}
@@ -0,0 +1,147 @@
+package streamplacement
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils/mocks"
+)
+
+func TestParseNodeLabels(t *testing.T) {
+	node1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	node2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	labels, err := ParseNodeLabels(fmt.Sprintf(
+		" %s:capacity=4,region=us-east,zone=us-east-1a ; %s:region=eu-west;", node1.Hex(), node2.Hex()))
+	require.NoError(t, err)
+	require.Equal(t, map[common.Address]NodeLabels{
+		node1: {Capacity: 4, Region: "us-east", Zone: "us-east-1a"},
+		node2: {Capacity: 1, Region: "eu-west"},
+	}, labels)
+
+	labels, err = ParseNodeLabels("")
+	require.NoError(t, err)
+	require.Empty(t, labels)
+
+	for _, invalid := range []string{
+		"not-an-address:capacity=1",
+		node1.Hex(),
+		node1.Hex() + ":capacity=0",
+		node1.Hex() + ":capacity=-1",
+		node1.Hex() + ":capacity=1001",
+		node1.Hex() + ":rack=12",
+		node1.Hex() + ":region",
+		node1.Hex() + ":region=a;" + node1.Hex() + ":region=b",
+	} {
+		_, err := ParseNodeLabels(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+// newTestDistributor creates a distributor with the given number of nodes, each node is operated
+// by a different operator.
+func newTestDistributor(numNodes int, settings *crypto.OnChainSettings) (*streamsDistributor, []common.Address) {
+	d := &streamsDistributor{cfg: &mocks.MockOnChainCfg{Settings: settings}}
+	d.impl.Store(&distributorImpl{
+		nodesMap:  make(map[common.Address]*streamNode),
+		operators: make(map[common.Address]struct{}),
+	})
+	d.labels.Store(emptyPlacementLabels)
+
+	var nodes []common.Address
+	for i := range numNodes {
+		node := common.BytesToAddress([]byte{byte(i + 1)})
+		operator := common.BytesToAddress([]byte{0xff, byte(i + 1)})
+		d.AddNewNode(operator, node)
+		nodes = append(nodes, node)
+	}
+	return d, nodes
+}
+
+func nextTestStreamID(t *testing.T, streamID StreamId) StreamId {
+	b := sha256.Sum256(streamID[:])
+	b[0] = STREAM_CHANNEL_BIN
+	streamID, err := StreamIdFromBytes(b[:STREAM_ID_BYTES_LENGTH])
+	require.NoError(t, err)
+	return streamID
+}
+
+func TestChooseStreamNodesWeightedByCapacity(t *testing.T) {
+	d, nodes := newTestDistributor(6, &crypto.OnChainSettings{})
+
+	labels := make(map[common.Address]NodeLabels)
+	for i, node := range nodes {
+		labels[node] = NodeLabels{Capacity: uint64(1 + i%2)} // odd nodes have double capacity
+	}
+	d.SetNodeLabels(labels)
+
+	var streamID StreamId
+	for range 30_000 {
+		streamID = nextTestStreamID(t, streamID)
+		selected, err := d.ChooseStreamNodes(t.Context(), streamID, 3)
+		require.NoError(t, err)
+		for _, node := range selected {
+			d.AssignStreamToNode(node)
+		}
+	}
+
+	counts := d.NodeStreamCount()
+	for i, node := range nodes {
+		weighted := counts[node] / int64(1+i%2)
+		// 90_000 replicas over a total capacity of 9 is 10_000 per capacity unit
+		require.InDelta(t, 10_000, weighted, 1_000, "node %d has unexpected load %d", i, counts[node])
+	}
+}
+
+func TestChooseStreamNodesSpreadsOverFailureDomains(t *testing.T) {
+	d, nodes := newTestDistributor(9, &crypto.OnChainSettings{})
+
+	regions := []string{"us-east", "eu-west", "ap-south"}
+	labels := make(map[common.Address]NodeLabels)
+	for i, node := range nodes {
+		region := regions[i%len(regions)]
+		labels[node] = NodeLabels{Region: region, Zone: fmt.Sprintf("%s-%d", region, i)}
+	}
+	d.SetNodeLabels(labels)
+
+	var streamID StreamId
+	for range 5_000 {
+		streamID = nextTestStreamID(t, streamID)
+		selected, err := d.ChooseStreamNodes(t.Context(), streamID, 3)
+		require.NoError(t, err)
+
+		usedRegions := make(map[string]struct{})
+		for _, node := range selected {
+			usedRegions[labels[node].Region] = struct{}{}
+			d.AssignStreamToNode(node)
+		}
+		require.Len(t, usedRegions, 3, "replicas must be placed in different regions")
+	}
+}
+
+func TestNodeLabelsFromOnChainConfig(t *testing.T) {
+	settings := &crypto.OnChainSettings{}
+	d, nodes := newTestDistributor(3, settings)
+
+	settings.StreamDistribution.NodeLabels = fmt.Sprintf("%s:capacity=3,region=us-east", nodes[0].Hex())
+	labels := d.placementLabels(t.Context(), settings.StreamDistribution.NodeLabels)
+	require.False(t, labels.uniformCapacity)
+	require.True(t, labels.spreadDomains)
+	require.EqualValues(t, 3, labels.capacity(d.impl.Load().nodesMap[nodes[0]]))
+	require.EqualValues(t, 1, labels.capacity(d.impl.Load().nodesMap[nodes[1]]))
+
+	// invalid labels are ignored
+	settings.StreamDistribution.NodeLabels = "invalid"
+	labels = d.placementLabels(t.Context(), settings.StreamDistribution.NodeLabels)
+	require.True(t, labels.uniformCapacity)
+	require.False(t, labels.spreadDomains)
+
+	_, err := d.ChooseStreamNodes(t.Context(), nextTestStreamID(t, StreamId{}), 3)
+	require.NoError(t, err)
+}
@@ -15,8 +15,10 @@ import (
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
 
+	"github.com/SherClockHolmes/webpush-go"
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/sideshow/apns2"
 	"github.com/sideshow/apns2/payload"
 	"google.golang.org/protobuf/proto"
 
@@ -57,15 +59,32 @@ type MessageToNotificationsProcessor struct {
 	subscriptionExpiration time.Duration
 	notifier               push.MessageNotifier
 	log                    *logging.Log
+	// lowPriorityDelay is the delay for low priority notifications that gives the user's other
+	// devices the opportunity to mark the message as read before the notification is sent.
+	lowPriorityDelay time.Duration
+	readState        *readStateTracker
+	// spaceRoles resolves role mentions in space channels, nil when role mentions are not resolved.
+	spaceRoles SpaceRoles
+	// streamOwner reports if this instance still owns a stream when streams are partitioned over
+	// instances, nil when this instance processes all streams.
+	streamOwner StreamOwner
+}
+
+// StreamOwner reports if this instance is responsible for sending notifications for a stream.
+type StreamOwner interface {
+	Owns(streamID shared.StreamId) bool
 }
 
 // NewNotificationMessageProcessor processes incoming messages, determines when and to whom to send a notification
-// for a processed message and sends it.
+// for a processed message and sends it. Role mentions are only resolved when spaceRoles is not nil. When streamOwner
+// is not nil delayed notifications are dropped for streams this instance no longer owns.
 func NewNotificationMessageProcessor(
 	ctx context.Context,
 	userPreferences UserPreferencesStore,
 	config config.NotificationsConfig,
 	notifier push.MessageNotifier,
+	spaceRoles SpaceRoles,
+	streamOwner StreamOwner,
 ) *MessageToNotificationsProcessor {
 	subscriptionExpiration := 90 * 24 * time.Hour // 90 days default
 	if config.SubscriptionExpirationDuration > time.Duration(0) {
@@ -78,6 +97,10 @@ func NewNotificationMessageProcessor(
 		cache:                  userPreferences,
 		subscriptionExpiration: subscriptionExpiration,
 		log:                    logging.FromCtx(ctx),
+		lowPriorityDelay:       config.GetLowPriorityNotificationDelay(),
+		readState:              newReadStateTracker(),
+		spaceRoles:             spaceRoles,
+		streamOwner:            streamOwner,
 	}
 }
 
@@ -104,9 +127,20 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 	}
 	l.Debugw("Process event")
 
+	if markers := event.Event.GetUserSettingsPayload().GetFullyReadMarkers(); markers != nil {
+		p.onFullyReadMarkers(ctx, channelID, event, markers)
+		return
+	}
+
 	kind := "new_message"
 	tags := event.Event.GetTags()
 
+	// redactions through the channel payload refer to the redacted event in plain text
+	if event.Event.GetChannelPayload().GetRedaction() != nil {
+		p.onMessageUpdate(ctx, channelID, spaceID, members, event, "redaction")
+		return
+	}
+
 	switch tags.GetMessageInteractionType() {
 	case MessageInteractionType_MESSAGE_INTERACTION_TYPE_TIP:
 		kind = "tip"
@@ -121,14 +155,18 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 	case MessageInteractionType_MESSAGE_INTERACTION_TYPE_TRADE:
 		kind = "trade"
 	case MessageInteractionType_MESSAGE_INTERACTION_TYPE_EDIT:
+		p.onMessageUpdate(ctx, channelID, spaceID, members, event, "edit")
 		return
 	case MessageInteractionType_MESSAGE_INTERACTION_TYPE_REDACTION:
+		p.onMessageUpdate(ctx, channelID, spaceID, members, event, "redaction")
 		return
 	case MessageInteractionType_MESSAGE_INTERACTION_TYPE_SLASH_COMMAND:
 		return
 	}
 
 	usersToNotify := make(map[common.Address]*types.UserPreferences)
+	// triggers keeps the users that are notified because of a role mention
+	triggers := make(map[common.Address]string)
 	recipients := mapset.NewSet[common.Address]()
 	sender := common.BytesToAddress(event.Event.CreatorAddress)
 
@@ -194,8 +232,13 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 			recipients.Add(participant)
 		case *StreamEvent_ChannelPayload:
 			if spaceID != nil {
-				if p.onSpaceChannelPayload(*spaceID, channelID, participant, pref, event) {
+				if notify, trigger := p.onSpaceChannelPayload(
+					ctx, *spaceID, channelID, participant, pref, event,
+				); notify {
 					usersToNotify[participant] = pref
+					if trigger != "" {
+						triggers[participant] = trigger
+					}
 				}
 				recipients.Add(participant)
 			} else {
@@ -206,7 +249,9 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 			// for member payloads we need to figure out what kind of stream we're in before we can check prefs
 			case *MemberPayload_MemberBlockchainTransaction_:
 				if spaceID != nil && shared.ValidChannelStreamId(&channelID) {
-					if p.onSpaceChannelPayload(*spaceID, channelID, participant, pref, event) {
+					if notify, _ := p.onSpaceChannelPayload(
+						ctx, *spaceID, channelID, participant, pref, event,
+					); notify {
 						usersToNotify[participant] = pref
 					}
 					recipients.Add(participant)
@@ -231,9 +276,92 @@ func (p *MessageToNotificationsProcessor) OnMessageEvent(
 
 	recipients.Remove(sender)
 
+	// role mention notifications are sent immediately with their own kind
+	if len(triggers) > 0 {
+		triggered := make(map[string]map[common.Address]*types.UserPreferences)
+		for user, trigger := range triggers {
+			if triggered[trigger] == nil {
+				triggered[trigger] = make(map[common.Address]*types.UserPreferences)
+			}
+			triggered[trigger][user] = usersToNotify[user]
+			delete(usersToNotify, user)
+		}
+		for trigger, users := range triggered {
+			p.deliverNotifications(ctx, spaceID, channelID, event, trigger, members, users)
+		}
+	}
+
+	if len(usersToNotify) == 0 {
+		return
+	}
+
+	// give the user's other devices the opportunity to mark low priority messages as read
+	// before the notification is sent
+	if p.lowPriorityDelay > 0 && isLowPriorityNotification(kind) {
+		time.AfterFunc(p.lowPriorityDelay, func() {
+			p.deliverDelayedNotifications(spaceID, channelID, event, kind, members, usersToNotify)
+		})
+		return
+	}
+
+	p.deliverNotifications(ctx, spaceID, channelID, event, kind, members, usersToNotify)
+}
+
+// deliverDelayedNotifications sends a delayed notification when this instance still owns the stream. The
+// stream can be taken over by another instance during the delay, the notification is dropped to prevent
+// both instances from sending it.
+func (p *MessageToNotificationsProcessor) deliverDelayedNotifications(
+	spaceID *shared.StreamId,
+	channelID shared.StreamId,
+	event *events.ParsedEvent,
+	kind string,
+	members mapset.Set[string],
+	usersToNotify map[common.Address]*types.UserPreferences,
+) {
+	if p.streamOwner != nil && !p.streamOwner.Owns(channelID) {
+		p.log.Debugw("Drop delayed notification for stream that is no longer owned",
+			"channel", channelID, "event", event.Hash)
+		return
+	}
+	p.deliverNotifications(p.ctx, spaceID, channelID, event, kind, members, usersToNotify)
+}
+
+// deliverNotifications sends the notification for the given event to the given users, except to
+// users that already marked the message as read on one of their devices.
+func (p *MessageToNotificationsProcessor) deliverNotifications(
+	ctx context.Context,
+	spaceID *shared.StreamId,
+	channelID shared.StreamId,
+	event *events.ParsedEvent,
+	kind string,
+	members mapset.Set[string],
+	usersToNotify map[common.Address]*types.UserPreferences,
+) {
+	var (
+		key       = readMarkerKeyForEvent(channelID, event)
+		createdAt = time.UnixMilli(event.Event.GetCreatedAtEpochMs())
+		notified  = make(map[common.Address]*types.UserPreferences, len(usersToNotify))
+	)
+
 	for user, userPref := range usersToNotify {
-		p.sendNotification(ctx, user, userPref, spaceID, channelID, event, kind, members)
+		if p.readState.isRead(user, key, createdAt) {
+			p.log.Debugw("Message already read on other device",
+				"user", user, "channel", channelID, "event", event.Hash)
+			continue
+		}
+
+		p.sendNotification(ctx, user, userPref, spaceID, channelID, event, kind, members, event.Hash)
+		p.readState.markNotified(user, key, time.Now())
+		notified[user] = userPref
 	}
+
+	p.recordSentNotifications(ctx, channelID, event, kind, notified)
+}
+
+// isLowPriorityNotification returns true for notifications that can be delayed. Direct messages,
+// mentions, replies and tips are sent immediately.
+func isLowPriorityNotification(kind string) bool {
+	return kind == "new_message" || kind == "reaction"
 }
 
 func (p *MessageToNotificationsProcessor) onDMChannelPayload(
@@ -315,15 +443,16 @@ func (p *MessageToNotificationsProcessor) onGDMChannelPayload(
 }
 
 func (p *MessageToNotificationsProcessor) onSpaceChannelPayload(
+	ctx context.Context,
 	spaceID shared.StreamId,
 	channelID shared.StreamId,
 	participant common.Address,
 	userPref *types.UserPreferences,
 	event *events.ParsedEvent,
-) bool {
+) (bool, string) {
 	if streamChannelPayload, ok := event.Event.Payload.(*StreamEvent_ChannelPayload); ok {
 		if streamChannelPayload.ChannelPayload.GetMessage() == nil {
-			return false // inception or channel properties
+			return false, "" // inception or channel properties
 		}
 	}
 
@@ -332,15 +461,20 @@ func (p *MessageToNotificationsProcessor) onSpaceChannelPayload(
 	mentioned := isMentioned(participant, tags.GetGroupMentionTypes(), tags.GetMentionedUserAddresses())
 	participating := isParticipating(participant, tags.GetParticipatingUserAddresses())
 
+	trigger := ""
+	if !mentioned && p.mentionsRole(ctx, spaceID, participant, tags.GetMentionedRoleIds()) {
+		trigger = types.TriggerRoleMention
+	}
+
 	// for non-reaction events send a notification to all users
 	if userPref.WantNotificationForSpaceChannelMessage(
 		spaceID,
 		channelID,
-		mentioned,
+		mentioned || trigger != "",
 		participating,
 		messageInteractionType,
 	) {
-		return true
+		return true, trigger
 	}
 
 	p.log.Debugw("User doesn't want to receive notification for space channel message",
@@ -351,7 +485,28 @@ func (p *MessageToNotificationsProcessor) onSpaceChannelPayload(
 		"mentioned", mentioned,
 		"messageType", messageInteractionType)
 
-	return false
+	return false, ""
+}
+
+// mentionsRole returns true when the participant has one of the mentioned roles in the space.
+// It always returns false when role mentions are not resolved.
+func (p *MessageToNotificationsProcessor) mentionsRole(
+	ctx context.Context,
+	spaceID shared.StreamId,
+	participant common.Address,
+	roleIDs []int64,
+) bool {
+	if p.spaceRoles == nil || len(roleIDs) == 0 {
+		return false
+	}
+
+	hasRole, err := p.spaceRoles.HasAnyRole(ctx, spaceID, roleIDs, participant)
+	if err != nil {
+		p.log.Warnw("Unable to resolve role mention",
+			"space", spaceID, "user", participant, "roles", roleIDs, "error", err)
+		return false
+	}
+	return hasRole
 }
 
 func (p *MessageToNotificationsProcessor) apnPayloadV1(
@@ -450,6 +605,7 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 	event *events.ParsedEvent,
 	kind string,
 	members mapset.Set[string],
+	notificationID common.Hash,
 ) {
 	eventBytes, err := proto.Marshal(event.Event)
 	if err != nil {
@@ -464,12 +620,14 @@ func (p *MessageToNotificationsProcessor) sendNotification(
 
 	// Send Web Push notifications
 	if len(userPref.Subscriptions.WebPush) > 0 {
-		p.sendWebPushNotifications(ctx, user, userPref, spaceID, channelID, event, kind, eventBytes, receivers)
+		p.sendWebPushNotifications(
+			ctx, user, userPref, spaceID, channelID, event, kind, eventBytes, receivers, notificationID)
 	}
 
 	// Send APNS notifications
 	if len(userPref.Subscriptions.APNPush) > 0 {
-		p.sendAPNSNotifications(ctx, user, userPref, spaceID, channelID, event, kind, eventBytes, receivers)
+		p.sendAPNSNotifications(
+			ctx, user, userPref, spaceID, channelID, event, kind, eventBytes, receivers, notificationID)
 	}
 }
 
@@ -483,6 +641,7 @@ func (p *MessageToNotificationsProcessor) sendWebPushNotifications(
 	kind string,
 	eventBytes []byte,
 	receivers []string,
+	notificationID common.Hash,
 ) {
 	eventBytesHex := hex.EncodeToString(eventBytes)
 
@@ -490,6 +649,12 @@ func (p *MessageToNotificationsProcessor) sendWebPushNotifications(
 		"channelId": hex.EncodeToString(channelID[:]),
 		"kind":      kind,
 		"senderId":  common.BytesToAddress(event.Event.CreatorAddress),
+		// tag allows the client to replace the shown notification when the event is edited or redacted
+		"tag": hex.EncodeToString(notificationID[:]),
+	}
+
+	if notificationID != event.Hash {
+		webPayload["replacesEventId"] = hex.EncodeToString(notificationID[:])
 	}
 
 	if len(eventBytesHex) <= MaxWebPushAllowedNotificationStreamEventPayloadSize {
@@ -521,7 +686,7 @@ func (p *MessageToNotificationsProcessor) sendWebPushNotifications(
 			continue
 		}
 
-		subscriptionExpired, err := p.sendWebPushNotification(ctx, channelID, sub, event, webPayload)
+		subscriptionExpired, err := p.sendWebPushNotification(ctx, channelID, sub, notificationID, webPayload)
 		if err == nil {
 			p.log.Debugw("Successfully sent web push notification",
 				"user", user,
@@ -557,6 +722,7 @@ func (p *MessageToNotificationsProcessor) sendAPNSNotifications(
 	kind string,
 	eventBytes []byte,
 	receivers []string,
+	notificationID common.Hash,
 ) {
 	// eventHash is used by iOS/OSX to route the user on the device notification to the message
 	eventHash := hex.EncodeToString(crypto.TownsHashForEvents.Hash(eventBytes).Bytes())
@@ -605,10 +771,15 @@ func (p *MessageToNotificationsProcessor) sendAPNSNotifications(
 			continue
 		}
 
+		if notificationID != event.Hash {
+			apnPayload["replacesEventId"] = hex.EncodeToString(notificationID[:])
+		}
+
 		subscriptionExpired, statusCode, err := p.sendAPNNotification(
 			channelID,
 			sub,
 			event,
+			notificationID,
 			apnPayload,
 			sub.PushVersion,
 		)
@@ -623,6 +794,7 @@ func (p *MessageToNotificationsProcessor) sendAPNSNotifications(
 					channelID,
 					sub,
 					event,
+					notificationID,
 					apnPayload,
 					sub.PushVersion,
 				)
@@ -635,6 +807,7 @@ func (p *MessageToNotificationsProcessor) sendAPNSNotifications(
 							channelID,
 							sub,
 							event,
+							notificationID,
 							apnPayload,
 							sub.PushVersion,
 						)
@@ -677,7 +850,7 @@ func (p *MessageToNotificationsProcessor) sendWebPushNotification(
 	ctx context.Context,
 	streamID shared.StreamId,
 	sub *types.WebPushSubscription,
-	event *events.ParsedEvent,
+	notificationID common.Hash,
 	content map[string]interface{},
 ) (bool, error) {
 	payload, _ := json.Marshal(map[string]interface{}{
@@ -690,13 +863,36 @@ func (p *MessageToNotificationsProcessor) sendWebPushNotification(
 	if sub.App != "" {
 		app = sub.App
 	}
-	return p.notifier.SendWebPushNotification(ctx, sub.Sub, event.Hash, payload, app)
+	return p.notifier.SendWebPushNotification(ctx, sub.Sub, notificationID, payload, webpush.UrgencyHigh, app)
+}
+
+// sendWebPushData sends a data-only web push message. Unlike notifications the content is sent in the
+// data field which service workers process without showing a notification to the user.
+func (p *MessageToNotificationsProcessor) sendWebPushData(
+	ctx context.Context,
+	streamID shared.StreamId,
+	sub *types.WebPushSubscription,
+	notificationID common.Hash,
+	data map[string]interface{},
+) (bool, error) {
+	payload, _ := json.Marshal(map[string]interface{}{
+		"channelId": streamID,
+		"data":      data,
+	})
+
+	// Default to Towns app if not specified
+	app := apps.Default
+	if sub.App != "" {
+		app = sub.App
+	}
+	return p.notifier.SendWebPushNotification(ctx, sub.Sub, notificationID, payload, webpush.UrgencyNormal, app)
 }
 
 func (p *MessageToNotificationsProcessor) sendAPNNotification(
 	streamID shared.StreamId,
 	sub *types.APNPushSubscription,
 	event *events.ParsedEvent,
+	notificationID common.Hash,
 	content map[string]interface{},
 	payloadVersion NotificationPushVersion,
 ) (bool, int, error) {
@@ -705,7 +901,7 @@ func (p *MessageToNotificationsProcessor) sendAPNNotification(
 	defer cancel()
 
 	notificationPayload := payload.NewPayload().
-		AlertTitle("You have a new message").
+		AlertTitle(apnAlertTitle(content["kind"])).
 		Custom("content", content).
 		ThreadID(streamID.String()).
 		ContentAvailable().
@@ -726,5 +922,5 @@ func (p *MessageToNotificationsProcessor) sendAPNNotification(
 		app = sub.App
 	}
 	return p.notifier.SendApplePushNotification(
-		ctx, sub, event.Hash, notificationPayload, containsStreamEvent, app)
+		ctx, sub, notificationID, notificationPayload, containsStreamEvent, apns2.PushTypeAlert, apns2.PriorityHigh, app)
 }
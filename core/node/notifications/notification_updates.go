@@ -0,0 +1,210 @@
+package notifications
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sideshow/apns2"
+	"github.com/sideshow/apns2/payload"
+
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/notifications/apps"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+// recordSentNotifications keeps track of the users that received a notification for the given event.
+// This allows replacing or retracting the notification when the event is edited or redacted.
+func (p *MessageToNotificationsProcessor) recordSentNotifications(
+	ctx context.Context,
+	channelID shared.StreamId,
+	event *events.ParsedEvent,
+	kind string,
+	users map[common.Address]*types.UserPreferences,
+) {
+	if len(users) == 0 {
+		return
+	}
+
+	var (
+		now    = time.Now()
+		sender = common.BytesToAddress(event.Event.CreatorAddress)
+		sent   = make([]*types.SentNotification, 0, len(users))
+	)
+
+	for user := range users {
+		sent = append(sent, &types.SentNotification{
+			EventHash: event.Hash,
+			UserID:    user,
+			ChannelID: channelID,
+			Sender:    sender,
+			Kind:      kind,
+			SentAt:    now,
+		})
+	}
+
+	if err := p.cache.AddSentNotifications(ctx, sent); err != nil {
+		p.log.Errorw("Unable to record sent notifications",
+			"channel", channelID, "event", event.Hash, "error", err)
+	}
+}
+
+// onMessageUpdate replaces (edit) or retracts (redaction) the notifications that were sent for
+// the event the given event refers to. The push is sent with the hash of the original event as
+// collapse id / tag so the device replaces the notification that is already shown. Redactions are
+// sent as silent pushes that let the device remove the notification.
+func (p *MessageToNotificationsProcessor) onMessageUpdate(
+	ctx context.Context,
+	channelID shared.StreamId,
+	spaceID *shared.StreamId,
+	members mapset.Set[string],
+	event *events.ParsedEvent,
+	kind string,
+) {
+	target, ok := referencedEventHash(event)
+	if !ok {
+		p.log.Debugw("Unable to determine referenced event for message update",
+			"channel", channelID, "event", event.Hash, "kind", kind)
+		return
+	}
+
+	sent, err := p.cache.GetSentNotifications(ctx, target)
+	if err != nil {
+		p.log.Errorw("Unable to load sent notifications",
+			"channel", channelID, "event", event.Hash, "target", target, "error", err)
+		return
+	}
+
+	var (
+		sender = common.BytesToAddress(event.Event.CreatorAddress)
+		// redactions through the channel payload are validated by the stream rules and can be
+		// made by space admins, all other updates must come from the creator of the original event.
+		adminRedaction = event.Event.GetChannelPayload().GetRedaction() != nil
+	)
+
+	for _, notification := range sent {
+		if notification.ChannelID != channelID {
+			continue
+		}
+
+		if notification.Sender != sender && !adminRedaction {
+			p.log.Warnw("Ignore message update from other user than original sender",
+				"channel", channelID,
+				"event", event.Hash,
+				"target", target,
+				"sender", sender,
+				"originalSender", notification.Sender,
+			)
+			return
+		}
+
+		pref, err := p.cache.GetUserPreferences(ctx, notification.UserID)
+		if err != nil {
+			p.log.Warnw("Unable to retrieve user preference to update notification",
+				"user", notification.UserID, "event", event.Hash, "error", err)
+			continue
+		}
+
+		if !pref.HasSubscriptions() {
+			continue
+		}
+
+		if kind == "redaction" {
+			p.sendRetraction(ctx, notification.UserID, pref, channelID, target)
+			continue
+		}
+
+		p.sendNotification(ctx, notification.UserID, pref, spaceID, channelID, event, kind, members, target)
+	}
+}
+
+// sendRetraction sends a silent push to the user's devices to remove the notification that was shown
+// for the redacted event. Unlike an edit the push doesn't show a new notification to the user.
+func (p *MessageToNotificationsProcessor) sendRetraction(
+	ctx context.Context,
+	user common.Address,
+	userPref *types.UserPreferences,
+	channelID shared.StreamId,
+	target common.Hash,
+) {
+	content := map[string]interface{}{
+		"channelId":      hex.EncodeToString(channelID[:]),
+		"kind":           "redaction",
+		"tag":            hex.EncodeToString(target[:]),
+		"removesEventId": hex.EncodeToString(target[:]),
+	}
+
+	for _, sub := range userPref.Subscriptions.WebPush {
+		if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
+			continue
+		}
+		if _, err := p.sendWebPushData(ctx, channelID, sub, target, content); err != nil {
+			p.log.Infow("Unable to send web push retraction", "user", user, "error", err)
+		}
+	}
+
+	for _, sub := range userPref.Subscriptions.APNPush {
+		if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
+			continue
+		}
+
+		// background notifications must not contain an alert, sound or badge
+		notificationPayload := payload.NewPayload().
+			Custom("content", content).
+			ContentAvailable()
+
+		app := apps.Default
+		if sub.App != "" {
+			app = sub.App
+		}
+
+		if _, _, err := p.notifier.SendApplePushNotification(
+			ctx, sub, target, notificationPayload, false, apns2.PushTypeBackground, apns2.PriorityLow, app,
+		); err != nil {
+			p.log.Infow("Unable to send APN retraction", "user", user, "error", err)
+		}
+	}
+}
+
+// referencedEventHash returns the hash of the event that the given edit or redaction refers to.
+// Encrypted messages carry the reference in plain text in EncryptedData.ref_event_id.
+func referencedEventHash(event *events.ParsedEvent) (common.Hash, bool) {
+	if redaction := event.Event.GetChannelPayload().GetRedaction(); redaction != nil {
+		if len(redaction.GetEventId()) != common.HashLength {
+			return common.Hash{}, false
+		}
+		return common.BytesToHash(redaction.GetEventId()), true
+	}
+
+	var message *EncryptedData
+	switch payload := event.Event.Payload.(type) {
+	case *StreamEvent_ChannelPayload:
+		message = payload.ChannelPayload.GetMessage()
+	case *StreamEvent_DmChannelPayload:
+		message = payload.DmChannelPayload.GetMessage()
+	case *StreamEvent_GdmChannelPayload:
+		message = payload.GdmChannelPayload.GetMessage()
+	}
+
+	ref, err := hex.DecodeString(strings.TrimPrefix(message.GetRefEventId(), "0x"))
+	if err != nil || len(ref) != common.HashLength {
+		return common.Hash{}, false
+	}
+
+	return common.BytesToHash(ref), true
+}
+
+// apnAlertTitle returns the alert title for an APN notification of the given kind.
+func apnAlertTitle(kind any) string {
+	switch kind {
+	case "edit":
+		return "A message was edited"
+	default:
+		return "You have a new message"
+	}
+}
@@ -0,0 +1,65 @@
+package notifications
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/SherClockHolmes/webpush-go"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sideshow/apns2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestSendRetractionIsSilent(t *testing.T) {
+	var (
+		req       = require.New(t)
+		ctx       = t.Context()
+		notifier  = &pushCapture{}
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		user      = common.Address{1}
+		target    = common.Hash{2}
+		processor = &MessageToNotificationsProcessor{
+			notifier:               notifier,
+			subscriptionExpiration: time.Hour,
+			log:                    logging.FromCtx(ctx),
+		}
+		pref = &types.UserPreferences{
+			UserID: user,
+			Subscriptions: types.Subscriptions{
+				WebPush: []*types.WebPushSubscription{{Sub: &webpush.Subscription{}, LastSeen: time.Now()}},
+				APNPush: []*types.APNPushSubscription{{DeviceToken: []byte{1}, LastSeen: time.Now()}},
+			},
+		}
+	)
+
+	processor.sendRetraction(ctx, user, pref, channelID, target)
+
+	// web push retractions are data-only messages tagged with the redacted event
+	req.Len(notifier.webPayloads, 1)
+	req.Equal(webpush.UrgencyNormal, notifier.webUrgency[0])
+	var webPayload map[string]any
+	req.NoError(json.Unmarshal(notifier.webPayloads[0], &webPayload))
+	req.NotContains(webPayload, "payload")
+	data := webPayload["data"].(map[string]any)
+	req.Equal("redaction", data["kind"])
+	req.Equal(hex.EncodeToString(target[:]), data["tag"])
+
+	// APN retractions are background notifications without alert, sound or badge
+	req.Len(notifier.apnPayloads, 1)
+	req.Equal(apns2.PushTypeBackground, notifier.apnTypes[0])
+	req.Equal(apns2.PriorityLow, notifier.apnPriority[0])
+	apnPayload, err := json.Marshal(notifier.apnPayloads[0])
+	req.NoError(err)
+	var aps struct {
+		Aps map[string]any `json:"aps"`
+	}
+	req.NoError(json.Unmarshal(apnPayload, &aps))
+	req.Equal(map[string]any{"content-available": float64(1)}, aps.Aps)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/pem"
 	"fmt"
@@ -35,10 +36,12 @@ type (
 			ctx context.Context,
 			// subscription object as returned by the browser on enabling subscriptions.
 			subscription *webpush.Subscription,
-			// event hash
+			// event hash, a pending notification for the same event hash is replaced
 			eventHash common.Hash,
 			// payload of the message
 			payload []byte,
+			// urgency of the message, data-only messages that aren't shown to the user use a normal urgency
+			urgency webpush.Urgency,
 			app string,
 		) (expired bool, err error)
 
@@ -47,12 +50,16 @@ type (
 			ctx context.Context,
 			// sub APN
 			sub *types.APNPushSubscription,
-			// event hash
+			// event hash, used as collapse id to replace earlier notifications for the same event
 			eventHash common.Hash,
 			// payload is sent to the APP
 			payload *payload2.Payload,
 			// payloadIncludesStreamEvent is true if the payload includes the stream event
 			payloadIncludesStreamEvent bool,
+			// pushType and priority of the notification, silent notifications must use
+			// apns2.PushTypeBackground with apns2.PriorityLow
+			pushType apns2.EPushType,
+			priority int,
 			app string,
 		) (bool, int, error)
 	}
@@ -303,6 +310,7 @@ func (n *MessageNotifications) SendWebPushNotification(
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	payload []byte,
+	urgency webpush.Urgency,
 	app string,
 ) (expired bool, err error) {
 	appConfig, ok := n.appConfigs[app]
@@ -320,8 +328,9 @@ func (n *MessageNotifications) SendWebPushNotification(
 
 	options := &webpush.Options{
 		Subscriber:      appConfig.WebPush.VAPIDSubject,
+		Topic:           webPushTopic(eventHash),
 		TTL:             30,
-		Urgency:         webpush.UrgencyHigh,
+		Urgency:         urgency,
 		VAPIDPublicKey:  appConfig.WebPush.VAPIDPublicKey,
 		VAPIDPrivateKey: appConfig.WebPush.VAPIDPrivateKey,
 	}
@@ -362,12 +371,20 @@ func (n *MessageNotifications) SendWebPushNotification(
 	return subExpired, riverErr
 }
 
+// webPushTopic returns the web push topic for the given event. The push service replaces pending
+// messages with the same topic. Topics are limited to 32 characters of the URL-safe base64 alphabet.
+func webPushTopic(eventHash common.Hash) string {
+	return base64.RawURLEncoding.EncodeToString(eventHash[:24])
+}
+
 func (n *MessageNotifications) SendApplePushNotification(
 	ctx context.Context,
 	sub *types.APNPushSubscription,
 	eventHash common.Hash,
 	payload *payload2.Payload,
 	payloadIncludesStreamEvent bool,
+	pushType apns2.EPushType,
+	priority int,
 	app string,
 ) (bool, int, error) {
 	appConfig, ok := n.appConfigs[app]
@@ -399,8 +416,9 @@ func (n *MessageNotifications) SendApplePushNotification(
 		DeviceToken: hex.EncodeToString(sub.DeviceToken),
 		Topic:       appConfig.APNS.AppBundleID,
 		Payload:     payload,
-		Priority:    apns2.PriorityHigh,
-		PushType:    apns2.PushTypeAlert,
+		CollapseID:  hex.EncodeToString(eventHash[:]),
+		Priority:    priority,
+		PushType:    pushType,
 		Expiration:  time.Now().Add(appConfig.APNS.Expiration),
 	}
 
@@ -461,6 +479,7 @@ func (n *MessageNotificationsSimulator) SendWebPushNotification(
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	payload []byte,
+	urgency webpush.Urgency,
 	app string,
 ) (bool, error) {
 	log := logging.FromCtx(ctx)
@@ -468,6 +487,7 @@ func (n *MessageNotificationsSimulator) SendWebPushNotification(
 		"keys.p256dh", subscription.Keys.P256dh,
 		"keys.auth", subscription.Keys.Auth,
 		"payload", payload,
+		"urgency", urgency,
 		"app", app)
 
 	n.WebPushNotificationsByEndpoint[subscription.Endpoint] = append(
@@ -487,6 +507,8 @@ func (n *MessageNotificationsSimulator) SendApplePushNotification(
 	eventHash common.Hash,
 	payload *payload2.Payload,
 	payloadIncludesStreamEvent bool,
+	pushType apns2.EPushType,
+	priority int,
 	app string,
 ) (bool, int, error) {
 	log := logging.FromCtx(ctx)
@@ -497,6 +519,8 @@ func (n *MessageNotificationsSimulator) SendApplePushNotification(
 		"payload", payload,
 		"payloadStripped", payloadIncludesStreamEvent,
 		"payloadVersion", fmt.Sprintf("%d", sub.PushVersion),
+		"pushType", pushType,
+		"priority", priority,
 		"app", app,
 	)
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/SherClockHolmes/webpush-go"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/sideshow/apns2"
 	payload2 "github.com/sideshow/apns2/payload"
 	"github.com/stretchr/testify/require"
 
@@ -73,7 +74,7 @@ func TestAPNSPushNotification(t *testing.T) {
 	}
 
 	expired, _, err := notifier.SendApplePushNotification(
-		ctx, &sub, common.Hash{1}, payload, true, apps.Towns)
+		ctx, &sub, common.Hash{1}, payload, true, apns2.PushTypeAlert, apns2.PriorityHigh, apps.Towns)
 	req.False(expired, "subscription should not be expired")
 	req.NoError(err, "send APN notification")
 }
@@ -144,6 +145,7 @@ func TestWebPushWithVapid(t *testing.T) {
 		subscription,
 		common.Hash{1},
 		payload,
+		webpush.UrgencyHigh,
 		apps.Towns,
 	)
 	req.False(expired, "expired")
@@ -0,0 +1,314 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/sideshow/apns2"
+	"github.com/sideshow/apns2/payload"
+
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/notifications/apps"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+// readStateRetention is how long read markers and notified channels are remembered. Read markers
+// only suppress notifications for events that were created before the marker was set, which
+// are events that are delayed or processed late.
+const readStateRetention = time.Hour
+
+type (
+	// readMarkerKey identifies a channel or a thread in a channel.
+	readMarkerKey struct {
+		channelID shared.StreamId
+		// threadID is the hex encoded thread parent event id, empty for the channel itself
+		threadID string
+	}
+
+	// userReadState keeps the read markers a user has set on one of their devices and the
+	// channels for which the user received a notification that hasn't been read yet.
+	userReadState struct {
+		// readAt keeps the time the channel/thread was fully read
+		readAt map[readMarkerKey]time.Time
+		// notified keeps the channels/threads for which the user received a notification
+		notified map[readMarkerKey]time.Time
+	}
+
+	// readStateTracker keeps track of the read state for users with a notification subscription.
+	readStateTracker struct {
+		mu        sync.Mutex
+		users     map[common.Address]*userReadState
+		lastPrune time.Time
+	}
+
+	// fullyReadMarkerContent is the JSON encoded FullyReadMarkers.Content the client stores in
+	// the user settings stream.
+	fullyReadMarkerContent struct {
+		ChannelID      string         `json:"channelId"`
+		ThreadParentID string         `json:"threadParentId"`
+		IsUnread       bool           `json:"isUnread"`
+		MarkedReadAtTs protoJSONInt64 `json:"markedReadAtTs"`
+	}
+
+	// protoJSONInt64 decodes an int64 that protobuf JSON encodes either as number or string.
+	protoJSONInt64 int64
+)
+
+func (i *protoJSONInt64) UnmarshalJSON(data []byte) error {
+	v, err := strconv.ParseInt(string(bytes.Trim(data, `"`)), 10, 64)
+	if err != nil {
+		return err
+	}
+	*i = protoJSONInt64(v)
+	return nil
+}
+
+func newReadStateTracker() *readStateTracker {
+	return &readStateTracker{
+		users:     make(map[common.Address]*userReadState),
+		lastPrune: time.Now(),
+	}
+}
+
+func (t *readStateTracker) userStateLocked(user common.Address) *userReadState {
+	state, ok := t.users[user]
+	if !ok {
+		state = &userReadState{
+			readAt:   make(map[readMarkerKey]time.Time),
+			notified: make(map[readMarkerKey]time.Time),
+		}
+		t.users[user] = state
+	}
+	return state
+}
+
+// markRead records that the user has read the given channel/thread up to readAt. It returns if the
+// user received a notification for the channel/thread that is now read and if there are other
+// channels/threads left for which the user received a notification that isn't read.
+func (t *readStateTracker) markRead(
+	user common.Address,
+	key readMarkerKey,
+	readAt time.Time,
+) (wasNotified bool, othersUnread bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pruneLocked()
+
+	state := t.userStateLocked(user)
+	if prev, ok := state.readAt[key]; ok && prev.After(readAt) {
+		return false, len(state.notified) > 0
+	}
+	state.readAt[key] = readAt
+
+	if notifiedAt, ok := state.notified[key]; ok && !notifiedAt.After(readAt) {
+		delete(state.notified, key)
+		wasNotified = true
+	}
+
+	return wasNotified, len(state.notified) > 0
+}
+
+// markNotified records that the user received a notification for the given channel/thread.
+func (t *readStateTracker) markNotified(user common.Address, key readMarkerKey, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.pruneLocked()
+	t.userStateLocked(user).notified[key] = at
+}
+
+// isRead returns true when the user has marked the channel/thread as read at or after createdAt.
+func (t *readStateTracker) isRead(user common.Address, key readMarkerKey, createdAt time.Time) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state, ok := t.users[user]
+	if !ok {
+		return false
+	}
+
+	readAt, ok := state.readAt[key]
+	return ok && !readAt.Before(createdAt)
+}
+
+// pruneLocked drops read state that is older than readStateRetention. Pruning happens at most
+// once per retention period.
+func (t *readStateTracker) pruneLocked() {
+	now := time.Now()
+	if now.Sub(t.lastPrune) < readStateRetention {
+		return
+	}
+	t.lastPrune = now
+
+	cutoff := now.Add(-readStateRetention)
+	for user, state := range t.users {
+		for key, readAt := range state.readAt {
+			if readAt.Before(cutoff) {
+				delete(state.readAt, key)
+			}
+		}
+		for key, notifiedAt := range state.notified {
+			if notifiedAt.Before(cutoff) {
+				delete(state.notified, key)
+			}
+		}
+		if len(state.readAt) == 0 && len(state.notified) == 0 {
+			delete(t.users, user)
+		}
+	}
+}
+
+// readMarkerKeyForEvent returns the channel/thread key for the given message event.
+func readMarkerKeyForEvent(channelID shared.StreamId, event *events.ParsedEvent) readMarkerKey {
+	key := readMarkerKey{channelID: channelID}
+	if threadID := event.Event.GetTags().GetThreadId(); len(threadID) > 0 {
+		key.threadID = hex.EncodeToString(threadID)
+	}
+	return key
+}
+
+// parseFullyReadMarkers decodes the read markers from a user settings fully read markers event.
+// Markers that are still unread are skipped.
+func parseFullyReadMarkers(
+	markers *UserSettingsPayload_FullyReadMarkers,
+	eventCreatedAt time.Time,
+) (map[readMarkerKey]time.Time, error) {
+	channelID, err := shared.StreamIdFromBytes(markers.GetStreamId())
+	if err != nil {
+		return nil, err
+	}
+
+	var content struct {
+		Markers map[string]fullyReadMarkerContent `json:"markers"`
+	}
+	if err := json.Unmarshal([]byte(markers.GetContent().GetData()), &content); err != nil {
+		return nil, err
+	}
+
+	read := make(map[readMarkerKey]time.Time)
+	for _, marker := range content.Markers {
+		if marker.IsUnread {
+			continue
+		}
+
+		key := readMarkerKey{
+			channelID: channelID,
+			threadID:  strings.ToLower(strings.TrimPrefix(marker.ThreadParentID, "0x")),
+		}
+
+		readAt := eventCreatedAt
+		if marker.MarkedReadAtTs > 0 {
+			readAt = time.UnixMilli(int64(marker.MarkedReadAtTs))
+		}
+		read[key] = readAt
+	}
+
+	return read, nil
+}
+
+// onFullyReadMarkers keeps track of the read markers a user with a notification subscription sets on
+// one of their devices. When the user received a notification for a channel that is now read a silent
+// push is sent to let the other devices clear the notification and badge.
+func (p *MessageToNotificationsProcessor) onFullyReadMarkers(
+	ctx context.Context,
+	userSettingsStreamID shared.StreamId,
+	event *events.ParsedEvent,
+	markers *UserSettingsPayload_FullyReadMarkers,
+) {
+	user, err := shared.GetUserAddressFromStreamId(userSettingsStreamID)
+	if err != nil {
+		p.log.Errorw("Unable to determine user from settings stream", "stream", userSettingsStreamID, "error", err)
+		return
+	}
+
+	pref, err := p.cache.GetUserPreferences(ctx, user)
+	if err != nil {
+		p.log.Warnw("Unable to retrieve user preferences to process read markers", "user", user, "error", err)
+		return
+	}
+
+	if !pref.HasSubscriptions() {
+		return
+	}
+
+	read, err := parseFullyReadMarkers(markers, time.UnixMilli(event.Event.GetCreatedAtEpochMs()))
+	if err != nil {
+		p.log.Debugw("Unable to parse fully read markers", "user", user, "event", event.Hash, "error", err)
+		return
+	}
+
+	for key, readAt := range read {
+		wasNotified, othersUnread := p.readState.markRead(user, key, readAt)
+		if wasNotified {
+			p.sendReadNotification(ctx, user, pref, key, !othersUnread)
+		}
+	}
+}
+
+// sendReadNotification sends a silent push to the user's devices to let them know the given
+// channel/thread was read on another device. When clearBadge is set the user has no notifications
+// left that aren't read.
+func (p *MessageToNotificationsProcessor) sendReadNotification(
+	ctx context.Context,
+	user common.Address,
+	userPref *types.UserPreferences,
+	key readMarkerKey,
+	clearBadge bool,
+) {
+	// use a dedicated id to prevent replacing a notification for one of the channel messages
+	notificationID := ethcrypto.Keccak256Hash([]byte("read"), key.channelID[:], []byte(key.threadID))
+
+	content := map[string]interface{}{
+		"channelId": hex.EncodeToString(key.channelID[:]),
+		"kind":      "read",
+	}
+	if key.threadID != "" {
+		content["threadId"] = key.threadID
+	}
+	if clearBadge {
+		content["badge"] = 0
+	}
+
+	for _, sub := range userPref.Subscriptions.WebPush {
+		if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
+			continue
+		}
+		if _, err := p.sendWebPushData(ctx, key.channelID, sub, notificationID, content); err != nil {
+			p.log.Infow("Unable to send web push read notification", "user", user, "error", err)
+		}
+	}
+
+	for _, sub := range userPref.Subscriptions.APNPush {
+		if time.Since(sub.LastSeen) >= p.subscriptionExpiration {
+			continue
+		}
+
+		// background notifications must not contain an alert, sound or badge, clients clear the badge
+		// based on the badge field in the content
+		notificationPayload := payload.NewPayload().
+			Custom("content", content).
+			ContentAvailable()
+
+		app := apps.Default
+		if sub.App != "" {
+			app = sub.App
+		}
+
+		if _, _, err := p.notifier.SendApplePushNotification(
+			ctx, sub, notificationID, notificationPayload, false, apns2.PushTypeBackground, apns2.PriorityLow, app,
+		); err != nil {
+			p.log.Infow("Unable to send APN read notification", "user", user, "error", err)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package notifications
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/SherClockHolmes/webpush-go"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/sideshow/apns2"
+	"github.com/sideshow/apns2/payload"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/notifications/types"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestParseFullyReadMarkers(t *testing.T) {
+	var (
+		req       = require.New(t)
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		createdAt = time.UnixMilli(1_700_000_500_000)
+	)
+
+	// markedReadAtTs is encoded as string by protobuf JSON but older clients encode it as number
+	read, err := parseFullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{
+		StreamId: channelID[:],
+		Content: &UserSettingsPayload_MarkerContent{Data: `{"markers":{
+			"` + channelID.String() + `":{"channelId":"` + channelID.String() + `","isUnread":false,"markedReadAtTs":"1700000000000"},
+			"0xABCD":{"channelId":"` + channelID.String() + `","threadParentId":"0xABCD","isUnread":false,"markedReadAtTs":1700000100000},
+			"ef01":{"channelId":"` + channelID.String() + `","threadParentId":"ef01","isUnread":true,"markedReadAtTs":"1700000200000"},
+			"1234":{"channelId":"` + channelID.String() + `","threadParentId":"1234"}
+		}}`},
+	}, createdAt)
+	req.NoError(err)
+	req.Equal(map[readMarkerKey]time.Time{
+		{channelID: channelID}:                   time.UnixMilli(1_700_000_000_000),
+		{channelID: channelID, threadID: "abcd"}: time.UnixMilli(1_700_000_100_000),
+		{channelID: channelID, threadID: "1234"}: createdAt,
+	}, read)
+
+	_, err = parseFullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{
+		StreamId: channelID[:],
+		Content:  &UserSettingsPayload_MarkerContent{Data: "not json"},
+	}, createdAt)
+	req.Error(err)
+}
+
+func TestReadStateTracker(t *testing.T) {
+	var (
+		req     = require.New(t)
+		tracker = newReadStateTracker()
+		user    = common.Address{1}
+		channel = readMarkerKey{channelID: testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)}
+		thread  = readMarkerKey{channelID: channel.channelID, threadID: "abcd"}
+		now     = time.Now()
+	)
+
+	req.False(tracker.isRead(user, channel, now))
+
+	// reading a channel without a notification doesn't require clearing notifications
+	wasNotified, othersUnread := tracker.markRead(user, channel, now)
+	req.False(wasNotified)
+	req.False(othersUnread)
+	req.True(tracker.isRead(user, channel, now.Add(-time.Second)))
+	req.True(tracker.isRead(user, channel, now))
+	req.False(tracker.isRead(user, channel, now.Add(time.Second)))
+	req.False(tracker.isRead(user, thread, now))
+	req.False(tracker.isRead(common.Address{2}, channel, now))
+
+	// an older read marker doesn't undo a newer one
+	tracker.markRead(user, channel, now.Add(-time.Minute))
+	req.True(tracker.isRead(user, channel, now))
+
+	tracker.markNotified(user, channel, now.Add(time.Second))
+	tracker.markNotified(user, thread, now.Add(time.Second))
+
+	wasNotified, othersUnread = tracker.markRead(user, channel, now.Add(2*time.Second))
+	req.True(wasNotified)
+	req.True(othersUnread)
+
+	wasNotified, othersUnread = tracker.markRead(user, thread, now.Add(2*time.Second))
+	req.True(wasNotified)
+	req.False(othersUnread)
+
+	// a notification that was sent after the channel was read is still unread
+	tracker.markNotified(user, channel, now.Add(3*time.Second))
+	wasNotified, othersUnread = tracker.markRead(user, channel, now.Add(2*time.Second))
+	req.False(wasNotified)
+	req.True(othersUnread)
+}
+
+func TestIsLowPriorityNotification(t *testing.T) {
+	for kind, expected := range map[string]bool{
+		"new_message":    true,
+		"reaction":       true,
+		"direct_message": false,
+		"mention":        false,
+		"@channel":       false,
+		"reply_to":       false,
+		"tip":            false,
+		"trade":          false,
+	} {
+		require.Equal(t, expected, isLowPriorityNotification(kind), kind)
+	}
+}
+
+// pushCapture implements push.MessageNotifier and records the sent notifications.
+type pushCapture struct {
+	webPayloads [][]byte
+	webUrgency  []webpush.Urgency
+	apnPayloads []*payload.Payload
+	apnTypes    []apns2.EPushType
+	apnPriority []int
+}
+
+func (c *pushCapture) SendWebPushNotification(
+	_ context.Context,
+	_ *webpush.Subscription,
+	_ common.Hash,
+	payload []byte,
+	urgency webpush.Urgency,
+	_ string,
+) (bool, error) {
+	c.webPayloads = append(c.webPayloads, payload)
+	c.webUrgency = append(c.webUrgency, urgency)
+	return false, nil
+}
+
+func (c *pushCapture) SendApplePushNotification(
+	_ context.Context,
+	_ *types.APNPushSubscription,
+	_ common.Hash,
+	payload *payload.Payload,
+	_ bool,
+	pushType apns2.EPushType,
+	priority int,
+	_ string,
+) (bool, int, error) {
+	c.apnPayloads = append(c.apnPayloads, payload)
+	c.apnTypes = append(c.apnTypes, pushType)
+	c.apnPriority = append(c.apnPriority, priority)
+	return false, http.StatusOK, nil
+}
+
+func TestSendReadNotificationIsSilent(t *testing.T) {
+	var (
+		req       = require.New(t)
+		ctx       = t.Context()
+		notifier  = &pushCapture{}
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		user      = common.Address{1}
+		processor = &MessageToNotificationsProcessor{
+			notifier:               notifier,
+			subscriptionExpiration: time.Hour,
+			log:                    logging.FromCtx(ctx),
+		}
+		pref = &types.UserPreferences{
+			UserID: user,
+			Subscriptions: types.Subscriptions{
+				WebPush: []*types.WebPushSubscription{{Sub: &webpush.Subscription{}, LastSeen: time.Now()}},
+				APNPush: []*types.APNPushSubscription{{DeviceToken: []byte{1}, LastSeen: time.Now()}},
+			},
+		}
+	)
+
+	processor.sendReadNotification(ctx, user, pref, readMarkerKey{channelID: channelID}, true)
+
+	// web push read updates are data-only messages
+	req.Len(notifier.webPayloads, 1)
+	req.Equal(webpush.UrgencyNormal, notifier.webUrgency[0])
+	var webPayload map[string]any
+	req.NoError(json.Unmarshal(notifier.webPayloads[0], &webPayload))
+	req.NotContains(webPayload, "payload")
+	req.Equal("read", webPayload["data"].(map[string]any)["kind"])
+
+	// APN read updates are background notifications without alert, sound or badge
+	req.Len(notifier.apnPayloads, 1)
+	req.Equal(apns2.PushTypeBackground, notifier.apnTypes[0])
+	req.Equal(apns2.PriorityLow, notifier.apnPriority[0])
+	apnPayload, err := json.Marshal(notifier.apnPayloads[0])
+	req.NoError(err)
+	var aps struct {
+		Aps map[string]any `json:"aps"`
+	}
+	req.NoError(json.Unmarshal(apnPayload, &aps))
+	req.Equal(map[string]any{"content-available": float64(1)}, aps.Aps)
+}
+
+type fakeStreamOwner bool
+
+func (o fakeStreamOwner) Owns(shared.StreamId) bool {
+	return bool(o)
+}
+
+func TestDelayedNotificationDroppedForStreamNotOwned(t *testing.T) {
+	var (
+		ctx       = t.Context()
+		notifier  = &pushCapture{}
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		user      = common.Address{1}
+		processor = &MessageToNotificationsProcessor{
+			ctx:                    ctx,
+			notifier:               notifier,
+			subscriptionExpiration: time.Hour,
+			log:                    logging.FromCtx(ctx),
+			readState:              newReadStateTracker(),
+			streamOwner:            fakeStreamOwner(false),
+		}
+		pref = &types.UserPreferences{
+			UserID: user,
+			Subscriptions: types.Subscriptions{
+				WebPush: []*types.WebPushSubscription{{Sub: &webpush.Subscription{}, LastSeen: time.Now()}},
+			},
+		}
+		event = &events.ParsedEvent{Event: &StreamEvent{}, Hash: common.Hash{2}}
+	)
+
+	processor.deliverDelayedNotifications(
+		nil, channelID, event, "new_message", nil, map[common.Address]*types.UserPreferences{user: pref})
+	require.Empty(t, notifier.webPayloads)
+}
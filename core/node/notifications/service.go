@@ -27,6 +27,12 @@ import (
 
 const (
 	notificationServiceChallengePrefix = "NS_AUTH:"
+
+	// defaultNotificationHistoryPageSize is the number of notifications returned by GetNotificationHistory
+	// when the client didn't specify a page size.
+	defaultNotificationHistoryPageSize = 50
+	// maxNotificationHistoryPageSize is the maximum number of notifications returned by GetNotificationHistory.
+	maxNotificationHistoryPageSize = 200
 )
 
 type (
@@ -43,6 +49,9 @@ type (
 	}
 )
 
+// NewService creates the notification service. When streamLeases is not nil the service only tracks the
+// streams in the partitions it holds a lease for and persists sync cookies in cookieStore to hand off
+// streams to other instances.
 func NewService(
 	ctx context.Context,
 	notificationsConfig config.NotificationsConfig,
@@ -53,6 +62,11 @@ func NewService(
 	metrics infra.MetricsFactory,
 	listener track_streams.StreamEventListener,
 	otelTracer trace.Tracer,
+	streamLeases *notificationssync.StreamLeases,
+	cookieStore track_streams.SyncCookieStore,
+	challengeStore authentication.ChallengeStore,
+	sessionStore authentication.SessionStore,
+	smartAccounts *crypto.SmartAccountVerifier,
 ) (*Service, error) {
 	tracker, err := notificationssync.NewNotificationsStreamsTracker(
 		ctx,
@@ -65,6 +79,8 @@ func NewService(
 		notificationsConfig.StreamTracking,
 		notificationsConfig,
 		otelTracer,
+		streamLeases,
+		cookieStore,
 	)
 	if err != nil {
 		return nil, err
@@ -83,6 +99,9 @@ func NewService(
 	if err := service.AuthServiceMixin.InitAuthentication(
 		notificationServiceChallengePrefix,
 		&notificationsConfig.Authentication,
+		challengeStore,
+		sessionStore,
+		smartAccounts,
 	); err != nil {
 		return nil, err
 	}
@@ -109,6 +128,37 @@ func (s *Service) Start(ctx context.Context) {
 			}
 		}
 	}()
+
+	go s.pruneSentNotifications(ctx)
+}
+
+// pruneSentNotifications periodically deletes sent notification records that are older than
+// the configured retention period and trims the notification history of each user.
+func (s *Service) pruneSentNotifications(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+	retention := s.notificationsConfig.GetSentNotificationsRetention()
+	historySize := s.notificationsConfig.GetNotificationHistorySize()
+
+	for {
+		select {
+		case <-time.After(time.Hour):
+			deleted, err := s.userPreferences.DeleteSentNotificationsBefore(ctx, time.Now().Add(-retention))
+			if err != nil {
+				log.Errorw("Unable to prune sent notifications", "error", err)
+			} else {
+				log.Debugw("Pruned sent notifications", "deleted", deleted)
+			}
+
+			trimmed, err := s.userPreferences.TrimNotificationHistory(ctx, historySize)
+			if err != nil {
+				log.Errorw("Unable to trim notification history", "error", err)
+			} else {
+				log.Debugw("Trimmed notification history", "deleted", trimmed)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
 }
 
 // GetSettings returns user stored notification userPreferencesCache.
@@ -441,3 +491,89 @@ func (s *Service) UnsubscribeAPN(
 
 	return connect.NewResponse(&UnsubscribeAPNResponse{}), nil
 }
+
+// GetNotificationHistory returns a page of the notifications that were sent to the user, most recent first.
+func (s *Service) GetNotificationHistory(
+	ctx context.Context,
+	req *connect.Request[GetNotificationHistoryRequest],
+) (*connect.Response[GetNotificationHistoryResponse], error) {
+	var (
+		msg    = req.Msg
+		userID = authentication.UserFromAuthenticatedContext(ctx)
+		limit  = int(msg.GetPageSize())
+	)
+
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+	if limit < 0 || msg.GetBeforeId() < 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid pagination parameters").
+			Tags("pageSize", msg.GetPageSize(), "beforeId", msg.GetBeforeId())
+	}
+	if limit == 0 {
+		limit = defaultNotificationHistoryPageSize
+	}
+	limit = min(limit, maxNotificationHistoryPageSize)
+
+	// fetch one more than requested to determine if there is a next page
+	history, err := s.userPreferences.GetNotificationHistory(
+		ctx, userID, msg.GetBeforeId(), limit+1, msg.GetUnseenOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	unseen, err := s.userPreferences.CountUnseenNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &GetNotificationHistoryResponse{UnseenCount: unseen}
+	if len(history) > limit {
+		history = history[:limit]
+		resp.NextBeforeId = history[limit-1].ID
+	}
+
+	for _, n := range history {
+		resp.Notifications = append(resp.Notifications, &NotificationHistoryItem{
+			Id:            n.ID,
+			Kind:          n.Kind,
+			ChannelId:     n.ChannelID[:],
+			EventHash:     n.EventHash[:],
+			SenderId:      n.Sender[:],
+			SentAtEpochMs: n.SentAt.UnixMilli(),
+			Seen:          n.Seen,
+		})
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// MarkNotificationsSeen marks notifications in the user's notification history as seen.
+func (s *Service) MarkNotificationsSeen(
+	ctx context.Context,
+	req *connect.Request[MarkNotificationsSeenRequest],
+) (*connect.Response[MarkNotificationsSeenResponse], error) {
+	var (
+		msg    = req.Msg
+		userID = authentication.UserFromAuthenticatedContext(ctx)
+	)
+
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+	if len(msg.GetIds()) > maxNotificationHistoryPageSize {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Too many notification ids").
+			Tag("count", len(msg.GetIds()))
+	}
+
+	if err := s.userPreferences.MarkNotificationsSeen(ctx, userID, msg.GetIds(), msg.GetUpToId()); err != nil {
+		return nil, err
+	}
+
+	unseen, err := s.userPreferences.CountUnseenNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&MarkNotificationsSeenResponse{UnseenCount: unseen}), nil
+}
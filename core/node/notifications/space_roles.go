@@ -0,0 +1,109 @@
+package notifications
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/arc/v2"
+
+	"github.com/towns-protocol/towns/core/contracts/types"
+	"github.com/towns-protocol/towns/core/node/auth"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+const (
+	// spaceRolesCacheTTL determines how long space roles are cached before they are reloaded from the space contract.
+	spaceRolesCacheTTL = 5 * time.Minute
+	// spaceRolesCacheSize is the maximum number of spaces for which roles are cached.
+	spaceRolesCacheSize = 10_000
+)
+
+// everyone is the address that is used in user entitlements to grant a role to all users.
+var everyone = common.HexToAddress("0x1")
+
+// SpaceRoles resolves role mentions in space channel messages to users.
+type SpaceRoles interface {
+	// HasAnyRole returns true when the given user has at least one of the given roles in the space.
+	HasAnyRole(ctx context.Context, spaceID shared.StreamId, roleIDs []int64, user common.Address) (bool, error)
+}
+
+type (
+	// spaceContractRoles resolves roles through the space contract. Only user entitlements are evaluated,
+	// roles that are granted through rule entitlements require cross chain checks which are too expensive
+	// to run for each member of a channel.
+	spaceContractRoles struct {
+		spaceContract auth.SpaceContract
+		cache         *lru.ARCCache[shared.StreamId, *cachedSpaceRoles]
+	}
+
+	cachedSpaceRoles struct {
+		roles     []types.BaseRole
+		expiresAt time.Time
+	}
+)
+
+var _ SpaceRoles = (*spaceContractRoles)(nil)
+
+// NewSpaceContractRoles returns a SpaceRoles implementation that loads space roles from the given space contract.
+func NewSpaceContractRoles(spaceContract auth.SpaceContract) (SpaceRoles, error) {
+	cache, err := lru.NewARC[shared.StreamId, *cachedSpaceRoles](spaceRolesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spaceContractRoles{
+		spaceContract: spaceContract,
+		cache:         cache,
+	}, nil
+}
+
+func (s *spaceContractRoles) HasAnyRole(
+	ctx context.Context,
+	spaceID shared.StreamId,
+	roleIDs []int64,
+	user common.Address,
+) (bool, error) {
+	if len(roleIDs) == 0 {
+		return false, nil
+	}
+
+	roles, err := s.roles(ctx, spaceID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, role := range roles {
+		if role.Disabled || role.Id == nil || !role.Id.IsInt64() || !slices.Contains(roleIDs, role.Id.Int64()) {
+			continue
+		}
+		for _, entitlement := range role.Entitlements {
+			if entitlement.EntitlementType != types.ModuleTypeUserEntitlement {
+				continue
+			}
+			if slices.ContainsFunc(entitlement.UserEntitlement, func(addr common.Address) bool {
+				return addr == user || addr == everyone
+			}) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func (s *spaceContractRoles) roles(ctx context.Context, spaceID shared.StreamId) ([]types.BaseRole, error) {
+	if cached, ok := s.cache.Get(spaceID); ok && time.Now().Before(cached.expiresAt) {
+		return cached.roles, nil
+	}
+
+	roles, err := s.spaceContract.GetRoles(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Add(spaceID, &cachedSpaceRoles{roles: roles, expiresAt: time.Now().Add(spaceRolesCacheTTL)})
+
+	return roles, nil
+}
@@ -63,6 +63,10 @@ type NotificationStreamView struct {
 	lastBlockNum int64                    // Last processed block number
 	seenEvents   map[common.Hash]struct{} // Event cache, pruned when blocks are applied
 	// NOTE: No mutex needed - accessed from single goroutine like TrackedStreamViewImpl
+
+	// persistCookies is set when streams are partitioned over instances, the persisted cookie
+	// allows the instance that takes over the stream to resume where this instance stopped.
+	persistCookies bool
 }
 
 // NewNotificationStreamView creates a lightweight stream view optimized for notifications.
@@ -378,6 +382,12 @@ func (v *NotificationStreamView) SendEventNotification(ctx context.Context, even
 					v.preferences.UnblockUser(userID, blockedUser)
 				}
 			}
+
+			// read markers allow the listener to suppress notifications for messages
+			// the user has already read on one of their devices
+			if settings.GetFullyReadMarkers() != nil {
+				v.listener.OnMessageEvent(ctx, v.streamID, nil, mapset.NewSet[string](), event)
+			}
 		}
 		return nil
 	}
@@ -393,7 +403,8 @@ func (v *NotificationStreamView) SendEventNotification(ctx context.Context, even
 	return nil
 }
 
-// ShouldPersistCookie returns false for notifications - they don't need cookie persistence.
+// ShouldPersistCookie returns true when streams are partitioned over notification service instances.
+// A single instance always tracks streams from the latest position and doesn't need cookies.
 func (v *NotificationStreamView) ShouldPersistCookie(ctx context.Context) bool {
-	return false
+	return v.persistCookies
 }
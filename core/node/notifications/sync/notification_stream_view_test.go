@@ -197,3 +197,47 @@ func TestNotificationStreamView_BlockedUsers(t *testing.T) {
 	// Verify user was unblocked
 	require.False(t, prefs.IsBlocked(user, blockedUser))
 }
+
+// TestNotificationStreamView_FullyReadMarkers verifies that read markers are forwarded to the listener
+// and other user settings events are not
+func TestNotificationStreamView_FullyReadMarkers(t *testing.T) {
+	ctx := context.Background()
+	user := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+
+	streamID := shared.StreamId{0xa5}
+	copy(streamID[1:21], user.Bytes())
+
+	snapshotBytes, err := proto.Marshal(&Snapshot{
+		Content: &Snapshot_UserSettingsContent{
+			UserSettingsContent: &UserSettingsPayload_Snapshot{},
+		},
+	})
+	require.NoError(t, err)
+
+	listener := newMockListener()
+	view, err := NewNotificationStreamView(
+		ctx, streamID, nil, &StreamAndCookie{Snapshot: &Envelope{Event: snapshotBytes}}, listener,
+		newMockUserPreferences())
+	require.NoError(t, err)
+
+	block := &ParsedEvent{Event: &StreamEvent{
+		CreatorAddress: user.Bytes(),
+		Payload: Make_UserSettingsPayload_UserBlock(&UserSettingsPayload_UserBlock{
+			UserId:    common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb").Bytes(),
+			IsBlocked: true,
+		}),
+	}}
+	require.NoError(t, view.SendEventNotification(ctx, block))
+	require.Empty(t, listener.messageEvents)
+
+	markers := &ParsedEvent{Event: &StreamEvent{
+		CreatorAddress: user.Bytes(),
+		Payload: Make_UserSettingsPayload_FullyReadMarkers(&UserSettingsPayload_FullyReadMarkers{
+			StreamId: shared.StreamId{0x20}.Bytes(),
+			Content:  &UserSettingsPayload_MarkerContent{Data: "{}"},
+		}),
+	}}
+	require.NoError(t, view.SendEventNotification(ctx, markers))
+	require.Len(t, listener.messageEvents, 1)
+	require.Same(t, markers, listener.messageEvents[0])
+}
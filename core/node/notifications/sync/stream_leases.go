@@ -0,0 +1,229 @@
+package sync
+
+import (
+	"context"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// StreamLeases partitions streams over notification service instances. Streams are hashed into a fixed
+// number of partitions and each instance holds leases on a share of these partitions. An instance only
+// tracks and sends notifications for streams in partitions it holds a lease for.
+//
+// Each heartbeat the instance renews its leases and rebalances partitions over the live instances. When an
+// instance joins, instances that hold more than their share release partitions which the new instance picks
+// up. When an instance leaves, or stops renewing its leases, other instances take over its partitions after
+// the lease expired. The new owner resumes tracking the streams from the sync cookies the previous owner
+// persisted.
+type StreamLeases struct {
+	store             storage.StreamLeaseStore
+	instanceID        string
+	partitions        int
+	leaseDuration     time.Duration
+	heartbeatInterval time.Duration
+	// clockSkewMargin is subtracted from the lease expiration in Owns
+	clockSkewMargin time.Duration
+
+	// onAcquired is called with partitions this instance took over
+	onAcquired func(ctx context.Context, partitions []int)
+	// onReleased is called with partitions this instance no longer owns
+	onReleased func(ctx context.Context, partitions []int)
+
+	mu sync.RWMutex
+	// owned keeps the partitions this instance owns with the time its lease expires
+	owned map[int]time.Time
+}
+
+// NewStreamLeases creates a StreamLeases instance for the given instance.
+func NewStreamLeases(
+	store storage.StreamLeaseStore,
+	instanceID string,
+	cfg config.StreamLeasesConfig,
+) *StreamLeases {
+	return &StreamLeases{
+		store:             store,
+		instanceID:        instanceID,
+		partitions:        cfg.GetPartitions(),
+		leaseDuration:     cfg.GetLeaseDuration(),
+		heartbeatInterval: cfg.GetHeartbeatInterval(),
+		clockSkewMargin:   cfg.GetClockSkewMargin(),
+		onAcquired:        func(context.Context, []int) {},
+		onReleased:        func(context.Context, []int) {},
+		owned:             make(map[int]time.Time),
+	}
+}
+
+// StreamPartition returns the partition the given stream belongs to.
+func StreamPartition(streamID shared.StreamId, partitions int) int {
+	h := fnv.New32a()
+	_, _ = h.Write(streamID[:])
+	return int(h.Sum32() % uint32(partitions))
+}
+
+// Owns returns true when this instance holds a valid lease on the partition the given stream belongs to.
+// The lease is considered expired clockSkewMargin before its expiration, lease expiration is determined
+// with the database clock and other instances can take over once it passed on their clocks.
+func (l *StreamLeases) Owns(streamID shared.StreamId) bool {
+	partition := StreamPartition(streamID, l.partitions)
+
+	l.mu.RLock()
+	expiresAt, ok := l.owned[partition]
+	l.mu.RUnlock()
+
+	// a lease that wasn't renewed in time can already be taken over by another instance
+	return ok && time.Now().Add(l.clockSkewMargin).Before(expiresAt)
+}
+
+// OwnedPartitions returns the partitions this instance currently owns.
+func (l *StreamLeases) OwnedPartitions() []int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	partitions := make([]int, 0, len(l.owned))
+	for partition := range l.owned {
+		partitions = append(partitions, partition)
+	}
+	slices.Sort(partitions)
+	return partitions
+}
+
+// Run renews leases and rebalances partitions until the given ctx expires. When the ctx expires all
+// leases are released to let other instances take over immediately.
+func (l *StreamLeases) Run(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+
+	ticker := time.NewTicker(l.heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if err := l.store.RemoveStreamLeaseInstance(releaseCtx, l.instanceID); err != nil {
+				log.Warnw("Unable to release stream leases", "instance", l.instanceID, "error", err)
+			}
+			cancel()
+			return
+		case <-ticker.C:
+			if err := l.Heartbeat(ctx); err != nil {
+				log.Warnw("Stream lease heartbeat failed", "instance", l.instanceID, "error", err)
+			}
+		}
+	}
+}
+
+// Heartbeat renews the leases this instance holds and rebalances partitions over the live instances.
+// It calls onReleased for partitions that were lost or released and onAcquired for partitions that
+// were acquired.
+func (l *StreamLeases) Heartbeat(ctx context.Context) error {
+	live, err := l.store.HeartbeatStreamLeaseInstance(ctx, l.instanceID, l.leaseDuration)
+	if err != nil {
+		return err
+	}
+
+	renewedAt := time.Now()
+	renewed, err := l.store.RenewStreamLeases(ctx, l.instanceID, l.leaseDuration)
+	if err != nil {
+		return err
+	}
+
+	// partitions that weren't renewed have expired and can be owned by another instance
+	lost := l.setOwned(renewed, renewedAt)
+	if len(lost) > 0 {
+		logging.FromCtx(ctx).Infow("Lost stream partition leases", "instance", l.instanceID, "partitions", lost)
+		l.onReleased(ctx, lost)
+	}
+
+	target := l.targetPartitions(live)
+
+	if len(renewed) > target {
+		slices.Sort(renewed)
+		excess := renewed[target:]
+
+		// stop processing streams before the lease is released to prevent sending notifications
+		// twice when the new owner starts processing streams in these partitions
+		l.removeOwned(excess)
+		l.onReleased(ctx, excess)
+
+		if err := l.store.ReleaseStreamLeases(ctx, l.instanceID, excess); err != nil {
+			return err
+		}
+
+		logging.FromCtx(ctx).Infow("Released stream partitions",
+			"instance", l.instanceID, "partitions", excess, "liveInstances", live)
+		return nil
+	}
+
+	if len(renewed) < target {
+		acquiredAt := time.Now()
+		acquired, err := l.store.AcquireStreamLeases(
+			ctx, l.instanceID, l.partitions, target-len(renewed), l.leaseDuration)
+		if err != nil {
+			return err
+		}
+
+		if len(acquired) > 0 {
+			l.addOwned(acquired, acquiredAt)
+			logging.FromCtx(ctx).Infow("Acquired stream partitions",
+				"instance", l.instanceID, "partitions", acquired, "liveInstances", live)
+			l.onAcquired(ctx, acquired)
+		}
+	}
+
+	return nil
+}
+
+// targetPartitions returns the number of partitions each of the live instances must own.
+func (l *StreamLeases) targetPartitions(live int) int {
+	if live <= 0 {
+		live = 1
+	}
+	return (l.partitions + live - 1) / live
+}
+
+// setOwned replaces the owned partitions with the renewed partitions and returns the partitions that
+// were owned but not renewed.
+func (l *StreamLeases) setOwned(renewed []int, renewedAt time.Time) []int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var lost []int
+	for partition := range l.owned {
+		if !slices.Contains(renewed, partition) {
+			lost = append(lost, partition)
+		}
+	}
+
+	l.owned = make(map[int]time.Time, len(renewed))
+	for _, partition := range renewed {
+		l.owned[partition] = renewedAt.Add(l.leaseDuration)
+	}
+
+	slices.Sort(lost)
+	return lost
+}
+
+func (l *StreamLeases) addOwned(partitions []int, acquiredAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, partition := range partitions {
+		l.owned[partition] = acquiredAt.Add(l.leaseDuration)
+	}
+}
+
+func (l *StreamLeases) removeOwned(partitions []int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, partition := range partitions {
+		delete(l.owned, partition)
+	}
+}
@@ -0,0 +1,257 @@
+package sync
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// memStreamLeaseStore implements storage.StreamLeaseStore in memory for testing
+type memStreamLeaseStore struct {
+	mu         sync.Mutex
+	now        time.Time
+	heartbeats map[string]time.Time
+	owners     map[int]string
+	expires    map[int]time.Time
+}
+
+func newMemStreamLeaseStore() *memStreamLeaseStore {
+	return &memStreamLeaseStore{
+		now:        time.Now(),
+		heartbeats: make(map[string]time.Time),
+		owners:     make(map[int]string),
+		expires:    make(map[int]time.Time),
+	}
+}
+
+func (m *memStreamLeaseStore) advance(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.now = m.now.Add(d)
+}
+
+func (m *memStreamLeaseStore) HeartbeatStreamLeaseInstance(
+	_ context.Context,
+	instanceID string,
+	leaseDuration time.Duration,
+) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.heartbeats[instanceID] = m.now
+	for id, at := range m.heartbeats {
+		if at.Before(m.now.Add(-leaseDuration)) {
+			delete(m.heartbeats, id)
+		}
+	}
+	return len(m.heartbeats), nil
+}
+
+func (m *memStreamLeaseStore) RemoveStreamLeaseInstance(_ context.Context, instanceID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.heartbeats, instanceID)
+	for partition, owner := range m.owners {
+		if owner == instanceID {
+			delete(m.owners, partition)
+		}
+	}
+	return nil
+}
+
+func (m *memStreamLeaseStore) RenewStreamLeases(
+	_ context.Context,
+	instanceID string,
+	leaseDuration time.Duration,
+) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var renewed []int
+	for partition, owner := range m.owners {
+		if owner == instanceID && m.expires[partition].After(m.now) {
+			m.expires[partition] = m.now.Add(leaseDuration)
+			renewed = append(renewed, partition)
+		}
+	}
+	return renewed, nil
+}
+
+func (m *memStreamLeaseStore) AcquireStreamLeases(
+	_ context.Context,
+	instanceID string,
+	numPartitions int,
+	limit int,
+	leaseDuration time.Duration,
+) ([]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var acquired []int
+	for partition := 0; partition < numPartitions && len(acquired) < limit; partition++ {
+		if _, owned := m.owners[partition]; owned && m.expires[partition].After(m.now) {
+			continue
+		}
+		m.owners[partition] = instanceID
+		m.expires[partition] = m.now.Add(leaseDuration)
+		acquired = append(acquired, partition)
+	}
+	return acquired, nil
+}
+
+func (m *memStreamLeaseStore) ReleaseStreamLeases(_ context.Context, instanceID string, partitions []int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, partition := range partitions {
+		if m.owners[partition] == instanceID {
+			delete(m.owners, partition)
+		}
+	}
+	return nil
+}
+
+func TestStreamPartition(t *testing.T) {
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	partition := StreamPartition(streamID, 16)
+	require.GreaterOrEqual(t, partition, 0)
+	require.Less(t, partition, 16)
+	require.Equal(t, partition, StreamPartition(streamID, 16), "partition must be stable")
+	require.Equal(t, 0, StreamPartition(streamID, 1))
+}
+
+// TestStreamLeases_Rebalance verifies that partitions are rebalanced when instances join and leave
+func TestStreamLeases_Rebalance(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		req   = require.New(t)
+		store = newMemStreamLeaseStore()
+		cfg   = config.StreamLeasesConfig{
+			Enabled:           true,
+			Partitions:        8,
+			LeaseDuration:     time.Hour, // expiry is driven by the store clock
+			HeartbeatInterval: time.Minute,
+		}
+		leases1 = NewStreamLeases(store, "instance-1", cfg)
+		leases2 = NewStreamLeases(store, "instance-2", cfg)
+	)
+
+	var acquired1, released1, acquired2 []int
+	leases1.onAcquired = func(_ context.Context, partitions []int) { acquired1 = append(acquired1, partitions...) }
+	leases1.onReleased = func(_ context.Context, partitions []int) { released1 = append(released1, partitions...) }
+	leases2.onAcquired = func(_ context.Context, partitions []int) { acquired2 = append(acquired2, partitions...) }
+
+	// single instance owns all partitions
+	req.NoError(leases1.Heartbeat(ctx))
+	req.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7}, leases1.OwnedPartitions())
+	req.Len(acquired1, 8)
+
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	req.True(leases1.Owns(streamID))
+	req.False(leases2.Owns(streamID))
+
+	// second instance joins, all partitions are owned so nothing can be acquired yet
+	req.NoError(leases2.Heartbeat(ctx))
+	req.Empty(leases2.OwnedPartitions())
+
+	// first instance notices the second instance and releases its excess partitions
+	req.NoError(leases1.Heartbeat(ctx))
+	req.Equal([]int{0, 1, 2, 3}, leases1.OwnedPartitions())
+	req.Equal([]int{4, 5, 6, 7}, released1)
+
+	// second instance picks up the released partitions
+	req.NoError(leases2.Heartbeat(ctx))
+	req.Equal([]int{4, 5, 6, 7}, leases2.OwnedPartitions())
+	req.Equal([]int{4, 5, 6, 7}, acquired2)
+
+	// each stream is owned by exactly one instance
+	for range 32 {
+		streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		req.NotEqual(leases1.Owns(streamID), leases2.Owns(streamID))
+	}
+
+	// second instance leaves gracefully, first instance takes over
+	req.NoError(store.RemoveStreamLeaseInstance(ctx, "instance-2"))
+	acquired1 = nil
+	req.NoError(leases1.Heartbeat(ctx))
+	req.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7}, leases1.OwnedPartitions())
+	slices.Sort(acquired1)
+	req.Equal([]int{4, 5, 6, 7}, acquired1)
+}
+
+// TestStreamLeases_Expired verifies that an instance that lost its leases stops owning streams and that
+// other instances take over partitions of instances that stopped
+func TestStreamLeases_Expired(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		req   = require.New(t)
+		store = newMemStreamLeaseStore()
+		cfg   = config.StreamLeasesConfig{
+			Enabled:       true,
+			Partitions:    4,
+			LeaseDuration: time.Hour,
+		}
+		leases1 = NewStreamLeases(store, "instance-1", cfg)
+		leases2 = NewStreamLeases(store, "instance-2", cfg)
+	)
+
+	var released1 []int
+	leases1.onReleased = func(_ context.Context, partitions []int) { released1 = append(released1, partitions...) }
+
+	req.NoError(leases1.Heartbeat(ctx))
+	req.NoError(leases2.Heartbeat(ctx))
+	req.NoError(leases1.Heartbeat(ctx))
+	req.NoError(leases2.Heartbeat(ctx))
+	req.Equal([]int{0, 1}, leases1.OwnedPartitions())
+	req.Equal([]int{2, 3}, leases2.OwnedPartitions())
+
+	// instance 1 stops sending heartbeats, its leases expire and instance 2 takes over
+	store.advance(2 * time.Hour)
+	req.NoError(leases2.Heartbeat(ctx))
+	req.Equal([]int{0, 1, 2, 3}, leases2.OwnedPartitions())
+
+	// instance 1 comes back and notices that its leases were lost
+	released1 = nil
+	req.NoError(leases1.Heartbeat(ctx))
+	req.Equal([]int{0, 1}, released1)
+	req.Empty(leases1.OwnedPartitions())
+}
+
+// TestStreamLeases_ClockSkewMargin verifies that an instance stops owning streams before its lease expires
+func TestStreamLeases_ClockSkewMargin(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		req   = require.New(t)
+		store = newMemStreamLeaseStore()
+		cfg   = config.StreamLeasesConfig{
+			Enabled:         true,
+			Partitions:      1,
+			LeaseDuration:   time.Hour,
+			ClockSkewMargin: 10 * time.Minute,
+		}
+		leases   = NewStreamLeases(store, "instance-1", cfg)
+		streamID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	)
+
+	req.NoError(leases.Heartbeat(ctx))
+	req.True(leases.Owns(streamID))
+
+	// the lease is still valid but within the clock skew margin of its expiration
+	leases.addOwned([]int{0}, time.Now().Add(-55*time.Minute))
+	req.False(leases.Owns(streamID))
+	req.Equal([]int{0}, leases.OwnedPartitions())
+
+	// the margin can't exceed the time between the last heartbeat and the lease expiration
+	cfg.ClockSkewMargin = time.Hour
+	req.Less(cfg.GetClockSkewMargin(), cfg.GetLeaseDuration()-cfg.GetHeartbeatInterval())
+}
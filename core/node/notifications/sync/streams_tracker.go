@@ -2,13 +2,16 @@ package sync
 
 import (
 	"context"
+	"sync/atomic"
 
+	mapset "github.com/deckarep/golang-set/v2"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/node/crypto"
 	"github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
 	"github.com/towns-protocol/towns/core/node/nodes"
 	"github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/registries"
@@ -23,11 +26,37 @@ type NotificationsStreamsTracker struct {
 	storage            UserPreferencesStore
 	onChainConfig      crypto.OnChainConfiguration
 	notificationConfig config.NotificationsConfig
+	// leases partitions streams over notification service instances, nil when a single
+	// instance tracks all streams
+	leases        *StreamLeases
+	leasesStarted atomic.Bool
 }
 
 var _ track_streams.StreamFilter = (*NotificationsStreamsTracker)(nil)
 
-// NewNotificationsStreamsTracker creates a stream tracker instance.
+// leasedStreamsListener forwards events to the wrapped listener only for streams the instance is
+// responsible for. This prevents sending notifications for streams in partitions that were handed
+// off to another instance but are not yet removed from their sync session.
+type leasedStreamsListener struct {
+	track_streams.StreamEventListener
+	tracker *NotificationsStreamsTracker
+}
+
+func (l *leasedStreamsListener) OnMessageEvent(
+	ctx context.Context,
+	streamID shared.StreamId,
+	spaceID *shared.StreamId,
+	members mapset.Set[string],
+	event *events.ParsedEvent,
+) {
+	if l.tracker.isResponsible(streamID) {
+		l.StreamEventListener.OnMessageEvent(ctx, streamID, spaceID, members, event)
+	}
+}
+
+// NewNotificationsStreamsTracker creates a stream tracker instance. When leases is not nil the tracker only
+// tracks the streams in the partitions this instance holds a lease for and persists sync cookies in the
+// given cookieStore to allow another instance to resume these streams when a partition is handed off.
 func NewNotificationsStreamsTracker(
 	ctx context.Context,
 	onChainConfig crypto.OnChainConfiguration,
@@ -39,12 +68,22 @@ func NewNotificationsStreamsTracker(
 	trackingConfig config.StreamTrackingConfig,
 	notificationConfig config.NotificationsConfig,
 	otelTracer trace.Tracer,
+	leases *StreamLeases,
+	cookieStore track_streams.SyncCookieStore,
 ) (track_streams.StreamsTracker, error) {
 	tracker := &NotificationsStreamsTracker{
 		onChainConfig:      onChainConfig,
 		storage:            storage,
 		notificationConfig: notificationConfig,
+		leases:             leases,
+	}
+
+	if leases != nil {
+		listener = &leasedStreamsListener{StreamEventListener: listener, tracker: tracker}
+	} else {
+		cookieStore = nil // cookies are only needed to hand off streams to another instance
 	}
+
 	if err := tracker.StreamsTrackerImpl.Init(
 		ctx,
 		onChainConfig,
@@ -55,7 +94,7 @@ func NewNotificationsStreamsTracker(
 		metricsFactory,
 		trackingConfig,
 		otelTracer,
-		nil, // cookieStore - notifications doesn't persist cookies yet
+		cookieStore,
 	); err != nil {
 		return nil, err
 	}
@@ -76,9 +115,65 @@ func (tracker *NotificationsStreamsTracker) NewTrackedStream(
 		stream,
 		tracker.StreamsTrackerImpl.Listener(),
 		tracker.storage,
+		tracker.leases != nil && streamID.Type() != shared.STREAM_USER_SETTINGS_BIN,
 	)
 }
 
+// Run acquires the initial stream partitions when streams are partitioned over instances and starts
+// tracking streams until the given ctx expires.
+func (tracker *NotificationsStreamsTracker) Run(ctx context.Context) error {
+	if tracker.leases != nil && tracker.leasesStarted.CompareAndSwap(false, true) {
+		// acquire partitions before streams are loaded from the registry, partitions that are acquired
+		// later are picked up by rescanning the registry
+		if err := tracker.leases.Heartbeat(ctx); err != nil {
+			tracker.leasesStarted.Store(false)
+			return err
+		}
+		tracker.leases.onAcquired = tracker.onPartitionsAcquired
+		tracker.leases.onReleased = tracker.onPartitionsReleased
+		go tracker.leases.Run(ctx)
+	}
+
+	return tracker.StreamsTrackerImpl.Run(ctx)
+}
+
+// onPartitionsAcquired starts tracking the streams in the given partitions. Streams resume from the sync
+// cookie the previous owner persisted.
+func (tracker *NotificationsStreamsTracker) onPartitionsAcquired(ctx context.Context, partitions []int) {
+	acquired := mapset.NewThreadUnsafeSet(partitions...)
+	go func() {
+		added, err := tracker.RescanStreams(ctx, func(streamID shared.StreamId) bool {
+			return acquired.Contains(StreamPartition(streamID, tracker.leases.partitions))
+		})
+		if err != nil {
+			logging.FromCtx(ctx).Errorw("Unable to load streams for acquired partitions",
+				"partitions", partitions, "error", err)
+			return
+		}
+		logging.FromCtx(ctx).Infow("Track streams in acquired partitions", "partitions", partitions, "streams", added)
+	}()
+}
+
+// onPartitionsReleased stops tracking the streams in the given partitions. User settings streams are
+// tracked by all instances and are never removed.
+func (tracker *NotificationsStreamsTracker) onPartitionsReleased(ctx context.Context, partitions []int) {
+	released := mapset.NewThreadUnsafeSet(partitions...)
+	removed := tracker.RemoveStreams(func(streamID shared.StreamId) bool {
+		return streamID.Type() != shared.STREAM_USER_SETTINGS_BIN &&
+			released.Contains(StreamPartition(streamID, tracker.leases.partitions))
+	})
+	logging.FromCtx(ctx).Infow("Stop tracking streams in released partitions",
+		"partitions", partitions, "streams", removed)
+}
+
+// isResponsible returns true when this instance must process events for the given stream. User settings
+// streams keep the block lists and read markers that all instances need and are processed by all instances.
+func (tracker *NotificationsStreamsTracker) isResponsible(streamID shared.StreamId) bool {
+	return tracker.leases == nil ||
+		streamID.Type() == shared.STREAM_USER_SETTINGS_BIN ||
+		tracker.leases.Owns(streamID)
+}
+
 func (tracker *NotificationsStreamsTracker) coldStreamsEnabled() bool {
 	return tracker.notificationConfig.ColdStreamsEnabled
 }
@@ -87,6 +182,10 @@ func (tracker *NotificationsStreamsTracker) coldStreamsEnabled() bool {
 func (tracker *NotificationsStreamsTracker) TrackStream(_ context.Context, streamID shared.StreamId, isInit bool) bool {
 	streamType := streamID.Type()
 
+	if !tracker.isResponsible(streamID) {
+		return false
+	}
+
 	// When cold streams are enabled, only track user settings stream on init
 	if isInit && tracker.coldStreamsEnabled() {
 		return streamType == shared.STREAM_USER_SETTINGS_BIN
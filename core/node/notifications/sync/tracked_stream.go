@@ -43,6 +43,7 @@ type (
 //	significantly higher than the new implementation especially for streams with long history.
 //
 // This ensures user blocked lists are kept up-to-date and message events are sent to the listener.
+// When persistCookies is set the sync cookie is persisted to allow another instance to resume the stream.
 //
 // See notification_stream_view_bench_test.go for benchmarks.
 func NewTrackedStreamForNotifications(
@@ -52,9 +53,10 @@ func NewTrackedStreamForNotifications(
 	stream *StreamAndCookie,
 	listener track_streams.StreamEventListener,
 	userPreferences UserPreferencesStore,
+	persistCookies bool,
 ) (TrackedStreamView, error) {
 	// Use the memory-optimized notification stream view
-	return NewNotificationStreamView(
+	view, err := NewNotificationStreamView(
 		ctx,
 		streamID,
 		cfg,
@@ -62,4 +64,9 @@ func NewTrackedStreamForNotifications(
 		listener,
 		userPreferences,
 	)
+	if err != nil {
+		return nil, err
+	}
+	view.persistCookies = persistCookies
+	return view, nil
 }
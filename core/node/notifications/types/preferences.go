@@ -11,6 +11,11 @@ import (
 	"github.com/towns-protocol/towns/core/node/shared"
 )
 
+const (
+	// TriggerRoleMention is the notification kind for messages that mention one of the user's roles.
+	TriggerRoleMention = "role_mention"
+)
+
 // UserPreferences are all user cache and web/APN subscriptions a user has configured through the API.
 type (
 	SpacesMap        map[shared.StreamId]*SpacePreferences
@@ -64,6 +69,29 @@ type (
 		// Channels is a list with channel specific settings that overwrite the space wide setting.
 		Channels SpaceChannelsMap
 	}
+
+	// SentNotification records that a notification for an event was sent to a user.
+	// It is used to replace or retract the notification when the event is edited or redacted
+	// and makes up the user's notification history.
+	SentNotification struct {
+		// ID identifies the notification in the user's notification history.
+		// It is assigned by the store.
+		ID int64
+		// EventHash is the hash of the event the notification was sent for.
+		EventHash common.Hash
+		// UserID is the user that received the notification.
+		UserID common.Address
+		// ChannelID is the stream the event was added to.
+		ChannelID shared.StreamId
+		// Sender is the creator of the event.
+		Sender common.Address
+		// Kind is the notification kind, e.g. mention or direct_message.
+		Kind string
+		// SentAt is the time the notification was sent.
+		SentAt time.Time
+		// Seen is true when the user marked the notification as seen.
+		Seen bool
+	}
 )
 
 // Clone creates a deep copy of up
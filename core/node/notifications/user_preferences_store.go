@@ -414,3 +414,57 @@ func (up *UserPreferencesCache) RemoveAPNSubscription(ctx context.Context,
 
 	return err
 }
+
+func (up *UserPreferencesCache) AddSentNotifications(
+	ctx context.Context,
+	notifications []*types.SentNotification,
+) error {
+	return up.persistent.AddSentNotifications(ctx, notifications)
+}
+
+func (up *UserPreferencesCache) GetSentNotifications(
+	ctx context.Context,
+	eventHash common.Hash,
+) ([]*types.SentNotification, error) {
+	return up.persistent.GetSentNotifications(ctx, eventHash)
+}
+
+func (up *UserPreferencesCache) DeleteSentNotificationsBefore(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	return up.persistent.DeleteSentNotificationsBefore(ctx, before)
+}
+
+func (up *UserPreferencesCache) GetNotificationHistory(
+	ctx context.Context,
+	userID common.Address,
+	beforeID int64,
+	limit int,
+	unseenOnly bool,
+) ([]*types.SentNotification, error) {
+	return up.persistent.GetNotificationHistory(ctx, userID, beforeID, limit, unseenOnly)
+}
+
+func (up *UserPreferencesCache) MarkNotificationsSeen(
+	ctx context.Context,
+	userID common.Address,
+	ids []int64,
+	upToID int64,
+) error {
+	return up.persistent.MarkNotificationsSeen(ctx, userID, ids, upToID)
+}
+
+func (up *UserPreferencesCache) CountUnseenNotifications(
+	ctx context.Context,
+	userID common.Address,
+) (int64, error) {
+	return up.persistent.CountUnseenNotifications(ctx, userID)
+}
+
+func (up *UserPreferencesCache) TrimNotificationHistory(
+	ctx context.Context,
+	maxPerUser int,
+) (int64, error) {
+	return up.persistent.TrimNotificationHistory(ctx, maxPerUser)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/SherClockHolmes/webpush-go"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/towns-protocol/towns/core/node/base/test"
@@ -19,6 +20,7 @@ import (
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
 	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
 	"github.com/towns-protocol/towns/core/node/testutils/dbtestutils"
 )
 
@@ -81,6 +83,152 @@ func TestUserPreferencesStore(t *testing.T) {
 	t.Run("webPushExpired", func(t *testing.T) {
 		webPushExpired(req, ctx, store)
 	})
+	t.Run("sentNotifications", func(t *testing.T) {
+		sentNotifications(req, ctx, store)
+	})
+	t.Run("notificationHistory", func(t *testing.T) {
+		notificationHistory(req, ctx, store)
+	})
+}
+
+func notificationHistory(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	var (
+		user      = common.Address{4}
+		otherUser = common.Address{5}
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		sentAt    = time.Now().Truncate(time.Millisecond)
+	)
+
+	for i := range 5 {
+		var eventHash common.Hash
+		_, err := rand.Read(eventHash[:])
+		req.NoError(err)
+
+		req.NoError(store.AddSentNotifications(ctx, []*types.SentNotification{
+			{
+				EventHash: eventHash,
+				UserID:    user,
+				ChannelID: channelID,
+				Sender:    otherUser,
+				Kind:      "new_message",
+				SentAt:    sentAt.Add(time.Duration(i) * time.Second),
+			},
+			{
+				EventHash: eventHash,
+				UserID:    otherUser,
+				ChannelID: channelID,
+				Sender:    user,
+				Kind:      "new_message",
+				SentAt:    sentAt.Add(time.Duration(i) * time.Second),
+			},
+		}))
+	}
+
+	history, err := store.GetNotificationHistory(ctx, user, 0, 3, false)
+	req.NoError(err)
+	req.Len(history, 3)
+	for i, n := range history {
+		req.Equal(user, n.UserID)
+		req.Equal(channelID, n.ChannelID)
+		req.Equal(otherUser, n.Sender)
+		req.False(n.Seen)
+		if i > 0 {
+			req.Less(n.ID, history[i-1].ID, "history must be sorted from most recent to oldest")
+		}
+	}
+	req.True(sentAt.Add(4 * time.Second).Equal(history[0].SentAt))
+
+	nextPage, err := store.GetNotificationHistory(ctx, user, history[2].ID, 3, false)
+	req.NoError(err)
+	req.Len(nextPage, 2)
+	req.Less(nextPage[0].ID, history[2].ID)
+
+	unseen, err := store.CountUnseenNotifications(ctx, user)
+	req.NoError(err)
+	req.EqualValues(5, unseen)
+
+	// marking notifications of another user as seen has no effect
+	req.NoError(store.MarkNotificationsSeen(ctx, otherUser, []int64{history[0].ID}, 0))
+	req.NoError(store.MarkNotificationsSeen(ctx, user, []int64{history[0].ID}, nextPage[0].ID))
+
+	unseen, err = store.CountUnseenNotifications(ctx, user)
+	req.NoError(err)
+	req.EqualValues(2, unseen)
+
+	unseenHistory, err := store.GetNotificationHistory(ctx, user, 0, 10, true)
+	req.NoError(err)
+	req.Len(unseenHistory, 2)
+	req.Equal(history[1].ID, unseenHistory[0].ID)
+	req.Equal(history[2].ID, unseenHistory[1].ID)
+
+	unseen, err = store.CountUnseenNotifications(ctx, otherUser)
+	req.NoError(err)
+	req.EqualValues(5, unseen)
+
+	_, err = store.TrimNotificationHistory(ctx, 2)
+	req.NoError(err)
+
+	history, err = store.GetNotificationHistory(ctx, user, 0, 10, false)
+	req.NoError(err)
+	req.Len(history, 2)
+	req.True(sentAt.Add(4 * time.Second).Equal(history[0].SentAt))
+	req.True(history[0].Seen)
+}
+
+func sentNotifications(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
+	var (
+		eventHash common.Hash
+		channelID = testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		sender    = common.Address{1}
+		users     = []common.Address{{2}, {3}}
+		sentAt    = time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	)
+	_, err := rand.Read(eventHash[:])
+	req.NoError(err)
+
+	sent, err := store.GetSentNotifications(ctx, eventHash)
+	req.NoError(err)
+	req.Empty(sent)
+
+	var records []*types.SentNotification
+	for _, user := range users {
+		records = append(records, &types.SentNotification{
+			EventHash: eventHash,
+			UserID:    user,
+			ChannelID: channelID,
+			Sender:    sender,
+			Kind:      "mention",
+			SentAt:    sentAt,
+		})
+	}
+	req.NoError(store.AddSentNotifications(ctx, records))
+	// recording the same notification again must not fail
+	req.NoError(store.AddSentNotifications(ctx, records[:1]))
+
+	sent, err = store.GetSentNotifications(ctx, eventHash)
+	req.NoError(err)
+	req.Len(sent, len(users))
+	for _, n := range sent {
+		req.Contains(users, n.UserID)
+		req.Equal(eventHash, n.EventHash)
+		req.Equal(channelID, n.ChannelID)
+		req.Equal(sender, n.Sender)
+		req.Equal("mention", n.Kind)
+		req.True(sentAt.Equal(n.SentAt))
+	}
+
+	// records within the retention period are kept
+	deleted, err := store.DeleteSentNotificationsBefore(ctx, sentAt.Add(-time.Minute))
+	req.NoError(err)
+	req.Zero(deleted)
+
+	deleted, err = store.DeleteSentNotificationsBefore(ctx, sentAt.Add(time.Minute))
+	req.NoError(err)
+	req.EqualValues(len(users), deleted)
+
+	sent, err = store.GetSentNotifications(ctx, eventHash)
+	req.NoError(err)
+	req.Empty(sent)
 }
 
 func userPreferencesNotExists(req *require.Assertions, ctx context.Context, store *storage.PostgresNotificationStore) {
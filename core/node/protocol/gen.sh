@@ -11,6 +11,7 @@ buf generate --template core/node/protocol/buf.gen.yaml \
   --path protocol/auth.proto \
   --path protocol/notifications.proto \
   --path protocol/apps.proto \
+  --path protocol/search.proto \
   --path protocol/metadata_shard.proto
 popd > /dev/null
 
@@ -65,6 +65,9 @@ func (s *StreamSettings) ToMap() map[string]any {
 	if s.LightStream {
 		data["LightStream"] = s.LightStream
 	}
+	if s.MessageExpirationSeconds > 0 {
+		data["MessageExpirationSeconds"] = s.MessageExpirationSeconds
+	}
 
 	return data
 }
@@ -39,6 +39,15 @@ const (
 	// AuthenticationServiceFinishAuthenticationProcedure is the fully-qualified name of the
 	// AuthenticationService's FinishAuthentication RPC.
 	AuthenticationServiceFinishAuthenticationProcedure = "/river.AuthenticationService/FinishAuthentication"
+	// AuthenticationServiceRefreshSessionProcedure is the fully-qualified name of the
+	// AuthenticationService's RefreshSession RPC.
+	AuthenticationServiceRefreshSessionProcedure = "/river.AuthenticationService/RefreshSession"
+	// AuthenticationServiceListSessionsProcedure is the fully-qualified name of the
+	// AuthenticationService's ListSessions RPC.
+	AuthenticationServiceListSessionsProcedure = "/river.AuthenticationService/ListSessions"
+	// AuthenticationServiceRevokeSessionsProcedure is the fully-qualified name of the
+	// AuthenticationService's RevokeSessions RPC.
+	AuthenticationServiceRevokeSessionsProcedure = "/river.AuthenticationService/RevokeSessions"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -46,6 +55,9 @@ var (
 	authenticationServiceServiceDescriptor                    = protocol.File_auth_proto.Services().ByName("AuthenticationService")
 	authenticationServiceStartAuthenticationMethodDescriptor  = authenticationServiceServiceDescriptor.Methods().ByName("StartAuthentication")
 	authenticationServiceFinishAuthenticationMethodDescriptor = authenticationServiceServiceDescriptor.Methods().ByName("FinishAuthentication")
+	authenticationServiceRefreshSessionMethodDescriptor       = authenticationServiceServiceDescriptor.Methods().ByName("RefreshSession")
+	authenticationServiceListSessionsMethodDescriptor         = authenticationServiceServiceDescriptor.Methods().ByName("ListSessions")
+	authenticationServiceRevokeSessionsMethodDescriptor       = authenticationServiceServiceDescriptor.Methods().ByName("RevokeSessions")
 )
 
 // AuthenticationServiceClient is a client for the river.AuthenticationService service.
@@ -55,6 +67,16 @@ type AuthenticationServiceClient interface {
 	// FinishAuthentication expects the signed challenge and if valid returns a session token
 	// that can be used in the NotificationService.
 	FinishAuthentication(context.Context, *connect.Request[protocol.FinishAuthenticationRequest]) (*connect.Response[protocol.FinishAuthenticationResponse], error)
+	// RefreshSession exchanges a refresh token for a new session token and refresh token. Refresh tokens
+	// can only be used once, using a refresh token that was already used revokes the session.
+	RefreshSession(context.Context, *connect.Request[protocol.RefreshSessionRequest]) (*connect.Response[protocol.RefreshSessionResponse], error)
+	// ListSessions returns the active sessions of the authenticated user.
+	// Requires a session token in the AUTHORIZATION header.
+	ListSessions(context.Context, *connect.Request[protocol.ListSessionsRequest]) (*connect.Response[protocol.ListSessionsResponse], error)
+	// RevokeSessions revokes sessions of the authenticated user. Session tokens and refresh tokens that
+	// were issued for a revoked session are no longer accepted.
+	// Requires a session token in the AUTHORIZATION header.
+	RevokeSessions(context.Context, *connect.Request[protocol.RevokeSessionsRequest]) (*connect.Response[protocol.RevokeSessionsResponse], error)
 }
 
 // NewAuthenticationServiceClient constructs a client for the river.AuthenticationService service.
@@ -79,6 +101,24 @@ func NewAuthenticationServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(authenticationServiceFinishAuthenticationMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		refreshSession: connect.NewClient[protocol.RefreshSessionRequest, protocol.RefreshSessionResponse](
+			httpClient,
+			baseURL+AuthenticationServiceRefreshSessionProcedure,
+			connect.WithSchema(authenticationServiceRefreshSessionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[protocol.ListSessionsRequest, protocol.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthenticationServiceListSessionsProcedure,
+			connect.WithSchema(authenticationServiceListSessionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSessions: connect.NewClient[protocol.RevokeSessionsRequest, protocol.RevokeSessionsResponse](
+			httpClient,
+			baseURL+AuthenticationServiceRevokeSessionsProcedure,
+			connect.WithSchema(authenticationServiceRevokeSessionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -86,6 +126,9 @@ func NewAuthenticationServiceClient(httpClient connect.HTTPClient, baseURL strin
 type authenticationServiceClient struct {
 	startAuthentication  *connect.Client[protocol.StartAuthenticationRequest, protocol.StartAuthenticationResponse]
 	finishAuthentication *connect.Client[protocol.FinishAuthenticationRequest, protocol.FinishAuthenticationResponse]
+	refreshSession       *connect.Client[protocol.RefreshSessionRequest, protocol.RefreshSessionResponse]
+	listSessions         *connect.Client[protocol.ListSessionsRequest, protocol.ListSessionsResponse]
+	revokeSessions       *connect.Client[protocol.RevokeSessionsRequest, protocol.RevokeSessionsResponse]
 }
 
 // StartAuthentication calls river.AuthenticationService.StartAuthentication.
@@ -98,6 +141,21 @@ func (c *authenticationServiceClient) FinishAuthentication(ctx context.Context,
 	return c.finishAuthentication.CallUnary(ctx, req)
 }
 
+// RefreshSession calls river.AuthenticationService.RefreshSession.
+func (c *authenticationServiceClient) RefreshSession(ctx context.Context, req *connect.Request[protocol.RefreshSessionRequest]) (*connect.Response[protocol.RefreshSessionResponse], error) {
+	return c.refreshSession.CallUnary(ctx, req)
+}
+
+// ListSessions calls river.AuthenticationService.ListSessions.
+func (c *authenticationServiceClient) ListSessions(ctx context.Context, req *connect.Request[protocol.ListSessionsRequest]) (*connect.Response[protocol.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSessions calls river.AuthenticationService.RevokeSessions.
+func (c *authenticationServiceClient) RevokeSessions(ctx context.Context, req *connect.Request[protocol.RevokeSessionsRequest]) (*connect.Response[protocol.RevokeSessionsResponse], error) {
+	return c.revokeSessions.CallUnary(ctx, req)
+}
+
 // AuthenticationServiceHandler is an implementation of the river.AuthenticationService service.
 type AuthenticationServiceHandler interface {
 	// StartAuthentication returns a challenge that the client must sign to prove its identity.
@@ -105,6 +163,16 @@ type AuthenticationServiceHandler interface {
 	// FinishAuthentication expects the signed challenge and if valid returns a session token
 	// that can be used in the NotificationService.
 	FinishAuthentication(context.Context, *connect.Request[protocol.FinishAuthenticationRequest]) (*connect.Response[protocol.FinishAuthenticationResponse], error)
+	// RefreshSession exchanges a refresh token for a new session token and refresh token. Refresh tokens
+	// can only be used once, using a refresh token that was already used revokes the session.
+	RefreshSession(context.Context, *connect.Request[protocol.RefreshSessionRequest]) (*connect.Response[protocol.RefreshSessionResponse], error)
+	// ListSessions returns the active sessions of the authenticated user.
+	// Requires a session token in the AUTHORIZATION header.
+	ListSessions(context.Context, *connect.Request[protocol.ListSessionsRequest]) (*connect.Response[protocol.ListSessionsResponse], error)
+	// RevokeSessions revokes sessions of the authenticated user. Session tokens and refresh tokens that
+	// were issued for a revoked session are no longer accepted.
+	// Requires a session token in the AUTHORIZATION header.
+	RevokeSessions(context.Context, *connect.Request[protocol.RevokeSessionsRequest]) (*connect.Response[protocol.RevokeSessionsResponse], error)
 }
 
 // NewAuthenticationServiceHandler builds an HTTP handler from the service implementation. It
@@ -125,12 +193,36 @@ func NewAuthenticationServiceHandler(svc AuthenticationServiceHandler, opts ...c
 		connect.WithSchema(authenticationServiceFinishAuthenticationMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	authenticationServiceRefreshSessionHandler := connect.NewUnaryHandler(
+		AuthenticationServiceRefreshSessionProcedure,
+		svc.RefreshSession,
+		connect.WithSchema(authenticationServiceRefreshSessionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthenticationServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authenticationServiceListSessionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationServiceRevokeSessionsHandler := connect.NewUnaryHandler(
+		AuthenticationServiceRevokeSessionsProcedure,
+		svc.RevokeSessions,
+		connect.WithSchema(authenticationServiceRevokeSessionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.AuthenticationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthenticationServiceStartAuthenticationProcedure:
 			authenticationServiceStartAuthenticationHandler.ServeHTTP(w, r)
 		case AuthenticationServiceFinishAuthenticationProcedure:
 			authenticationServiceFinishAuthenticationHandler.ServeHTTP(w, r)
+		case AuthenticationServiceRefreshSessionProcedure:
+			authenticationServiceRefreshSessionHandler.ServeHTTP(w, r)
+		case AuthenticationServiceListSessionsProcedure:
+			authenticationServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthenticationServiceRevokeSessionsProcedure:
+			authenticationServiceRevokeSessionsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -147,3 +239,15 @@ func (UnimplementedAuthenticationServiceHandler) StartAuthentication(context.Con
 func (UnimplementedAuthenticationServiceHandler) FinishAuthentication(context.Context, *connect.Request[protocol.FinishAuthenticationRequest]) (*connect.Response[protocol.FinishAuthenticationResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AuthenticationService.FinishAuthentication is not implemented"))
 }
+
+func (UnimplementedAuthenticationServiceHandler) RefreshSession(context.Context, *connect.Request[protocol.RefreshSessionRequest]) (*connect.Response[protocol.RefreshSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AuthenticationService.RefreshSession is not implemented"))
+}
+
+func (UnimplementedAuthenticationServiceHandler) ListSessions(context.Context, *connect.Request[protocol.ListSessionsRequest]) (*connect.Response[protocol.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AuthenticationService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthenticationServiceHandler) RevokeSessions(context.Context, *connect.Request[protocol.RevokeSessionsRequest]) (*connect.Response[protocol.RevokeSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.AuthenticationService.RevokeSessions is not implemented"))
+}
@@ -66,6 +66,12 @@ const (
 	// NotificationServiceUnsubscribeAPNProcedure is the fully-qualified name of the
 	// NotificationService's UnsubscribeAPN RPC.
 	NotificationServiceUnsubscribeAPNProcedure = "/river.NotificationService/UnsubscribeAPN"
+	// NotificationServiceGetNotificationHistoryProcedure is the fully-qualified name of the
+	// NotificationService's GetNotificationHistory RPC.
+	NotificationServiceGetNotificationHistoryProcedure = "/river.NotificationService/GetNotificationHistory"
+	// NotificationServiceMarkNotificationsSeenProcedure is the fully-qualified name of the
+	// NotificationService's MarkNotificationsSeen RPC.
+	NotificationServiceMarkNotificationsSeenProcedure = "/river.NotificationService/MarkNotificationsSeen"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
@@ -82,6 +88,8 @@ var (
 	notificationServiceUnsubscribeWebPushMethodDescriptor      = notificationServiceServiceDescriptor.Methods().ByName("UnsubscribeWebPush")
 	notificationServiceSubscribeAPNMethodDescriptor            = notificationServiceServiceDescriptor.Methods().ByName("SubscribeAPN")
 	notificationServiceUnsubscribeAPNMethodDescriptor          = notificationServiceServiceDescriptor.Methods().ByName("UnsubscribeAPN")
+	notificationServiceGetNotificationHistoryMethodDescriptor  = notificationServiceServiceDescriptor.Methods().ByName("GetNotificationHistory")
+	notificationServiceMarkNotificationsSeenMethodDescriptor   = notificationServiceServiceDescriptor.Methods().ByName("MarkNotificationsSeen")
 )
 
 // NotificationServiceClient is a client for the river.NotificationService service.
@@ -110,6 +118,11 @@ type NotificationServiceClient interface {
 	SubscribeAPN(context.Context, *connect.Request[protocol.SubscribeAPNRequest]) (*connect.Response[protocol.SubscribeAPNResponse], error)
 	// UnsubscribeAPN unsubscribes a device from receiving Apple Push Notifications.
 	UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error)
+	// GetNotificationHistory returns a page of the notifications that were sent to the user, most recent first.
+	// The service keeps a bounded history of notifications per user.
+	GetNotificationHistory(context.Context, *connect.Request[protocol.GetNotificationHistoryRequest]) (*connect.Response[protocol.GetNotificationHistoryResponse], error)
+	// MarkNotificationsSeen marks notifications in the user's notification history as seen.
+	MarkNotificationsSeen(context.Context, *connect.Request[protocol.MarkNotificationsSeenRequest]) (*connect.Response[protocol.MarkNotificationsSeenResponse], error)
 }
 
 // NewNotificationServiceClient constructs a client for the river.NotificationService service. By
@@ -188,6 +201,18 @@ func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(notificationServiceUnsubscribeAPNMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getNotificationHistory: connect.NewClient[protocol.GetNotificationHistoryRequest, protocol.GetNotificationHistoryResponse](
+			httpClient,
+			baseURL+NotificationServiceGetNotificationHistoryProcedure,
+			connect.WithSchema(notificationServiceGetNotificationHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		markNotificationsSeen: connect.NewClient[protocol.MarkNotificationsSeenRequest, protocol.MarkNotificationsSeenResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkNotificationsSeenProcedure,
+			connect.WithSchema(notificationServiceMarkNotificationsSeenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -204,6 +229,8 @@ type notificationServiceClient struct {
 	unsubscribeWebPush      *connect.Client[protocol.UnsubscribeWebPushRequest, protocol.UnsubscribeWebPushResponse]
 	subscribeAPN            *connect.Client[protocol.SubscribeAPNRequest, protocol.SubscribeAPNResponse]
 	unsubscribeAPN          *connect.Client[protocol.UnsubscribeAPNRequest, protocol.UnsubscribeAPNResponse]
+	getNotificationHistory  *connect.Client[protocol.GetNotificationHistoryRequest, protocol.GetNotificationHistoryResponse]
+	markNotificationsSeen   *connect.Client[protocol.MarkNotificationsSeenRequest, protocol.MarkNotificationsSeenResponse]
 }
 
 // GetSettings calls river.NotificationService.GetSettings.
@@ -261,6 +288,16 @@ func (c *notificationServiceClient) UnsubscribeAPN(ctx context.Context, req *con
 	return c.unsubscribeAPN.CallUnary(ctx, req)
 }
 
+// GetNotificationHistory calls river.NotificationService.GetNotificationHistory.
+func (c *notificationServiceClient) GetNotificationHistory(ctx context.Context, req *connect.Request[protocol.GetNotificationHistoryRequest]) (*connect.Response[protocol.GetNotificationHistoryResponse], error) {
+	return c.getNotificationHistory.CallUnary(ctx, req)
+}
+
+// MarkNotificationsSeen calls river.NotificationService.MarkNotificationsSeen.
+func (c *notificationServiceClient) MarkNotificationsSeen(ctx context.Context, req *connect.Request[protocol.MarkNotificationsSeenRequest]) (*connect.Response[protocol.MarkNotificationsSeenResponse], error) {
+	return c.markNotificationsSeen.CallUnary(ctx, req)
+}
+
 // NotificationServiceHandler is an implementation of the river.NotificationService service.
 type NotificationServiceHandler interface {
 	// GetSettings returns user stored notification settings.
@@ -287,6 +324,11 @@ type NotificationServiceHandler interface {
 	SubscribeAPN(context.Context, *connect.Request[protocol.SubscribeAPNRequest]) (*connect.Response[protocol.SubscribeAPNResponse], error)
 	// UnsubscribeAPN unsubscribes a device from receiving Apple Push Notifications.
 	UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error)
+	// GetNotificationHistory returns a page of the notifications that were sent to the user, most recent first.
+	// The service keeps a bounded history of notifications per user.
+	GetNotificationHistory(context.Context, *connect.Request[protocol.GetNotificationHistoryRequest]) (*connect.Response[protocol.GetNotificationHistoryResponse], error)
+	// MarkNotificationsSeen marks notifications in the user's notification history as seen.
+	MarkNotificationsSeen(context.Context, *connect.Request[protocol.MarkNotificationsSeenRequest]) (*connect.Response[protocol.MarkNotificationsSeenResponse], error)
 }
 
 // NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -361,6 +403,18 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 		connect.WithSchema(notificationServiceUnsubscribeAPNMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	notificationServiceGetNotificationHistoryHandler := connect.NewUnaryHandler(
+		NotificationServiceGetNotificationHistoryProcedure,
+		svc.GetNotificationHistory,
+		connect.WithSchema(notificationServiceGetNotificationHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceMarkNotificationsSeenHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkNotificationsSeenProcedure,
+		svc.MarkNotificationsSeen,
+		connect.WithSchema(notificationServiceMarkNotificationsSeenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/river.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case NotificationServiceGetSettingsProcedure:
@@ -385,6 +439,10 @@ func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...conne
 			notificationServiceSubscribeAPNHandler.ServeHTTP(w, r)
 		case NotificationServiceUnsubscribeAPNProcedure:
 			notificationServiceUnsubscribeAPNHandler.ServeHTTP(w, r)
+		case NotificationServiceGetNotificationHistoryProcedure:
+			notificationServiceGetNotificationHistoryHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkNotificationsSeenProcedure:
+			notificationServiceMarkNotificationsSeenHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -437,3 +495,11 @@ func (UnimplementedNotificationServiceHandler) SubscribeAPN(context.Context, *co
 func (UnimplementedNotificationServiceHandler) UnsubscribeAPN(context.Context, *connect.Request[protocol.UnsubscribeAPNRequest]) (*connect.Response[protocol.UnsubscribeAPNResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.UnsubscribeAPN is not implemented"))
 }
+
+func (UnimplementedNotificationServiceHandler) GetNotificationHistory(context.Context, *connect.Request[protocol.GetNotificationHistoryRequest]) (*connect.Response[protocol.GetNotificationHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.GetNotificationHistory is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) MarkNotificationsSeen(context.Context, *connect.Request[protocol.MarkNotificationsSeenRequest]) (*connect.Response[protocol.MarkNotificationsSeenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("river.NotificationService.MarkNotificationsSeen is not implemented"))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/app_registry"
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/http_client"
+	"github.com/towns-protocol/towns/core/node/infra/analytics"
 	"github.com/towns-protocol/towns/core/node/logging"
 	"github.com/towns-protocol/towns/core/node/nodes"
 	"github.com/towns-protocol/towns/core/node/track_streams"
@@ -83,8 +84,10 @@ func (s *Service) startAppRegistryMode(opts *ServerStartOpts) error {
 	}
 
 	var streamEventListener track_streams.StreamEventListener
+	var analyticsSinks []analytics.Sink
 	if opts != nil {
 		streamEventListener = opts.StreamEventListener
+		analyticsSinks = opts.AnalyticsSinks
 	}
 
 	// If insecure webhook calls are desired, override the configured http client with an h2c client.
@@ -106,6 +109,7 @@ func (s *Service) startAppRegistryMode(opts *ServerStartOpts) error {
 		registries,
 		s.metrics,
 		streamEventListener,
+		analyticsSinks,
 		webhookHttpClient,
 		s.baseChain,
 		&s.config.AppRegistryContract,
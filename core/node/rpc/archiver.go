@@ -233,6 +233,20 @@ func (ct *StreamCorruptionTracker) ReportScrubSuccess(ctx context.Context, block
 	return nil
 }
 
+// ResetScrubCorruption clears a scrub failure after the miniblocks starting at fromBlock were
+// replaced, so that they are scrubbed again.
+func (ct *StreamCorruptionTracker) ResetScrubCorruption(fromBlock int64) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+
+	ct.corrupt = false
+	ct.corruptionReason = NotCorrupt
+	ct.firstCorruptBlock = -1
+	ct.corruptionError = nil
+	ct.consecutiveUpdateFailures = 0
+	ct.latestScrubbedBlock = min(ct.latestScrubbedBlock, fromBlock-1)
+}
+
 func (ct *StreamCorruptionTracker) GetLatestScrubbedBlock() int64 {
 	ct.mu.RLock()
 	defer ct.mu.RUnlock()
@@ -357,9 +371,13 @@ type Archiver struct {
 	streamLastMiniblockUpdated atomic.Uint64
 	scrubsInProgress           atomic.Int64
 
+	// Corrupt stream repair
+	repairs *streamRepairLog
+
 	// metrics
 	nodeAdvances        *prometheus.CounterVec
 	successfulDownloads *prometheus.CounterVec
+	streamRepairs       *prometheus.CounterVec
 
 	cancelFuncs []func()
 }
@@ -392,6 +410,7 @@ func NewArchiver(
 		storage:      storage,
 		tasks:        make(chan StreamId, config.GetTaskQueueSize()),
 		reports:      reports,
+		repairs:      newStreamRepairLog(config.Repair.GetAuditLogSize()),
 	}
 	a.startedWG.Add(1)
 	return a
@@ -488,6 +507,12 @@ func (a *Archiver) setupStatisticsMetrics(factory infra.MetricsFactory) {
 		"Total times a node gave the latest miniblock content to the archival service, according to the contract",
 		"node_address",
 	)
+
+	a.streamRepairs = factory.NewCounterVecEx(
+		"stream_repairs",
+		"Total corrupt stream repair attempts by outcome",
+		"status",
+	)
 }
 
 func (a *Archiver) GetCorruptStreams(ctx context.Context) []scrub.CorruptStreamRecord {
@@ -646,6 +671,7 @@ func (a *Archiver) ArchiveStream(ctx context.Context, stream *ArchiveStream) (er
 			ToExclusive:   toBlock,
 		})
 		req.Header().Set(RiverNoForwardHeader, RiverHeaderTrueValue)
+		req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
 		resp, err := stub.GetMiniblocks(ctx, req)
 		// Map connect errors back to river errors.
 		if err != nil {
@@ -763,6 +789,15 @@ func (a *Archiver) Start(ctx context.Context, once bool, metrics infra.MetricsFa
 	go a.processMiniblockScrubs(child)
 	a.cancelFuncs = append(a.cancelFuncs, cancel)
 
+	if a.config.Repair.Enabled && !once {
+		if err := a.loadStreamRepairs(ctx); err != nil {
+			logging.FromCtx(ctx).Errorw("Failed to load stream repairs", "error", err)
+		}
+		child, cancel = context.WithCancel(ctx)
+		go a.runStreamRepairs(child)
+		a.cancelFuncs = append(a.cancelFuncs, cancel)
+	}
+
 	err := a.startImpl(ctx, once, metrics)
 	if err != nil {
 		exitSignal <- err
@@ -814,6 +849,7 @@ func (a *Archiver) processScrubReports(ctx context.Context) {
 				}
 				as.mostRecentScrubbedBlock.Store(report.LatestBlockScrubbed)
 				as.scrubInProgress.Store(false)
+				a.onRepairScrubReport(ctx, report)
 
 				log.Errorw("Corrupt stream detected",
 					"streamId", as.streamId,
@@ -836,6 +872,7 @@ func (a *Archiver) processScrubReports(ctx context.Context) {
 
 			as.mostRecentScrubbedBlock.Store(report.LatestBlockScrubbed)
 			as.scrubInProgress.Store(false)
+			a.onRepairScrubReport(ctx, report)
 
 		case <-ctx.Done():
 			return
@@ -0,0 +1,426 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/rpc/headers"
+	"github.com/towns-protocol/towns/core/node/scrub"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const (
+	// RepairStatusFailed means no replica provided a valid copy of the corrupt miniblocks,
+	// or the repaired miniblocks could not be written to storage.
+	RepairStatusFailed = "failed"
+	// RepairStatusRescrubbing means the corrupt miniblocks were replaced and the stream
+	// is waiting to be scrubbed again.
+	RepairStatusRescrubbing = "rescrubbing"
+	// RepairStatusRepaired means the replaced miniblocks passed the scrub.
+	RepairStatusRepaired = "repaired"
+	// RepairStatusRescrubFailed means the stream was found corrupt again after the repair.
+	RepairStatusRescrubFailed = "rescrub_failed"
+)
+
+// streamRepairLog keeps the audit trail of stream repairs and the number of repair attempts
+// per stream. Only the most recent maxSize records are kept in memory, the records are
+// persisted in storage by the archiver and loaded on start together with the attempt counts.
+type streamRepairLog struct {
+	mu       sync.Mutex
+	maxSize  int
+	records  []*scrub.StreamRepairRecord
+	attempts map[StreamId]uint32
+	// rescrubbing holds the records of repaired streams that wait for their scrub report.
+	rescrubbing map[StreamId]*scrub.StreamRepairRecord
+}
+
+func newStreamRepairLog(maxSize int) *streamRepairLog {
+	return &streamRepairLog{
+		maxSize:     maxSize,
+		attempts:    make(map[StreamId]uint32),
+		rescrubbing: make(map[StreamId]*scrub.StreamRepairRecord),
+	}
+}
+
+// add appends the record to the audit trail and counts the repair attempt.
+func (l *streamRepairLog) add(record *scrub.StreamRepairRecord) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.attempts[record.StreamId]++
+	if record.Status == RepairStatusRescrubbing {
+		l.rescrubbing[record.StreamId] = record
+	}
+
+	l.records = append(l.records, record)
+	if len(l.records) > l.maxSize {
+		l.records = l.records[len(l.records)-l.maxSize:]
+	}
+}
+
+// load restores the audit trail from the stored records, oldest first, and the repair attempts
+// from the stored attempt counts. Streams with a repair waiting for its scrub are not attempted
+// again until the scrub completes the record.
+func (l *streamRepairLog) load(records []*scrub.StreamRepairRecord, attempts map[StreamId]uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for streamId, count := range attempts {
+		l.attempts[streamId] += count
+	}
+	for _, record := range records {
+		if record.Status == RepairStatusRescrubbing {
+			l.rescrubbing[record.StreamId] = record
+		} else {
+			delete(l.rescrubbing, record.StreamId)
+		}
+	}
+
+	l.records = append(records, l.records...)
+	if len(l.records) > l.maxSize {
+		l.records = l.records[len(l.records)-l.maxSize:]
+	}
+}
+
+// canAttempt returns true when the stream is not waiting for a scrub after a previous repair
+// and has not used up its repair attempts.
+func (l *streamRepairLog) canAttempt(streamId StreamId, maxAttempts uint32) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	_, rescrubbing := l.rescrubbing[streamId]
+	return !rescrubbing && l.attempts[streamId] < maxAttempts
+}
+
+// onScrubReport completes the record of a repaired stream with the outcome of its scrub.
+// It returns a copy of the completed record, or nil if the report didn't complete a repair.
+func (l *streamRepairLog) onScrubReport(report *scrub.MiniblockScrubReport) *scrub.StreamRepairRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record, ok := l.rescrubbing[report.StreamId]
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case report.ScrubError != nil && report.FirstCorruptBlock != -1:
+		record.Status = RepairStatusRescrubFailed
+		record.Error = report.ScrubError.Error()
+	case report.ScrubError == nil && report.LatestBlockScrubbed >= record.ToExclusive-1:
+		record.Status = RepairStatusRepaired
+	default:
+		// Scrub didn't reach the repaired range yet, wait for the next report.
+		return nil
+	}
+
+	delete(l.rescrubbing, report.StreamId)
+	result := *record
+	return &result
+}
+
+// list returns copies of the audit trail records, oldest first.
+func (l *streamRepairLog) list() []scrub.StreamRepairRecord {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	records := make([]scrub.StreamRepairRecord, len(l.records))
+	for i, record := range l.records {
+		records[i] = *record
+	}
+	return records
+}
+
+// GetStreamRepairs returns the audit trail of the most recent stream repairs, oldest first.
+func (a *Archiver) GetStreamRepairs(context.Context) []scrub.StreamRepairRecord {
+	return a.repairs.list()
+}
+
+// runStreamRepairs periodically attempts to repair streams that failed a miniblock scrub
+// until the context expires or is cancelled.
+func (a *Archiver) runStreamRepairs(ctx context.Context) {
+	ticker := time.NewTicker(a.config.Repair.GetInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			a.repairCorruptStreams(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (a *Archiver) repairCorruptStreams(ctx context.Context) {
+	maxAttempts := a.config.Repair.GetMaxAttempts()
+
+	var candidates []*ArchiveStream
+	a.streams.Range(func(key, value any) bool {
+		as, ok := value.(*ArchiveStream)
+		if ok && as.corrupt.GetCorruptionReason() == ScrubFailed &&
+			a.repairs.canAttempt(as.streamId, maxAttempts) {
+			candidates = append(candidates, as)
+		}
+		return true
+	})
+
+	for _, as := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+		if _, err := a.RepairStream(ctx, as); err != nil && !IsRiverErrorCode(err, Err_UNAVAILABLE) {
+			logging.FromCtx(ctx).Warnw("Failed to repair corrupt stream", "streamId", as.streamId, "error", err)
+		}
+	}
+}
+
+// RepairStream replaces the corrupt miniblocks of a stream that failed a miniblock scrub with
+// miniblocks fetched from one of the stream's replicas. The fetched miniblocks must extend the
+// hash chain of the last well-formed miniblock in storage and pass the same validation as the
+// scrubber. After the miniblocks are replaced the stream is scheduled for a new scrub, the
+// scrub outcome completes the audit record in GetStreamRepairs.
+//
+// Err_UNAVAILABLE is returned without an audit record when the stream is being scrubbed.
+func (a *Archiver) RepairStream(ctx context.Context, stream *ArchiveStream) (*scrub.StreamRepairRecord, error) {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	// Claim the scrub slot so that the scrubber doesn't read the stream while it is rewritten.
+	if stream.scrubInProgress.Swap(true) {
+		return nil, RiverError(Err_UNAVAILABLE, "Stream scrub in progress", "streamId", stream.streamId).
+			Func("RepairStream")
+	}
+	defer stream.scrubInProgress.Store(false)
+
+	if stream.corrupt.GetCorruptionReason() != ScrubFailed {
+		return nil, RiverError(Err_FAILED_PRECONDITION, "Stream didn't fail a scrub", "streamId", stream.streamId).
+			Func("RepairStream")
+	}
+
+	record := &scrub.StreamRepairRecord{
+		StreamId:      stream.streamId,
+		FromInclusive: stream.corrupt.GetFirstCorruptBlock(),
+		ToExclusive:   stream.numBlocksInDb.Load(),
+		StartedAt:     time.Now(),
+	}
+	if scrubErr := stream.corrupt.GetScrubError(); scrubErr != nil {
+		record.CorruptionError = scrubErr.Error()
+	}
+
+	err := a.repairStreamLocked(ctx, stream, record)
+	record.FinishedAt = time.Now()
+	if err != nil {
+		record.Status = RepairStatusFailed
+		record.Error = err.Error()
+	} else {
+		record.Status = RepairStatusRescrubbing
+	}
+	a.storeRepair(ctx, record)
+	// The audit log completes the record once the stream is scrubbed, return a copy.
+	result := *record
+	a.repairs.add(record)
+	a.reportRepair(ctx, &result)
+
+	return &result, err
+}
+
+func (a *Archiver) repairStreamLocked(
+	ctx context.Context,
+	stream *ArchiveStream,
+	record *scrub.StreamRepairRecord,
+) error {
+	streamId := stream.streamId
+	from, to := record.FromInclusive, record.ToExclusive
+	if from < 0 || from >= to {
+		return RiverError(Err_FAILED_PRECONDITION, "No stored miniblocks to repair",
+			"streamId", streamId, "fromInclusive", from, "toExclusive", to).Func("RepairStream")
+	}
+
+	// The replacement must extend the last well-formed miniblock in storage.
+	opts := scrub.GenesisMiniblockOpts()
+	readFrom := max(from-1, 0)
+	stored, _, err := a.storage.ReadMiniblocks(ctx, streamId, readFrom, from+1, false)
+	if err != nil {
+		return AsRiverError(err).Func("RepairStream")
+	}
+	if len(stored) != int(from+1-readFrom) {
+		return RiverError(Err_MINIBLOCKS_NOT_FOUND, "Stored miniblocks not found",
+			"streamId", streamId, "fromInclusive", readFrom, "toExclusive", from+1).Func("RepairStream")
+	}
+	if from > 0 {
+		prev, err := events.NewMiniblockInfoFromDescriptor(stored[0])
+		if err != nil {
+			return AsRiverError(err, Err_BAD_BLOCK).Message("Last well-formed miniblock is invalid").
+				Func("RepairStream")
+		}
+		opts = scrub.OptsFromPrevMiniblock(streamId, prev)
+	}
+	corruptData := stored[len(stored)-1].Data
+
+	var (
+		miniblocks []*storage.MiniblockDescriptor
+		lastHash   common.Hash
+		source     common.Address
+		errs       []error
+	)
+	for _, node := range stream.nodes.GetQuorumNodes() {
+		mbs, hash, err := a.fetchVerifiedMiniblocks(ctx, streamId, node, from, to, opts)
+		if err == nil && bytes.Equal(mbs[0].Data, corruptData) {
+			err = RiverError(Err_BAD_BLOCK, "Replica has the same corrupt miniblock")
+		}
+		if err != nil {
+			errs = append(errs, AsRiverError(err).Tag("node", node))
+			continue
+		}
+		miniblocks, lastHash, source = mbs, hash, node
+		break
+	}
+	if miniblocks == nil {
+		if len(errs) == 0 {
+			return RiverError(Err_UNAVAILABLE, "Stream has no replicas", "streamId", streamId).
+				Func("RepairStream")
+		}
+		return AsRiverError(errors.Join(errs...), Err_UNAVAILABLE).
+			Message("No replica has a valid copy of the corrupt miniblocks").
+			Tag("streamId", streamId).
+			Func("RepairStream")
+	}
+
+	// The corrupt miniblocks are replaced in a single transaction, storage keeps the corrupt range
+	// if the write fails.
+	if err := a.storage.ReplaceArchiveMiniblocks(ctx, streamId, from, miniblocks); err != nil {
+		return AsRiverError(err).Func("RepairStream")
+	}
+	stream.numBlocksInDb.Store(to)
+	stream.corrupt.ResetScrubCorruption(from)
+	stream.mostRecentScrubbedBlock.Store(from - 1)
+
+	record.SourceNode = source
+	record.LastMiniblockHash = lastHash
+	return nil
+}
+
+// fetchVerifiedMiniblocks reads miniblocks [from, to) from the given node. Every miniblock is
+// validated with opts derived from its predecessor, starting with the given opts for the first.
+// It returns the miniblocks and the hash of the last miniblock.
+func (a *Archiver) fetchVerifiedMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	node common.Address,
+	from int64,
+	to int64,
+	opts *events.ParsedMiniblockInfoOpts,
+) ([]*storage.MiniblockDescriptor, common.Hash, error) {
+	stub, err := a.nodeRegistry.GetStreamServiceClientForAddress(node)
+	if err != nil {
+		return nil, common.Hash{}, err
+	}
+
+	var (
+		miniblocks []*storage.MiniblockDescriptor
+		lastHash   common.Hash
+	)
+	for from < to {
+		req := connect.NewRequest(&GetMiniblocksRequest{
+			StreamId:      streamId[:],
+			FromInclusive: from,
+			ToExclusive:   min(from+int64(a.config.GetReadMiniblocksSize()), to),
+		})
+		req.Header().Set(RiverNoForwardHeader, RiverHeaderTrueValue)
+		req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
+		resp, err := stub.GetMiniblocks(ctx, req)
+		if err != nil {
+			return nil, common.Hash{}, AsRiverError(err)
+		}
+		if len(resp.Msg.Miniblocks) == 0 {
+			return nil, common.Hash{}, RiverError(Err_MINIBLOCKS_NOT_FOUND, "Replica returned no miniblocks",
+				"fromInclusive", from, "toExclusive", to)
+		}
+
+		for i, mb := range resp.Msg.Miniblocks {
+			num := from + int64(i)
+			if num >= to {
+				break
+			}
+			info, err := events.NewMiniblockInfoFromProto(mb, resp.Msg.GetMiniblockSnapshot(num), opts)
+			if err != nil {
+				return nil, common.Hash{}, AsRiverError(err, Err_BAD_BLOCK).
+					Message("Replica miniblock failed validation").
+					Tag("miniblockNum", num)
+			}
+			storageMb, err := info.AsStorageMb()
+			if err != nil {
+				return nil, common.Hash{}, err
+			}
+			miniblocks = append(miniblocks, storageMb)
+			lastHash = info.Ref.Hash
+			opts = scrub.OptsFromPrevMiniblock(streamId, info)
+		}
+		from += int64(len(resp.Msg.Miniblocks))
+	}
+
+	return miniblocks, lastHash, nil
+}
+
+func (a *Archiver) onRepairScrubReport(ctx context.Context, report *scrub.MiniblockScrubReport) {
+	if record := a.repairs.onScrubReport(report); record != nil {
+		a.storeRepair(ctx, record)
+		a.reportRepair(ctx, record)
+	}
+}
+
+// loadStreamRepairs restores the audit log of stream repairs from storage.
+func (a *Archiver) loadStreamRepairs(ctx context.Context) error {
+	records, err := a.storage.ReadStreamRepairRecords(ctx, a.config.Repair.GetAuditLogSize())
+	if err != nil {
+		return AsRiverError(err).Func("loadStreamRepairs")
+	}
+	attempts, err := a.storage.ReadStreamRepairAttempts(ctx)
+	if err != nil {
+		return AsRiverError(err).Func("loadStreamRepairs")
+	}
+	a.repairs.load(records, attempts)
+	return nil
+}
+
+// storeRepair persists the audit record, the in-memory audit log is kept if storage fails.
+func (a *Archiver) storeRepair(ctx context.Context, record *scrub.StreamRepairRecord) {
+	if err := a.storage.WriteStreamRepairRecord(ctx, record); err != nil {
+		logging.FromCtx(ctx).Errorw("Failed to store stream repair record", "streamId", record.StreamId, "error", err)
+	}
+}
+
+// reportRepair logs the audit record and updates the repair metrics.
+func (a *Archiver) reportRepair(ctx context.Context, record *scrub.StreamRepairRecord) {
+	if a.streamRepairs != nil {
+		a.streamRepairs.With(prometheus.Labels{"status": record.Status}).Inc()
+	}
+
+	log := logging.FromCtx(ctx).Infow
+	if record.Status == RepairStatusFailed || record.Status == RepairStatusRescrubFailed {
+		log = logging.FromCtx(ctx).Warnw
+	}
+	log("Corrupt stream repair",
+		"streamId", record.StreamId,
+		"status", record.Status,
+		"fromInclusive", record.FromInclusive,
+		"toExclusive", record.ToExclusive,
+		"sourceNode", record.SourceNode,
+		"lastMiniblockHash", record.LastMiniblockHash,
+		"corruptionError", record.CorruptionError,
+		"error", record.Error,
+	)
+}
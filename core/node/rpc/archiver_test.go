@@ -23,6 +23,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
 	"github.com/towns-protocol/towns/core/node/registries"
 	"github.com/towns-protocol/towns/core/node/rpc/node2nodeauth"
+	"github.com/towns-protocol/towns/core/node/scrub"
 	. "github.com/towns-protocol/towns/core/node/shared"
 	"github.com/towns-protocol/towns/core/node/storage"
 	"github.com/towns-protocol/towns/core/node/testutils"
@@ -694,3 +695,196 @@ func TestCorruptionTracker(t *testing.T) {
 	ct.ReportBlockUpdateSuccess(ctx)
 	requireStreamScrubCorruption(require, &ct, 25, "scrub error block 25")
 }
+
+func TestStreamRepairLog(t *testing.T) {
+	require := require.New(t)
+
+	streamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	streamId2 := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	repairs := newStreamRepairLog(2)
+
+	require.True(repairs.canAttempt(streamId, 2))
+	repairs.add(&scrub.StreamRepairRecord{StreamId: streamId, Status: RepairStatusFailed})
+	require.True(repairs.canAttempt(streamId, 2))
+
+	// Streams waiting for a scrub after a repair are not attempted again.
+	repairs.add(&scrub.StreamRepairRecord{
+		StreamId:      streamId2,
+		FromInclusive: 3,
+		ToExclusive:   10,
+		Status:        RepairStatusRescrubbing,
+	})
+	require.False(repairs.canAttempt(streamId2, 2))
+
+	// Reports of other streams and scrubs that don't cover the repaired range are ignored.
+	require.Nil(repairs.onScrubReport(&scrub.MiniblockScrubReport{StreamId: streamId, LatestBlockScrubbed: 10}))
+	require.Nil(repairs.onScrubReport(&scrub.MiniblockScrubReport{
+		StreamId:            streamId2,
+		LatestBlockScrubbed: 5,
+		FirstCorruptBlock:   -1,
+		ScrubError:          fmt.Errorf("db error"),
+	}))
+
+	record := repairs.onScrubReport(&scrub.MiniblockScrubReport{
+		StreamId:            streamId2,
+		LatestBlockScrubbed: 9,
+		FirstCorruptBlock:   -1,
+	})
+	require.NotNil(record)
+	require.Equal(RepairStatusRepaired, record.Status)
+	require.True(repairs.canAttempt(streamId2, 2))
+
+	// A second failed attempt uses up the attempts of the first stream, the oldest record is dropped.
+	repairs.add(&scrub.StreamRepairRecord{StreamId: streamId, Status: RepairStatusRescrubbing, ToExclusive: 4})
+	record = repairs.onScrubReport(&scrub.MiniblockScrubReport{
+		StreamId:            streamId,
+		LatestBlockScrubbed: 1,
+		FirstCorruptBlock:   2,
+		ScrubError:          fmt.Errorf("bad block"),
+	})
+	require.NotNil(record)
+	require.Equal(RepairStatusRescrubFailed, record.Status)
+	require.False(repairs.canAttempt(streamId, 2))
+
+	records := repairs.list()
+	require.Len(records, 2)
+	require.Equal(streamId2, records[0].StreamId)
+	require.Equal(RepairStatusRepaired, records[0].Status)
+	require.Equal(streamId, records[1].StreamId)
+	require.Equal(RepairStatusRescrubFailed, records[1].Status)
+}
+
+func TestStreamRepairLogLoad(t *testing.T) {
+	require := require.New(t)
+
+	streamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	streamId2 := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	repairs := newStreamRepairLog(2)
+
+	repairs.load([]*scrub.StreamRepairRecord{
+		{Id: 1, StreamId: streamId, Status: RepairStatusRescrubbing, ToExclusive: 4},
+		{Id: 2, StreamId: streamId2, Status: RepairStatusRescrubbing, ToExclusive: 4},
+		{Id: 3, StreamId: streamId2, Status: RepairStatusFailed},
+	}, map[StreamId]uint32{streamId: 1, streamId2: 2})
+
+	// Only the most recent records are kept, stored attempts count after a restart.
+	records := repairs.list()
+	require.Len(records, 2)
+	require.Equal(int64(2), records[0].Id)
+	require.Equal(int64(3), records[1].Id)
+	require.False(repairs.canAttempt(streamId2, 2))
+	require.True(repairs.canAttempt(streamId2, 3))
+
+	// A stored repair waiting for its scrub is completed by the scrub report.
+	require.False(repairs.canAttempt(streamId, 1))
+	record := repairs.onScrubReport(&scrub.MiniblockScrubReport{
+		StreamId:            streamId,
+		LatestBlockScrubbed: 3,
+		FirstCorruptBlock:   -1,
+	})
+	require.NotNil(record)
+	require.Equal(int64(1), record.Id)
+	require.Equal(RepairStatusRepaired, record.Status)
+	require.False(repairs.canAttempt(streamId, 1))
+	require.True(repairs.canAttempt(streamId, 2))
+}
+
+func TestCorruptionTrackerResetScrubCorruption(t *testing.T) {
+	require := require.New(t)
+
+	stream := NewArchiveStream(testutils.FakeStreamId(STREAM_SPACE_BIN), []common.Address{}, 0, 50)
+	stream.numBlocksInDb.Store(10)
+	ct := NewStreamCorruptionTracker(50)
+	ct.SetParent(stream)
+
+	require.NoError(ct.ReportScrubSuccess(context.Background(), 3))
+	require.NoError(ct.MarkBlockCorrupt(4, fmt.Errorf("scrub error block 4")))
+	requireStreamScrubCorruption(require, &ct, 4, "scrub error block 4")
+
+	ct.ResetScrubCorruption(4)
+	requireStreamNotCorrupt(require, &ct)
+	require.Equal(int64(3), ct.GetLatestScrubbedBlock())
+	require.Nil(ct.GetScrubError())
+
+	// The repaired blocks can be scrubbed again.
+	require.NoError(ct.ReportScrubSuccess(context.Background(), 9))
+	require.Equal(int64(9), ct.GetLatestScrubbedBlock())
+}
+
+func TestArchiveRepairsCorruptStream(t *testing.T) {
+	tester := newServiceTester(t, serviceTesterOpts{numNodes: 1, start: true})
+	ctx := tester.ctx
+	require := tester.require
+
+	client := tester.testClient(0)
+	wallet, err := crypto.NewWallet(ctx)
+	require.NoError(err)
+	streamId, _, mbRef, err := createUserSettingsStream(
+		ctx,
+		wallet,
+		client,
+		&StreamSettings{DisableMiniblockCreation: true},
+	)
+	require.NoError(err)
+	lastMB, err := fillUserSettingsStreamWithData(ctx, streamId, wallet, client, 5, 3, mbRef)
+	require.NoError(err)
+
+	archiveCfg := tester.getConfig()
+	archiveCfg.Archive.ArchiveId = "arch" + GenShortNanoid()
+	archiveCfg.Archive.Repair.Enabled = true
+	archiveCfg.Archive.Repair.Interval = 100 * time.Millisecond
+
+	serverCtx, serverCancel := context.WithCancel(ctx)
+	defer serverCancel()
+
+	arch, err := StartServerInArchiveMode(serverCtx, archiveCfg, makeTestServerOpts(tester), false)
+	require.NoError(err)
+	tester.t.Cleanup(arch.Close)
+	arch.Archiver.WaitForStart()
+	require.Len(arch.ExitSignal(), 0)
+
+	require.EventuallyWithT(
+		func(c *assert.CollectT) {
+			num, err := arch.Storage().GetMaxArchivedMiniblockNumber(ctx, streamId)
+			assert.NoError(c, err)
+			assert.Equal(c, lastMB.Num, num)
+		},
+		15*time.Second,
+		10*time.Millisecond,
+	)
+	requireNoCorruptStreams(ctx, require, arch.Archiver)
+
+	// Corrupt miniblock 2 in archive storage and let the scrubber find it.
+	store := arch.Storage()
+	blocks, _, err := store.ReadMiniblocks(ctx, streamId, 2, lastMB.Num+1, false)
+	require.NoError(err)
+	require.NoError(store.DebugDeleteMiniblocks(ctx, streamId, 2, lastMB.Num+1))
+	blocks[0].Data = invalidateEventHash(require, wallet, blocks[0].Data)
+	require.NoError(store.WriteArchiveMiniblocks(ctx, streamId, 2, blocks))
+
+	value, ok := arch.Archiver.streams.Load(streamId)
+	require.True(ok)
+	as := value.(*ArchiveStream)
+	as.corrupt.mu.Lock()
+	as.corrupt.latestScrubbedBlock = 1
+	as.corrupt.mu.Unlock()
+	as.mostRecentScrubbedBlock.Store(1)
+
+	require.EventuallyWithT(
+		func(c *assert.CollectT) {
+			repairs := arch.Archiver.GetStreamRepairs(ctx)
+			if assert.Len(c, repairs, 1) {
+				assert.Equal(c, streamId, repairs[0].StreamId)
+				assert.Equal(c, int64(2), repairs[0].FromInclusive)
+				assert.Equal(c, lastMB.Num+1, repairs[0].ToExclusive)
+				assert.Equal(c, lastMB.Hash, repairs[0].LastMiniblockHash)
+				assert.Equal(c, RepairStatusRepaired, repairs[0].Status)
+			}
+		},
+		20*time.Second,
+		100*time.Millisecond,
+	)
+
+	require.Empty(arch.Archiver.GetCorruptStreams(ctx))
+	require.NoError(compareStreamMiniblocks(t, ctx, streamId, store, client))
+}
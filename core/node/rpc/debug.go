@@ -16,6 +16,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
@@ -110,6 +112,9 @@ func (s *Service) registerDebugHandlersOnMux(mux httpMux, enableDebugEndpoints b
 	if s.mode == ServerModeArchive && (cfg.CorruptStreams || enableDebugEndpoints) {
 		handler.Handle(mux, "/debug/corrupt_streams", &corruptStreamsHandler{service: s.Archiver})
 	}
+
+	// Registered last so that the console links all other enabled pages.
+	s.registerConsoleHandlers(&handler, mux, enableDebugEndpoints, cfg)
 }
 
 func (s *Service) registerDebugHandlersOnPrivateAddress(cfg config.DebugEndpointsConfig) {
@@ -251,6 +256,27 @@ func (h *corruptStreamsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		},
 	)
 
+	// Most recent repairs first
+	repairs := h.service.GetStreamRepairs(ctx)
+	reply.Repairs = make([]render.DebugStreamRepairRecord, 0, len(repairs))
+	for _, repair := range slices.Backward(repairs) {
+		record := render.DebugStreamRepairRecord{
+			StreamId:        repair.StreamId.String(),
+			FromInclusive:   repair.FromInclusive,
+			ToExclusive:     repair.ToExclusive,
+			CorruptionError: repair.CorruptionError,
+			Status:          repair.Status,
+			Error:           repair.Error,
+			StartedAt:       repair.StartedAt.UTC().Format(time.RFC3339),
+			Duration:        repair.FinishedAt.Sub(repair.StartedAt).String(),
+		}
+		if repair.SourceNode != (common.Address{}) {
+			record.SourceNode = repair.SourceNode.Hex()
+			record.LastMiniblockHash = repair.LastMiniblockHash.Hex()
+		}
+		reply.Repairs = append(reply.Repairs, record)
+	}
+
 	output, err := render.Execute(&reply)
 	if err != nil {
 		logging.FromCtx(ctx).Errorw("unable to render stack data", "error", err)
@@ -0,0 +1,321 @@
+package rpc
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rpc/render"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/river_node/version"
+)
+
+// consoleMiniblocksPageSize is the number of miniblocks on a stream browser page.
+const consoleMiniblocksPageSize = 20
+
+// consoleHandler serves the read-only operator console. Sections of the console follow
+// the debug endpoint toggles.
+type consoleHandler struct {
+	service *Service
+	cfg     config.DebugEndpointsConfig
+	// enableAll is set when all debug endpoints are enabled.
+	enableAll bool
+	// pages are the other debug pages registered on the same mux.
+	pages []string
+}
+
+func (s *Service) registerConsoleHandlers(
+	handler *debugHandler,
+	mux httpMux,
+	enableDebugEndpoints bool,
+	cfg config.DebugEndpointsConfig,
+) {
+	if !cfg.Console && !enableDebugEndpoints {
+		return
+	}
+	if cfg.ConsoleToken == "" {
+		s.defaultLogger.Warnw("Operator console is enabled but not served because no console token is set")
+		return
+	}
+
+	console := &consoleHandler{
+		service:   s,
+		cfg:       cfg,
+		enableAll: enableDebugEndpoints,
+		pages:     append([]string(nil), handler.patterns...),
+	}
+	handler.Handle(mux, "/debug/console", consoleAuth(cfg.ConsoleToken, http.HandlerFunc(console.serveOverview)))
+	if console.streamLookupEnabled() {
+		mux.Handle("/debug/console/stream", consoleAuth(cfg.ConsoleToken, http.HandlerFunc(console.serveStream)))
+	}
+}
+
+// consoleAuth only passes requests that carry the console token, either as bearer token or as
+// basic auth password. Browsers are asked for basic auth credentials.
+func consoleAuth(token string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var provided string
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			provided = strings.TrimPrefix(auth, "Bearer ")
+		} else if _, password, ok := r.BasicAuth(); ok {
+			provided = password
+		}
+
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", `Basic realm="river operator console"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		w.Header().Set("Cache-Control", "no-store")
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *consoleHandler) streamLookupEnabled() bool {
+	return (h.cfg.Stream || h.enableAll) && h.service.storage != nil
+}
+
+func (h *consoleHandler) serveOverview(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx   = r.Context()
+		s     = h.service
+		reply = render.ConsoleOverviewData{
+			Mode:         s.mode,
+			Version:      version.GetFullVersion(),
+			StartTime:    s.startTime.UTC().Format(time.RFC3339),
+			Uptime:       time.Since(s.startTime).Truncate(time.Second).String(),
+			Pages:        h.pages,
+			StreamLookup: h.streamLookupEnabled(),
+			HighUsage:    s.getHighUsageInfo(),
+		}
+	)
+
+	if s.wallet != nil {
+		reply.Address = s.wallet.Address.Hex()
+	}
+
+	if s.syncv3Svc != nil {
+		stats := s.syncv3Svc.Stats()
+		reply.Sync = &render.ConsoleSyncData{
+			Sessions:               stats.Sessions,
+			PendingMessages:        stats.PendingMessages,
+			LargestPendingMessages: stats.LargestPendingMessages,
+			PendingBytes:           stats.PendingBytes,
+		}
+	}
+
+	if (h.cfg.TxPool || h.enableAll) && s.riverChain != nil {
+		pool := s.riverChain.TxPool
+		reply.TxPool = &render.TransactionPoolData{}
+		reply.TxPool.River.ProcessedTransactions = pool.ProcessedTransactionsCount()
+		reply.TxPool.River.PendingTransactions = pool.PendingTransactionsCount()
+		reply.TxPool.River.ReplacementTransactionsCount = pool.ReplacementTransactionsCount()
+		if reply.TxPool.River.ReplacementTransactionsCount > 0 {
+			reply.TxPool.River.LastReplacementTransaction = time.Unix(pool.LastReplacementTransactionUnix(), 0).
+				Format(time.RFC3339)
+		}
+	}
+
+	if s.mode == ServerModeArchive && s.Archiver != nil && (h.cfg.CorruptStreams || h.enableAll) {
+		stats := s.Archiver.GetStats()
+		reply.Archiver = &render.ConsoleArchiverData{
+			StreamsExamined:        stats.StreamsExamined,
+			StreamsUpToDate:        stats.StreamsUpToDate,
+			MiniblocksProcessed:    stats.MiniblocksProcessed,
+			SuccessOpsCount:        stats.SuccessOpsCount,
+			FailedOpsCount:         stats.FailedOpsCount,
+			StreamScrubsInProgress: stats.StreamScrubsInProgress,
+			TasksQueued:            stats.TasksQueued,
+			CorruptStreams:         len(s.Archiver.GetCorruptStreams(ctx)),
+			StreamRepairs:          len(s.Archiver.GetStreamRepairs(ctx)),
+		}
+	}
+
+	writeConsolePage(w, r, &reply)
+}
+
+func (h *consoleHandler) serveStream(w http.ResponseWriter, r *http.Request) {
+	var (
+		ctx   = r.Context()
+		query = r.URL.Query()
+		reply = render.ConsoleStreamData{
+			Query:    strings.TrimSpace(query.Get("id")),
+			PrevFrom: -1,
+			NextFrom: -1,
+		}
+	)
+
+	if reply.Query != "" {
+		if err := h.loadStream(r, &reply); err != nil {
+			logging.FromCtx(ctx).Infow("Console stream lookup failed", "query", reply.Query, "error", err)
+			reply.Error = err.Error()
+		}
+	}
+
+	writeConsolePage(w, r, &reply)
+}
+
+// loadStream fills reply with the stream's storage state and either a single miniblock
+// with its events (mb parameter) or a page of miniblocks (from parameter).
+func (h *consoleHandler) loadStream(r *http.Request, reply *render.ConsoleStreamData) error {
+	var (
+		ctx   = r.Context()
+		store = h.service.storage
+		query = r.URL.Query()
+	)
+
+	streamId, err := shared.StreamIdFromString(strings.TrimPrefix(reply.Query, "0x"))
+	if err != nil {
+		return base.AsRiverError(err, protocol.Err_INVALID_ARGUMENT).Message("Invalid stream id")
+	}
+	reply.StreamId = streamId.String()
+
+	if h.service.cache != nil {
+		if stream, err := h.service.cache.GetStreamNoWait(ctx, streamId); err == nil {
+			reply.Nodes = formatAddresses(stream.GetQuorumNodes())
+		}
+	}
+
+	ranges, err := store.GetMiniblockNumberRanges(ctx, streamId)
+	if err != nil {
+		return err
+	}
+	if len(ranges) == 0 {
+		return base.RiverError(protocol.Err_NOT_FOUND, "Stream has no stored miniblocks")
+	}
+	rangeStrs := make([]string, len(ranges))
+	for i, rng := range ranges {
+		rangeStrs[i] = fmt.Sprintf("%d-%d", rng.StartInclusive, rng.EndInclusive)
+	}
+	reply.MiniblockRanges = strings.Join(rangeStrs, ", ")
+	first, last := ranges[0].StartInclusive, ranges[len(ranges)-1].EndInclusive
+	reply.LastMiniblockNum = last
+
+	if mbStr := query.Get("mb"); mbStr != "" {
+		num, err := strconv.ParseInt(mbStr, 10, 64)
+		if err != nil {
+			return base.AsRiverError(err, protocol.Err_INVALID_ARGUMENT).Message("Invalid miniblock number")
+		}
+		mbs, _, err := store.ReadMiniblocks(ctx, streamId, num, num+1, true)
+		if err != nil {
+			return err
+		}
+		if len(mbs) == 0 {
+			return base.RiverError(protocol.Err_MINIBLOCKS_NOT_FOUND, "Miniblock not found", "num", num)
+		}
+		mb, info := consoleMiniblock(mbs[0])
+		reply.Miniblock = &mb
+		if info != nil {
+			for _, event := range info.Events() {
+				reply.Events = append(reply.Events, render.ConsoleEvent{
+					Hash:      event.Hash.Hex(),
+					Creator:   common.BytesToAddress(event.Event.CreatorAddress).Hex(),
+					CreatedAt: time.UnixMilli(event.Event.CreatedAtEpochMs).UTC().Format(time.RFC3339),
+					Payload:   eventPayloadType(event.Event),
+				})
+			}
+		}
+		return nil
+	}
+
+	from := max(first, last-consoleMiniblocksPageSize+1)
+	if fromStr := query.Get("from"); fromStr != "" {
+		if from, err = strconv.ParseInt(fromStr, 10, 64); err != nil {
+			return base.AsRiverError(err, protocol.Err_INVALID_ARGUMENT).Message("Invalid miniblock number")
+		}
+		from = min(max(from, first), last)
+	}
+	to := min(from+consoleMiniblocksPageSize, last+1)
+
+	mbs, _, err := store.ReadMiniblocks(ctx, streamId, from, to, true)
+	if err != nil {
+		return err
+	}
+	for _, descriptor := range mbs {
+		mb, _ := consoleMiniblock(descriptor)
+		reply.Miniblocks = append(reply.Miniblocks, mb)
+	}
+	if from > first {
+		reply.PrevFrom = max(first, from-consoleMiniblocksPageSize)
+	}
+	if to <= last {
+		reply.NextFrom = to
+	}
+	return nil
+}
+
+// consoleMiniblock converts the given miniblock for rendering. The parsed miniblock is nil
+// when the miniblock can't be parsed, the parse error is part of the result.
+func consoleMiniblock(descriptor *storage.MiniblockDescriptor) (render.ConsoleMiniblock, *MiniblockInfo) {
+	mb := render.ConsoleMiniblock{Num: descriptor.Number}
+	info, err := NewMiniblockInfoFromDescriptor(descriptor)
+	if err != nil {
+		mb.Error = err.Error()
+		return mb, nil
+	}
+
+	header := info.Header()
+	mb.Hash = info.Ref.Hash.Hex()
+	mb.Timestamp = header.GetTimestamp().AsTime().UTC().Format(time.RFC3339Nano)
+	mb.EventNumOffset = header.GetEventNumOffset()
+	mb.Events = len(info.Events())
+	mb.Snapshot = header.GetSnapshot() != nil || len(header.GetSnapshotHash()) > 0
+	return mb, info
+}
+
+// eventPayloadType returns the payload and content type of the event, e.g. member_payload.membership.
+func eventPayloadType(event *protocol.StreamEvent) string {
+	msg := event.ProtoReflect()
+	payload := msg.Descriptor().Oneofs().ByName("payload")
+	if payload == nil {
+		return ""
+	}
+	field := msg.WhichOneof(payload)
+	if field == nil {
+		return ""
+	}
+
+	name := string(field.Name())
+	if field.Message() != nil {
+		inner := msg.Get(field).Message()
+		if content := inner.Descriptor().Oneofs().ByName("content"); content != nil {
+			if contentField := inner.WhichOneof(content); contentField != nil {
+				name += "." + string(contentField.Name())
+			}
+		}
+	}
+	return name
+}
+
+func formatAddresses(addrs []common.Address) string {
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.Hex()
+	}
+	return strings.Join(strs, ",")
+}
+
+func writeConsolePage[RD render.RenderableData](w http.ResponseWriter, r *http.Request, data RD) {
+	output, err := render.Execute(data)
+	if err != nil {
+		logging.FromCtx(r.Context()).Errorw("unable to render operator console", "error", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(output.Bytes())
+}
@@ -0,0 +1,51 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+func TestConsoleAuth(t *testing.T) {
+	handler := consoleAuth("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for name, tc := range map[string]struct {
+		setup  func(r *http.Request)
+		status int
+	}{
+		"no credentials": {func(r *http.Request) {}, http.StatusUnauthorized},
+		"bearer":         {func(r *http.Request) { r.Header.Set("Authorization", "Bearer secret") }, http.StatusOK},
+		"wrong bearer":   {func(r *http.Request) { r.Header.Set("Authorization", "Bearer nope") }, http.StatusUnauthorized},
+		"basic":          {func(r *http.Request) { r.SetBasicAuth("operator", "secret") }, http.StatusOK},
+		"wrong basic":    {func(r *http.Request) { r.SetBasicAuth("operator", "nope") }, http.StatusUnauthorized},
+	} {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/debug/console", nil)
+			tc.setup(req)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			require.Equal(t, tc.status, rec.Code)
+			if tc.status == http.StatusUnauthorized {
+				require.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestEventPayloadType(t *testing.T) {
+	event := &StreamEvent{
+		Payload: &StreamEvent_MemberPayload{
+			MemberPayload: &MemberPayload{
+				Content: &MemberPayload_Membership_{Membership: &MemberPayload_Membership{}},
+			},
+		},
+	}
+	require.Equal(t, "member_payload.membership", eventPayloadType(event))
+	require.Empty(t, eventPayloadType(&StreamEvent{}))
+}
@@ -34,6 +34,9 @@ func copyRequestForForwarding[T any](s *Service, req *connect.Request[T]) *conne
 	if allowNoQuorum(req) {
 		newReq.Header().Set(RiverAllowNoQuorumHeader, RiverHeaderTrueValue)
 	}
+	if fullMiniblocks(s, req) {
+		newReq.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
+	}
 	// Forward test bypass header if present so downstream node can honor it.
 	if v := req.Header().Get(RiverTestBypassHeaderName); v != "" {
 		newReq.Header().Set(RiverTestBypassHeaderName, v)
@@ -45,6 +48,17 @@ func allowNoQuorum[T any](req *connect.Request[T]) bool {
 	return req.Header().Get(RiverAllowNoQuorumHeader) == RiverHeaderTrueValue
 }
 
+// fullMiniblocks returns true if the caller needs miniblocks with all events, including expired messages.
+// Nodes and node services set it since they verify miniblocks against their headers, the header is only honored
+// when it carries a token signed by a node or a node service.
+func fullMiniblocks[T any](s *Service, req *connect.Request[T]) bool {
+	token := req.Header().Get(RiverFullMiniblocksHeader)
+	if token == "" || s.fullMiniblocksVerifier == nil {
+		return false
+	}
+	return s.fullMiniblocksVerifier.Verify(token, time.Now())
+}
+
 func (s *Service) asAnnotatedRiverError(err error) *RiverErrorImpl {
 	return AsRiverError(err).
 		Tag("nodeAddress", s.wallet.Address).
@@ -165,7 +179,7 @@ func (s *Service) getStreamImpl(
 		nodeAddress := common.BytesToAddress(req.Msg.SyncCookie.GetNodeAddress())
 		if nodeAddress == s.wallet.Address {
 			if view != nil {
-				return s.localGetStream(ctx, view, req.Msg.SyncCookie, req.Msg.NumberOfPrecedingMiniblocks)
+				return s.localGetStream(ctx, req, view)
 			} else {
 				return nil, RiverError(Err_BAD_SYNC_COOKIE, "Stream not found").
 					Func("service.getStreamImpl").
@@ -188,7 +202,7 @@ func (s *Service) getStreamImpl(
 	}
 
 	if view != nil {
-		if resp, err := s.localGetStream(ctx, view, req.Msg.SyncCookie, req.Msg.NumberOfPrecedingMiniblocks); err == nil {
+		if resp, err := s.localGetStream(ctx, req, view); err == nil {
 			return resp, nil
 		} else if IsOperationRetriableOnRemotes(err) {
 			logging.FromCtx(ctx).Errorw("Failed to get stream from local node, falling back to remotes",
@@ -233,7 +247,7 @@ func (s *Service) getStreamExImpl(
 
 	allowNoQuorum := allowNoQuorum(req)
 	if !allowNoQuorum && nodes.IsLocalInQuorum() || allowNoQuorum && nodes.IsLocal() {
-		if err = s.localGetStreamEx(ctx, req, resp); err == nil {
+		if err = s.localGetStreamEx(ctx, req, resp, nodes); err == nil {
 			return nil
 		} else if IsOperationRetriableOnRemotes(err) {
 			logging.FromCtx(ctx).Errorw("Failed to stream the stream from local node, falling back to remotes",
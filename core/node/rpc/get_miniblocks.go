@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -38,6 +39,21 @@ func (s *Service) localGetMiniblocks(
 		}
 	}
 
+	// Exclude expired messages from DM and GDM streams. Nodes and node services verify miniblocks
+	// against their headers and need all events.
+	if !fullMiniblocks(s, req) {
+		expiration, err := stream.MessageExpiration(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if expiration > 0 {
+			now := time.Now()
+			for i := range mbsInfo {
+				mbsInfo[i] = RemoveExpiredMessages(mbsInfo[i], expiration, now)
+			}
+		}
+	}
+
 	miniblocks := make([]*Miniblock, len(mbsInfo))
 	snapshots := make(map[int64]*Envelope)
 	for i, info := range mbsInfo {
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 
@@ -11,20 +12,26 @@ import (
 
 func (s *Service) localGetStream(
 	ctx context.Context,
+	req *connect.Request[GetStreamRequest],
 	streamView *StreamView,
-	syncCookie *SyncCookie,
-	numPrecedingMiniblocks int64,
 ) (*connect.Response[GetStreamResponse], error) {
 	var stream *StreamAndCookie
 	var err error
-	if syncCookie != nil {
-		stream, err = streamView.GetStreamSince(ctx, s.wallet.Address, syncCookie)
+	if req.Msg.SyncCookie != nil {
+		stream, err = streamView.GetStreamSince(ctx, s.wallet.Address, req.Msg.SyncCookie)
 	} else {
 		// Use the new method that properly handles preceding miniblocks
-		stream = streamView.GetResetStreamAndCookieWithPrecedingMiniblocks(s.wallet.Address, numPrecedingMiniblocks)
+		stream = streamView.GetResetStreamAndCookieWithPrecedingMiniblocks(
+			s.wallet.Address,
+			req.Msg.NumberOfPrecedingMiniblocks,
+		)
 	}
 	if err != nil {
 		return nil, err
 	}
+	// Nodes and node services verify miniblocks against their headers and need all events.
+	if !fullMiniblocks(s, req) {
+		stream = streamView.RemoveExpiredMessages(stream, time.Now())
+	}
 	return connect.NewResponse(&GetStreamResponse{Stream: stream}), nil
 }
@@ -2,27 +2,39 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/proto"
 
 	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
 )
 
 // localGetStreamEx is the local implementation of GetStreamEx and writes all the
-// stream miniblocks to the given resp.
+// stream miniblocks to the given resp. Expired DM and GDM messages are removed unless
+// the caller needs full miniblocks.
 func (s *Service) localGetStreamEx(
 	ctx context.Context,
 	req *connect.Request[GetStreamExRequest],
 	resp *connect.ServerStream[GetStreamExResponse],
+	stream *events.Stream,
 ) (err error) {
 	streamId, err := shared.StreamIdFromBytes(req.Msg.StreamId)
 	if err != nil {
 		return err
 	}
 
+	var expiration time.Duration
+	if !fullMiniblocks(s, req) {
+		if expiration, err = stream.MessageExpiration(ctx); err != nil {
+			return err
+		}
+	}
+	now := time.Now()
+
 	lastMiniblockNum, err := s.storage.GetLastMiniblockNumber(ctx, streamId)
 	if err != nil {
 		return err
@@ -39,8 +51,14 @@ func (s *Service) localGetStreamEx(
 		}
 
 		for _, miniblockDescriptor := range miniblockDescriptors {
-			var mb Miniblock
-			if err = proto.Unmarshal(miniblockDescriptor.Data, &mb); err != nil {
+			mb := &Miniblock{}
+			if expiration > 0 {
+				info, err := events.NewMiniblockInfoFromDescriptor(miniblockDescriptor)
+				if err != nil {
+					return err
+				}
+				mb = events.RemoveExpiredMessages(info, expiration, now).Proto
+			} else if err = proto.Unmarshal(miniblockDescriptor.Data, mb); err != nil {
 				return WrapRiverError(Err_BAD_BLOCK, err).Message("Unable to unmarshal miniblock")
 			}
 
@@ -53,7 +71,7 @@ func (s *Service) localGetStreamEx(
 			}
 
 			if err := resp.Send(&GetStreamExResponse{
-				Data:     &GetStreamExResponse_Miniblock{Miniblock: &mb},
+				Data:     &GetStreamExResponse_Miniblock{Miniblock: mb},
 				Snapshot: snapshot,
 			}); err != nil {
 				return err
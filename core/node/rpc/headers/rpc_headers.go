@@ -8,5 +8,6 @@ const (
 	RiverAllowNoQuorumHeader     = "X-River-Allow-No-Quorum" // Must be set to "true" to allow getting data if local node is not in quorum
 	RiverUseSharedSyncHeaderName = "X-Use-Shared-Sync"
 	RiverTestBypassHeaderName    = "X-River-Test-Bypass"
-	RiverClientVersionHeader     = "X-River-Client-Version" // Client SDK version header
+	RiverClientVersionHeader     = "X-River-Client-Version"  // Client SDK version header
+	RiverFullMiniblocksHeader    = "X-River-Full-Miniblocks" // Signed token of a node or node service to get expired DM/GDM messages
 )
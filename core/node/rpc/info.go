@@ -7,13 +7,16 @@ import (
 	"math"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/logging"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	. "github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
@@ -62,6 +65,8 @@ func (s *Service) info(
 			return s.debugDropStream(ctx, request)
 		} else if debug == "trim_stream" {
 			return s.debugTrimStream(ctx, log, request)
+		} else if debug == "snapshot_at" {
+			return s.debugSnapshotAt(ctx, request)
 		}
 
 		if s.config.EnableTestAPIs {
@@ -252,6 +257,111 @@ func (s *Service) debugForceTrimStream(
 	return connect.NewResponse(&InfoResponse{}), nil
 }
 
+// maxSnapshotAtMiniblocks is the maximum number of miniblocks a snapshot_at request reads. It bounds the work of
+// requests for streams with few snapshots or with snapshots that are nullified by history trimming.
+const maxSnapshotAtMiniblocks = 1000
+
+// debugSnapshotAt reconstructs the snapshot of a stream as of a miniblock by replaying the events since the
+// nearest earlier snapshot. Arguments are the stream id, the miniblock number and optionally a second miniblock
+// number to diff the snapshots at both miniblocks, or "verify" to compare the snapshot that is stored in the
+// miniblock with the replayed snapshot. The request fails if it reads more than maxSnapshotAtMiniblocks.
+func (s *Service) debugSnapshotAt(
+	ctx context.Context,
+	request *connect.Request[InfoRequest],
+) (*connect.Response[InfoResponse], error) {
+	if len(request.Msg.Debug) < 3 || len(request.Msg.Debug) > 4 {
+		return nil, RiverError(Err_DEBUG_ERROR, "snapshot_at requires a stream id and miniblock number")
+	}
+	streamID, err := shared.StreamIdFromString(request.Msg.Debug[1])
+	if err != nil {
+		return nil, err
+	}
+	miniblockNum, err := strconv.ParseInt(request.Msg.Debug[2], 10, 64)
+	if err != nil {
+		return nil, RiverError(Err_DEBUG_ERROR, "invalid miniblock number")
+	}
+
+	stream, err := s.cache.GetStreamNoWait(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+	if !stream.IsLocal() {
+		return utils.PeerNodeRequestWithRetries(
+			ctx,
+			stream,
+			func(ctx context.Context, stub StreamServiceClient, _ common.Address) (*connect.Response[InfoResponse], error) {
+				return stub.Info(ctx, request)
+			},
+			s.config.Network.NumRetries,
+			s.nodeRegistry,
+		)
+	}
+
+	remaining := int64(maxSnapshotAtMiniblocks)
+	read := func(ctx context.Context, fromInclusive int64, toExclusive int64) ([]*events.MiniblockInfo, error) {
+		if remaining <= 0 {
+			return nil, RiverError(Err_RESOURCE_EXHAUSTED, "snapshot_at reads too many miniblocks").
+				Tags("streamId", streamID, "maxMiniblocks", maxSnapshotAtMiniblocks)
+		}
+		toExclusive = min(toExclusive, fromInclusive+remaining)
+		descriptors, _, err := s.storage.ReadMiniblocks(ctx, streamID, fromInclusive, toExclusive, false)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= int64(len(descriptors))
+		miniblocks := make([]*events.MiniblockInfo, len(descriptors))
+		for i, desc := range descriptors {
+			if miniblocks[i], err = events.NewMiniblockInfoFromDescriptor(desc); err != nil {
+				return nil, err
+			}
+		}
+		return miniblocks, nil
+	}
+
+	var (
+		replay  *events.SnapshotReplay
+		changes []events.SnapshotFieldChange
+	)
+	switch {
+	case len(request.Msg.Debug) == 4 && request.Msg.Debug[3] == "verify":
+		if replay, changes, err = events.VerifySnapshotAt(ctx, read, miniblockNum); err != nil {
+			return nil, err
+		}
+	case len(request.Msg.Debug) == 4:
+		otherNum, err := strconv.ParseInt(request.Msg.Debug[3], 10, 64)
+		if err != nil {
+			return nil, RiverError(Err_DEBUG_ERROR, "invalid miniblock number")
+		}
+		from, err := events.ReplaySnapshotAt(ctx, read, miniblockNum)
+		if err != nil {
+			return nil, err
+		}
+		if replay, err = events.ReplaySnapshotAt(ctx, read, otherNum); err != nil {
+			return nil, err
+		}
+		if changes, err = events.DiffSnapshots(from.Snapshot, replay.Snapshot); err != nil {
+			return nil, err
+		}
+	default:
+		if replay, err = events.ReplaySnapshotAt(ctx, read, miniblockNum); err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(&InfoResponse{
+			Graffiti: protojson.Format(replay.Snapshot),
+			Version:  strconv.FormatInt(replay.BaseMiniblockNum, 10),
+		}), nil
+	}
+
+	lines := make([]string, len(changes))
+	for i, change := range changes {
+		lines[i] = change.String()
+	}
+	return connect.NewResponse(&InfoResponse{
+		Graffiti: strings.Join(lines, "\n"),
+		Version:  strconv.FormatInt(replay.BaseMiniblockNum, 10),
+	}), nil
+}
+
 func (s *Service) debugInfoMakeMiniblock(
 	ctx context.Context,
 	request *connect.Request[InfoRequest],
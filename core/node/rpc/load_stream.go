@@ -7,6 +7,7 @@ import (
 	. "github.com/towns-protocol/towns/core/node/events"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	. "github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
+	. "github.com/towns-protocol/towns/core/node/rpc/headers"
 	. "github.com/towns-protocol/towns/core/node/shared"
 
 	"connectrpc.com/connect"
@@ -37,9 +38,11 @@ func (s *Service) loadStream(ctx context.Context, streamId StreamId) (ViewStream
 		return nil, err
 	}
 
-	resp, err := stub.GetStream(ctx, connect.NewRequest(&GetStreamRequest{
+	req := connect.NewRequest(&GetStreamRequest{
 		StreamId: streamId[:],
-	}))
+	})
+	req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
+	resp, err := stub.GetStream(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -65,11 +68,13 @@ func (s *remoteStream) GetMiniblocks(
 	fromInclusive int64,
 	toExclusive int64,
 ) ([]*MiniblockInfo, bool, error) {
-	res, err := s.stub.GetMiniblocks(ctx, connect.NewRequest(&GetMiniblocksRequest{
+	req := connect.NewRequest(&GetMiniblocksRequest{
 		StreamId:      s.streamId[:],
 		FromInclusive: fromInclusive,
 		ToExclusive:   toExclusive,
-	}))
+	})
+	req.Header().Set(RiverFullMiniblocksHeader, RiverHeaderTrueValue)
+	res, err := s.stub.GetMiniblocks(ctx, req)
 	if err != nil {
 		return nil, false, err
 	}
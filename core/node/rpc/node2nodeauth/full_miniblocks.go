@@ -0,0 +1,162 @@
+package node2nodeauth
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	lru "github.com/hashicorp/golang-lru/arc/v2"
+
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/rpc/headers"
+)
+
+const (
+	// fullMiniblocksTokenTTL is how long a full miniblocks token is accepted after it is signed.
+	fullMiniblocksTokenTTL = 5 * time.Minute
+	// fullMiniblocksTokenRefresh is the age after which the signer creates a new token.
+	fullMiniblocksTokenRefresh = fullMiniblocksTokenTTL / 5
+	// fullMiniblocksMaxClockSkew is how far in the future a token timestamp may be.
+	fullMiniblocksMaxClockSkew = time.Minute
+	// fullMiniblocksVerifiedCacheSize is the number of verified tokens that are cached.
+	fullMiniblocksVerifiedCacheSize = 1024
+)
+
+// Full miniblocks include messages of DM and GDM streams that are expired but not yet trimmed. Clients only receive
+// miniblocks without these messages. Nodes and node services verify miniblocks against their headers and need all
+// events, they prove their identity with a token in the RiverFullMiniblocksHeader that is signed by their wallet.
+// The token is <unix seconds>.<hex signature> where the signature is over the timestamp.
+
+func fullMiniblocksTokenHash(timestamp int64) common.Hash {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(timestamp))
+	return crypto.TownsHashForFullMiniblocks.Hash(buf[:])
+}
+
+// FullMiniblocksSigner creates signed full miniblocks tokens.
+type FullMiniblocksSigner struct {
+	wallet *crypto.Wallet
+
+	mu       sync.Mutex
+	token    string
+	signedAt time.Time
+}
+
+// NewFullMiniblocksSigner creates a FullMiniblocksSigner that signs tokens with the given wallet.
+func NewFullMiniblocksSigner(wallet *crypto.Wallet) *FullMiniblocksSigner {
+	return &FullMiniblocksSigner{wallet: wallet}
+}
+
+// Token returns a token for the RiverFullMiniblocksHeader. Tokens are reused until they are refreshed.
+func (s *FullMiniblocksSigner) Token(now time.Time) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && now.Sub(s.signedAt) < fullMiniblocksTokenRefresh {
+		return s.token, nil
+	}
+
+	timestamp := now.Unix()
+	signature, err := s.wallet.SignHash(fullMiniblocksTokenHash(timestamp))
+	if err != nil {
+		return "", err
+	}
+
+	s.token = strconv.FormatInt(timestamp, 10) + "." + hex.EncodeToString(signature)
+	s.signedAt = now
+	return s.token, nil
+}
+
+// fullMiniblocksTransport replaces the RiverFullMiniblocksHeader that internal clients set to true with a signed
+// token. The header is removed when the token can't be created.
+type fullMiniblocksTransport struct {
+	base   http.RoundTripper
+	signer *FullMiniblocksSigner
+}
+
+func (t *fullMiniblocksTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get(headers.RiverFullMiniblocksHeader) != headers.RiverHeaderTrueValue {
+		return t.base.RoundTrip(req)
+	}
+
+	// RoundTrippers must not modify the given request
+	req = req.Clone(req.Context())
+	token, err := t.signer.Token(time.Now())
+	if err != nil {
+		logging.FromCtx(req.Context()).Errorw("Unable to sign full miniblocks token", "error", err)
+		req.Header.Del(headers.RiverFullMiniblocksHeader)
+	} else {
+		req.Header.Set(headers.RiverFullMiniblocksHeader, token)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// WithFullMiniblocksSigner returns a copy of client that signs requests for full miniblocks with signer.
+func WithFullMiniblocksSigner(client *http.Client, signer *FullMiniblocksSigner) *http.Client {
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	signed := *client
+	signed.Transport = &fullMiniblocksTransport{base: base, signer: signer}
+	return &signed
+}
+
+// FullMiniblocksVerifier verifies full miniblocks tokens.
+type FullMiniblocksVerifier struct {
+	// isAllowed returns true if the given signer may read full miniblocks
+	isAllowed func(addr common.Address) bool
+	// verified keeps verified tokens with their signing time
+	verified *lru.ARCCache[string, time.Time]
+}
+
+// NewFullMiniblocksVerifier creates a FullMiniblocksVerifier that accepts tokens signed by addresses for which
+// isAllowed returns true.
+func NewFullMiniblocksVerifier(isAllowed func(addr common.Address) bool) *FullMiniblocksVerifier {
+	verified, _ := lru.NewARC[string, time.Time](fullMiniblocksVerifiedCacheSize)
+	return &FullMiniblocksVerifier{isAllowed: isAllowed, verified: verified}
+}
+
+// Verify returns true if token is a valid full miniblocks token signed by an allowed node or node service.
+func (v *FullMiniblocksVerifier) Verify(token string, now time.Time) bool {
+	if signedAt, ok := v.verified.Get(token); ok {
+		return v.fresh(signedAt, now)
+	}
+
+	timestampStr, signatureHex, ok := strings.Cut(token, ".")
+	if !ok {
+		return false
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return false
+	}
+	signedAt := time.Unix(timestamp, 0)
+	if !v.fresh(signedAt, now) {
+		return false
+	}
+	signature, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return false
+	}
+	publicKey, err := crypto.RecoverSignerPublicKey(fullMiniblocksTokenHash(timestamp).Bytes(), signature)
+	if err != nil {
+		return false
+	}
+	if !v.isAllowed(crypto.PublicKeyToAddress(publicKey)) {
+		return false
+	}
+
+	v.verified.Add(token, signedAt)
+	return true
+}
+
+func (v *FullMiniblocksVerifier) fresh(signedAt time.Time, now time.Time) bool {
+	return now.Sub(signedAt) < fullMiniblocksTokenTTL && signedAt.Sub(now) < fullMiniblocksMaxClockSkew
+}
@@ -0,0 +1,91 @@
+package node2nodeauth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/rpc/headers"
+)
+
+func TestFullMiniblocksToken(t *testing.T) {
+	wallet, err := crypto.NewWallet(context.Background())
+	require.NoError(t, err)
+	other, err := crypto.NewWallet(context.Background())
+	require.NoError(t, err)
+
+	signer := NewFullMiniblocksSigner(wallet)
+	verifier := NewFullMiniblocksVerifier(func(addr common.Address) bool {
+		return addr == wallet.Address
+	})
+
+	now := time.Now()
+	token, err := signer.Token(now)
+	require.NoError(t, err)
+	require.True(t, verifier.Verify(token, now))
+	// cached tokens expire as well
+	require.True(t, verifier.Verify(token, now.Add(fullMiniblocksTokenTTL-time.Second)))
+	require.False(t, verifier.Verify(token, now.Add(fullMiniblocksTokenTTL)))
+
+	// tokens are reused until they are refreshed
+	reused, err := signer.Token(now.Add(fullMiniblocksTokenRefresh - time.Second))
+	require.NoError(t, err)
+	require.Equal(t, token, reused)
+	refreshed, err := signer.Token(now.Add(fullMiniblocksTokenRefresh))
+	require.NoError(t, err)
+	require.NotEqual(t, token, refreshed)
+
+	// tokens from the future and of unknown signers are rejected
+	future, err := NewFullMiniblocksSigner(wallet).Token(now.Add(2 * fullMiniblocksMaxClockSkew))
+	require.NoError(t, err)
+	require.False(t, verifier.Verify(future, now))
+	otherToken, err := NewFullMiniblocksSigner(other).Token(now)
+	require.NoError(t, err)
+	require.False(t, verifier.Verify(otherToken, now))
+
+	for _, invalid := range []string{"", "true", "123", "abc.def", token[:len(token)-2]} {
+		require.False(t, verifier.Verify(invalid, now), invalid)
+	}
+}
+
+func TestFullMiniblocksTransport(t *testing.T) {
+	wallet, err := crypto.NewWallet(context.Background())
+	require.NoError(t, err)
+
+	verifier := NewFullMiniblocksVerifier(func(addr common.Address) bool {
+		return addr == wallet.Address
+	})
+
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get(headers.RiverFullMiniblocksHeader)
+	}))
+	defer server.Close()
+
+	client := WithFullMiniblocksSigner(server.Client(), NewFullMiniblocksSigner(wallet))
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	require.NotEqual(t, headers.RiverHeaderTrueValue, received)
+	require.True(t, verifier.Verify(received, time.Now()))
+	// the caller's request is not modified
+	require.Equal(t, headers.RiverHeaderTrueValue, req.Header.Get(headers.RiverFullMiniblocksHeader))
+
+	req, err = http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Empty(t, received)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	eth_crypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/google/go-cmp/cmp"
+	"github.com/sideshow/apns2"
 	payload2 "github.com/sideshow/apns2/payload"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
@@ -327,6 +328,85 @@ func TestNotifications(t *testing.T) {
 	tester.parallelSubtest("SpaceChannelNotification", func(tester *serviceTester) {
 		testSpaceChannelNotifications(tester, notificationClient, authClient, notifications)
 	})
+
+	tester.parallelSubtest("Sessions", func(tester *serviceTester) {
+		testNotificationSessions(tester, authClient)
+	})
+}
+
+// testNotificationSessions ensures that sessions can be refreshed, listed and revoked and that
+// session tokens of revoked sessions are rejected.
+func testNotificationSessions(
+	tester *serviceTester,
+	authClient protocolconnect.AuthenticationServiceClient,
+) {
+	var (
+		ctx = tester.ctx
+		req = tester.require
+	)
+
+	wallet, err := crypto.NewWallet(ctx)
+	req.NoError(err)
+
+	listSessions := func(sessionToken string) (*ListSessionsResponse, error) {
+		request := connect.NewRequest(&ListSessionsRequest{})
+		request.Header().Set("authorization", sessionToken)
+		resp, err := authClient.ListSessions(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Msg, nil
+	}
+
+	session1 := authentication.FinishAuthentication(ctx, "NS_AUTH:", req, authClient, wallet)
+	session2 := authentication.FinishAuthentication(ctx, "NS_AUTH:", req, authClient, wallet)
+	req.NotEmpty(session1.GetRefreshToken())
+	_, err = listSessions(session1.GetSessionToken())
+	req.NoError(err)
+
+	// refresh tokens are rotated on each refresh
+	refreshed, err := authClient.RefreshSession(ctx, connect.NewRequest(&RefreshSessionRequest{
+		RefreshToken: session1.GetRefreshToken(),
+	}))
+	req.NoError(err)
+	req.NotEqual(session1.GetRefreshToken(), refreshed.Msg.GetRefreshToken())
+
+	// list sessions requires a session token
+	_, err = authClient.ListSessions(ctx, connect.NewRequest(&ListSessionsRequest{}))
+	req.Equal(connect.CodeUnauthenticated, connect.CodeOf(err))
+
+	sessions, err := listSessions(refreshed.Msg.GetSessionToken())
+	req.NoError(err)
+	req.Len(sessions.GetSessions(), 2)
+	for _, session := range sessions.GetSessions() {
+		req.Equal(bytes.Equal(session.GetSessionId(), session1.GetSessionId()), session.GetCurrent())
+	}
+
+	// reusing a refresh token revokes the session
+	_, err = authClient.RefreshSession(ctx, connect.NewRequest(&RefreshSessionRequest{
+		RefreshToken: session1.GetRefreshToken(),
+	}))
+	req.Equal(connect.CodeUnauthenticated, connect.CodeOf(err))
+	req.Eventually(func() bool {
+		_, err := listSessions(refreshed.Msg.GetSessionToken())
+		return connect.CodeOf(err) == connect.CodeUnauthenticated
+	}, 20*time.Second, 250*time.Millisecond, "Session token of revoked session accepted")
+
+	// revoke the remaining session
+	revokeRequest := connect.NewRequest(&RevokeSessionsRequest{SessionIds: [][]byte{session2.GetSessionId()}})
+	revokeRequest.Header().Set("authorization", session2.GetSessionToken())
+	revoked, err := authClient.RevokeSessions(ctx, revokeRequest)
+	req.NoError(err)
+	req.EqualValues(1, revoked.Msg.GetRevoked())
+	req.Eventually(func() bool {
+		_, err := listSessions(session2.GetSessionToken())
+		return connect.CodeOf(err) == connect.CodeUnauthenticated
+	}, 20*time.Second, 250*time.Millisecond, "Session token of revoked session accepted")
+
+	_, err = authClient.RefreshSession(ctx, connect.NewRequest(&RefreshSessionRequest{
+		RefreshToken: session2.GetRefreshToken(),
+	}))
+	req.Equal(connect.CodeUnauthenticated, connect.CodeOf(err))
 }
 
 func testGDMNotifications(
@@ -1620,6 +1700,7 @@ func (nc *notificationCapture) SendWebPushNotification(
 	subscription *webpush.Subscription,
 	eventHash common.Hash,
 	_ []byte,
+	_ webpush.Urgency,
 	_ string,
 ) (bool, error) {
 	nc.WebPushNotificationsMu.Lock()
@@ -1643,6 +1724,8 @@ func (nc *notificationCapture) SendApplePushNotification(
 	eventHash common.Hash,
 	_ *payload2.Payload,
 	_ bool,
+	_ apns2.EPushType,
+	_ int,
 	_ string,
 ) (bool, int, error) {
 	nc.ApnPushNotificationsMu.Lock()
@@ -12,11 +12,17 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/auth"
+	"github.com/towns-protocol/towns/core/node/authentication"
 	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
 	"github.com/towns-protocol/towns/core/node/logging"
 	"github.com/towns-protocol/towns/core/node/nodes"
 	"github.com/towns-protocol/towns/core/node/notifications"
 	"github.com/towns-protocol/towns/core/node/notifications/push"
+	notificationssync "github.com/towns-protocol/towns/core/node/notifications/sync"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/track_streams"
 )
 
 func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *ServerStartOpts) error {
@@ -54,11 +60,56 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		}
 	}
 
+	var baseChain *crypto.Blockchain
+	if s.config.Notifications.ResolveRoleMentions || s.config.Notifications.Authentication.SmartAccountSignatures {
+		baseChain, err = crypto.NewBlockchain(s.serverCtx, &s.config.BaseChain, nil, s.metrics, s.otelTracer)
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init base chain").LogError(s.defaultLogger)
+		}
+	}
+
+	var spaceRoles notifications.SpaceRoles
+	if s.config.Notifications.ResolveRoleMentions {
+		spaceContract, err := auth.NewSpaceContractV3(
+			s.serverCtx,
+			&s.config.ArchitectContract,
+			&s.config.BaseChain,
+			baseChain.Client,
+		)
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init space contract").LogError(s.defaultLogger)
+		}
+		spaceRoles, err = notifications.NewSpaceContractRoles(spaceContract)
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init space roles").LogError(s.defaultLogger)
+		}
+	}
+
+	var (
+		streamLeases *notificationssync.StreamLeases
+		streamOwner  notifications.StreamOwner
+		cookieStore  track_streams.SyncCookieStore
+	)
+	if s.config.Notifications.StreamLeases.Enabled {
+		if s.notificationsPgStore == nil {
+			return RiverError(Err_BAD_CONFIG, "Stream leases require postgres storage").LogError(s.defaultLogger)
+		}
+		streamLeases = notificationssync.NewStreamLeases(
+			s.notificationsPgStore,
+			s.instanceId,
+			s.config.Notifications.StreamLeases,
+		)
+		streamOwner = streamLeases
+		cookieStore = track_streams.NewPostgresStreamCookieStore(s.notificationsPgStore.Pool(), "stream_sync_cookies")
+	}
+
 	processor := notifications.NewNotificationMessageProcessor(
 		s.serverCtx,
 		s.notifications,
 		s.config.Notifications,
 		notifier,
+		spaceRoles,
+		streamOwner,
 	)
 
 	httpClient, err := s.httpClientMaker(s.serverCtx, s.config)
@@ -91,6 +142,20 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		registries = append(registries, registry.CloneWithClients(httpClient, httpClient))
 	}
 
+	var (
+		challengeStore authentication.ChallengeStore
+		sessionStore   authentication.SessionStore
+		smartAccounts  *crypto.SmartAccountVerifier
+	)
+	if s.notificationsPgStore != nil {
+		// share pending authentication challenges and sessions between instances
+		challengeStore = authentication.NewPostgresChallengeStore(s.notificationsPgStore.Pool())
+		sessionStore = authentication.NewPostgresSessionStore(s.notificationsPgStore.Pool())
+	}
+	if s.config.Notifications.Authentication.SmartAccountSignatures {
+		smartAccounts = crypto.NewSmartAccountVerifier(baseChain.Client)
+	}
+
 	s.NotificationService, err = notifications.NewService(
 		s.serverCtx,
 		s.config.Notifications,
@@ -101,6 +166,11 @@ func (s *Service) startNotificationMode(notifier push.MessageNotifier, opts *Ser
 		s.metrics,
 		processor,
 		s.otelTracer,
+		streamLeases,
+		cookieStore,
+		challengeStore,
+		sessionStore,
+		smartAccounts,
 	)
 	if err != nil {
 		return AsRiverError(err).Message("Failed to instantiate notification service").LogError(s.defaultLogger)
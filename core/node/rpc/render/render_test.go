@@ -92,3 +92,52 @@ func TestRenderDebugMulti(t *testing.T) {
 	_, err := render.Execute(&payload)
 	require.NoError(t, err)
 }
+
+func TestRenderConsole(t *testing.T) {
+	overview := render.ConsoleOverviewData{
+		Address:      "0x1234567890abcdef1234567890abcdef12345678",
+		Mode:         "full",
+		Version:      "1.2.3",
+		StartTime:    "2024-04-30T19:08:26Z",
+		Uptime:       "10s",
+		Pages:        []string{"/debug/multi", "/debug/txpool"},
+		StreamLookup: true,
+		Sync:         &render.ConsoleSyncData{Sessions: 3, PendingMessages: 7, LargestPendingMessages: 5},
+		TxPool:       &render.TransactionPoolData{},
+		Archiver:     &render.ConsoleArchiverData{StreamsExamined: 10, CorruptStreams: 1},
+		HighUsage: []statusinfo.HighUsageInfo{
+			{
+				User:       "0x1234567890abcdef1234567890abcdef12345678",
+				CallType:   "event",
+				LastSeen:   "2024-04-30T19:08:26Z",
+				Violations: []statusinfo.ViolationInfo{{Window: "1m0s", Count: 100, Limit: 50}},
+			},
+		},
+	}
+	output, err := render.Execute(&overview)
+	require.NoError(t, err)
+	require.Contains(t, output.String(), "Active sessions: 3")
+	require.Contains(t, output.String(), "100/50 per 1m0s")
+
+	stream := render.ConsoleStreamData{
+		Query:            "a81234",
+		StreamId:         "a81234",
+		MiniblockRanges:  "0-30",
+		LastMiniblockNum: 30,
+		Miniblocks: []render.ConsoleMiniblock{
+			{Num: 11, Hash: "0x01", Events: 2},
+			{Num: 12, Error: "unable to parse"},
+		},
+		PrevFrom: 0,
+		NextFrom: 13,
+		Miniblock: &render.ConsoleMiniblock{
+			Num:  11,
+			Hash: "0x01",
+		},
+		Events: []render.ConsoleEvent{{Hash: "0x02", Payload: "member_payload.membership"}},
+	}
+	output, err = render.Execute(&stream)
+	require.NoError(t, err)
+	require.Contains(t, output.String(), "/debug/console/stream?id=a81234&from=13")
+	require.Contains(t, output.String(), "member_payload.membership")
+}
@@ -17,7 +17,9 @@ type RenderableData interface {
 		*DebugMultiData |
 		*StorageData |
 		*StreamSummaryData |
-		*CorruptStreamData
+		*CorruptStreamData |
+		*ConsoleOverviewData |
+		*ConsoleStreamData
 
 	// TemplateName returns the name of the template to be used for rendering
 	TemplateName() string
@@ -35,8 +37,23 @@ type DebugCorruptStreamRecord struct {
 	CorruptionReason     string
 }
 
+// DebugStreamRepairRecord is scrub.StreamRepairRecord converted for html template rendering.
+type DebugStreamRepairRecord struct {
+	StreamId          string
+	FromInclusive     int64
+	ToExclusive       int64
+	SourceNode        string
+	LastMiniblockHash string
+	CorruptionError   string
+	Status            string
+	Error             string
+	StartedAt         string
+	Duration          string
+}
+
 type CorruptStreamData struct {
 	Streams []DebugCorruptStreamRecord
+	Repairs []DebugStreamRepairRecord
 }
 
 func (d CorruptStreamData) TemplateName() string {
@@ -152,3 +169,88 @@ type OnChainConfigData struct {
 func (d OnChainConfigData) TemplateName() string {
 	return "templates/debug/on-chain-config.template.html"
 }
+
+// ConsoleOverviewData is the landing page of the operator console.
+type ConsoleOverviewData struct {
+	Address   string
+	Mode      string
+	Version   string
+	StartTime string
+	Uptime    string
+
+	// Pages lists the other enabled debug pages.
+	Pages []string
+	// StreamLookup is true when the stream browser is enabled.
+	StreamLookup bool
+
+	// Sections are nil when disabled or not available in the node's mode.
+	Sync      *ConsoleSyncData
+	TxPool    *TransactionPoolData
+	Archiver  *ConsoleArchiverData
+	HighUsage []statusinfo.HighUsageInfo
+}
+
+func (d ConsoleOverviewData) TemplateName() string {
+	return "templates/debug/console.template.html"
+}
+
+type ConsoleSyncData struct {
+	Sessions               int
+	PendingMessages        int
+	LargestPendingMessages int
+	PendingBytes           int
+}
+
+type ConsoleArchiverData struct {
+	StreamsExamined        uint64
+	StreamsUpToDate        uint64
+	MiniblocksProcessed    uint64
+	SuccessOpsCount        uint64
+	FailedOpsCount         uint64
+	StreamScrubsInProgress int64
+	TasksQueued            int
+	CorruptStreams         int
+	StreamRepairs          int
+}
+
+// ConsoleStreamData is the stream browser page of the operator console.
+type ConsoleStreamData struct {
+	Query string
+	Error string
+
+	StreamId         string
+	Nodes            string
+	MiniblockRanges  string
+	LastMiniblockNum int64
+
+	// Miniblocks is the current page of miniblocks, PrevFrom and NextFrom are -1 when there
+	// is no previous or next page.
+	Miniblocks []ConsoleMiniblock
+	PrevFrom   int64
+	NextFrom   int64
+
+	// Miniblock is set when a single miniblock is inspected.
+	Miniblock *ConsoleMiniblock
+	Events    []ConsoleEvent
+}
+
+func (d ConsoleStreamData) TemplateName() string {
+	return "templates/debug/consoleStream.template.html"
+}
+
+type ConsoleMiniblock struct {
+	Num            int64
+	Hash           string
+	Timestamp      string
+	EventNumOffset int64
+	Events         int
+	Snapshot       bool
+	Error          string
+}
+
+type ConsoleEvent struct {
+	Hash      string
+	Creator   string
+	CreatedAt string
+	Payload   string
+}
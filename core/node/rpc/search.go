@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/auth"
+	"github.com/towns-protocol/towns/core/node/authentication"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	"github.com/towns-protocol/towns/core/node/search"
+	"github.com/towns-protocol/towns/core/node/track_streams"
+)
+
+func (s *Service) startSearchMode(opts *ServerStartOpts) error {
+	var err error
+	s.startTime = time.Now()
+
+	s.initInstance(ServerModeSearch, opts)
+
+	s.initTracing("search", s.instanceId)
+
+	err = s.initRiverChain()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init river chain").LogError(s.defaultLogger)
+	}
+
+	// entitlements are required to check if users can read the space channels that match a search
+	err = s.initEntitlements()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init entitlements").LogError(s.defaultLogger)
+	}
+
+	err = s.initBaseChain()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init base chain").LogError(s.defaultLogger)
+	}
+
+	err = s.prepareStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to prepare store").LogError(s.defaultLogger)
+	}
+
+	err = s.initSearchStore()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to init store").LogError(s.defaultLogger)
+	}
+
+	httpClient, err := s.httpClientMaker(s.serverCtx, s.config)
+	if err != nil {
+		return err
+	}
+
+	registry, err := nodes.LoadNodeRegistry(
+		s.serverCtx,
+		s.registryContract,
+		common.Address{},
+		s.riverChain.InitialBlockNum,
+		s.riverChain.ChainMonitor,
+		s.chainConfig,
+		httpClient,
+		httpClient,
+		s.otelConnectIterceptor,
+	)
+	if err != nil {
+		return err
+	}
+
+	var registries []nodes.NodeRegistry
+	for range 10 {
+		httpClient, err := s.httpClientMaker(s.serverCtx, s.config)
+		if err != nil {
+			return err
+		}
+		registries = append(registries, registry.CloneWithClients(httpClient, httpClient))
+	}
+
+	// space channel names are stored in the space contract
+	var channels search.SpaceChannels
+	if s.baseChain != nil {
+		channels, err = auth.NewSpaceContractV3(
+			s.serverCtx,
+			&s.config.ArchitectContract,
+			&s.config.BaseChain,
+			s.baseChain.Client,
+		)
+		if err != nil {
+			return AsRiverError(err).Message("Failed to init space contract").LogError(s.defaultLogger)
+		}
+	}
+
+	s.SearchService, err = search.NewService(
+		s.serverCtx,
+		s.config,
+		s.chainConfig,
+		s.searchStore,
+		s.chainAuth,
+		channels,
+		s.registryContract,
+		registries,
+		s.metrics,
+		s.otelTracer,
+		track_streams.NewPostgresStreamCookieStore(s.searchStore.Pool(), "stream_sync_cookies"),
+		authentication.NewPostgresChallengeStore(s.searchStore.Pool()),
+		authentication.NewPostgresSessionStore(s.searchStore.Pool()),
+	)
+	if err != nil {
+		return AsRiverError(err).Message("Failed to instantiate search service").LogError(s.defaultLogger)
+	}
+
+	s.SetStatus("OK")
+
+	err = s.runHttpServer()
+	if err != nil {
+		return AsRiverError(err).Message("Failed to run http server").LogError(s.defaultLogger)
+	}
+
+	if err := s.initSearchHandlers(); err != nil {
+		return err
+	}
+
+	s.SearchService.Start(s.serverCtx)
+
+	// Retrieve the TCP address of the listener
+	tcpAddr := s.listener.Addr().(*net.TCPAddr)
+
+	// Get the port as an integer
+	port := tcpAddr.Port
+
+	// build the url by converting the integer to a string
+	url := s.config.UrlSchema() + "://localhost:" + strconv.Itoa(port)
+	s.defaultLogger.Infow("Server started", "port", port, "https", !s.config.DisableHttps, "url", url)
+
+	return nil
+}
+
+func StartServerInSearchMode(
+	ctx context.Context,
+	cfg *config.Config,
+	opts *ServerStartOpts,
+) (*Service, error) {
+	ctx = config.CtxWithConfig(ctx, cfg)
+	ctx, ctxCancel := context.WithCancel(ctx)
+
+	service := &Service{
+		serverCtx:       ctx,
+		serverCtxCancel: ctxCancel,
+		config:          cfg,
+		exitSignal:      make(chan error, 1),
+	}
+
+	err := service.startSearchMode(opts)
+	if err != nil {
+		service.Close()
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func RunSearchService(ctx context.Context, cfg *config.Config) error {
+	log := logging.FromCtx(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	service, err := StartServerInSearchMode(ctx, cfg, nil)
+	if err != nil {
+		log.Errorw("Failed to start server", "error", err)
+		return err
+	}
+	defer service.Close()
+
+	osSignal := make(chan os.Signal, 1)
+	signal.Notify(osSignal, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-osSignal
+		log.Infow("Got OS signal", "signal", sig.String())
+		service.exitSignal <- nil
+	}()
+
+	err = <-service.exitSignal
+	return err
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/towns-protocol/towns/core/node/events/remoteprovider"
 	"github.com/towns-protocol/towns/core/node/http_client"
 	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/infra/analytics"
 	"github.com/towns-protocol/towns/core/node/logging"
 	"github.com/towns-protocol/towns/core/node/nodes"
 	"github.com/towns-protocol/towns/core/node/nodes/streamplacement"
@@ -54,6 +55,7 @@ const (
 	ServerModeArchive      = "archive"
 	ServerModeNotification = "notification"
 	ServerModeAppRegistry  = "app_registry"
+	ServerModeSearch       = "search"
 )
 
 func (s *Service) httpServerClose() {
@@ -227,6 +229,16 @@ func (s *Service) initInstance(mode string, opts *ServerStartOpts) {
 		s.httpClientMaker = http_client.GetHttpClient
 	}
 
+	// Internal clients set the full miniblocks header to true, it's replaced with a signed token
+	makeHttpClient := s.httpClientMaker
+	s.httpClientMaker = func(ctx context.Context, cfg *config.Config) (*http.Client, error) {
+		client, err := makeHttpClient(ctx, cfg)
+		if err != nil || s.fullMiniblocksSigner == nil {
+			return client, err
+		}
+		return node2nodeauth.WithFullMiniblocksSigner(client, s.fullMiniblocksSigner), nil
+	}
+
 	if s.httpClientMakerWithCert == nil {
 		s.httpClientMakerWithCert = http_client.GetHttpClientWithCert
 	}
@@ -267,6 +279,8 @@ func (s *Service) initInstance(mode string, opts *ServerStartOpts) {
 		subsystem = "notification"
 	case ServerModeAppRegistry:
 		subsystem = "app_registry"
+	case ServerModeSearch:
+		subsystem = "search"
 	}
 
 	metricsRegistry := prometheus.NewRegistry()
@@ -384,6 +398,8 @@ func (s *Service) initRiverChain() error {
 		walletAddress = s.wallet.Address
 	}
 
+	s.initFullMiniblocksSigner()
+
 	httpClient, err := s.httpClientMaker(ctx, s.config)
 	if err != nil {
 		return err
@@ -411,6 +427,13 @@ func (s *Service) initRiverChain() error {
 		return err
 	}
 
+	s.fullMiniblocksVerifier = node2nodeauth.NewFullMiniblocksVerifier(func(addr common.Address) bool {
+		if _, err := s.nodeRegistry.GetNode(addr); err == nil {
+			return true
+		}
+		return slices.Contains(s.chainConfig.Get().NodeServiceAddresses, addr)
+	})
+
 	s.streamPlacer, err = streamplacement.NewDistributor(
 		ctx,
 		s.chainConfig,
@@ -425,6 +448,28 @@ func (s *Service) initRiverChain() error {
 	return nil
 }
 
+// initFullMiniblocksSigner creates the signer for requests of full miniblocks. Nodes sign with their wallet,
+// node services with the optional wallet from WALLETPRIVATEKEY, PRIVATE_KEY or the wallet file. The address of
+// a node service wallet must be listed in the node.serviceaddresses on-chain setting.
+func (s *Service) initFullMiniblocksSigner() {
+	wallet := s.wallet
+	if wallet == nil && s.riverChain != nil {
+		wallet = s.riverChain.Wallet
+	}
+	if wallet == nil {
+		var err error
+		wallet, err = util.LoadWallet(s.serverCtx)
+		if err != nil {
+			s.defaultLogger.Infow(
+				"No wallet to sign full miniblocks requests, miniblocks are received without expired messages",
+				"error", err,
+			)
+			return
+		}
+	}
+	s.fullMiniblocksSigner = node2nodeauth.NewFullMiniblocksSigner(wallet)
+}
+
 func (s *Service) prepareStore() error {
 	switch s.config.StorageType {
 	case storage.StreamStorageTypePostgres:
@@ -438,6 +483,8 @@ func (s *Service) prepareStore() error {
 			schema = storage.DbSchemaNameForNotifications(s.config.RiverChain.ChainId)
 		case ServerModeAppRegistry:
 			schema = storage.DbSchemaNameForAppRegistryService(s.config.AppRegistry.AppRegistryId)
+		case ServerModeSearch:
+			schema = storage.DbSchemaNameForSearch(s.config.RiverChain.ChainId)
 		default:
 			return RiverError(
 				Err_BAD_CONFIG,
@@ -704,6 +751,7 @@ func (s *Service) initNotificationsStore() error {
 		}
 
 		s.notifications = notifications.NewUserPreferencesCache(pgstore)
+		s.notificationsPgStore = pgstore
 		s.onClose(pgstore.Close)
 
 		if !s.config.Log.Simplify {
@@ -760,6 +808,42 @@ func (s *Service) initAppRegistryStore() error {
 	}
 }
 
+func (s *Service) initSearchStore() error {
+	ctx := s.serverCtx
+	log := s.defaultLogger
+
+	switch s.config.StorageType {
+	case storage.SearchStorageTypePostgres:
+		pgstore, err := storage.NewPostgresSearchStore(
+			ctx,
+			s.storagePoolInfo,
+			s.exitSignal,
+			s.metrics,
+		)
+		if err != nil {
+			return err
+		}
+		s.onClose(pgstore.Close)
+		s.searchStore = pgstore
+
+		if !s.config.Log.Simplify {
+			log.Infow(
+				"Created postgres search store",
+				"schema",
+				s.storagePoolInfo.Schema,
+			)
+		}
+		return nil
+	default:
+		return RiverError(
+			Err_BAD_CONFIG,
+			"Unknown storage type",
+			"storageType",
+			s.config.StorageType,
+		).Func("createStore")
+	}
+}
+
 func (s *Service) initCacheAndSync(opts *ServerStartOpts) error {
 	cacheParams := &events.StreamCacheParams{
 		ServerCtx:               s.serverCtx,
@@ -806,6 +890,7 @@ func (s *Service) initCacheAndSync(opts *ServerStartOpts) error {
 		s.nodeRegistry,
 		s.metrics,
 		s.otelTracer,
+		s.config.Sync,
 	)
 
 	return nil
@@ -835,6 +920,16 @@ func (s *Service) initHandlers() {
 	)
 	s.mux.Handle(streamServicePattern, newHttpHandler(streamServiceHandler, s.defaultLogger))
 
+	if s.config.Sync.WebSocket.Enabled {
+		syncWebSocketHandler := newSyncWebSocketHandler(
+			s.syncv3Svc,
+			s.config.Sync.WebSocket,
+			s.config.TestEntitlementsBypassSecret,
+			s.metrics,
+		)
+		s.mux.Handle(SyncWebSocketPath, newHttpHandler(syncWebSocketHandler, s.defaultLogger))
+	}
+
 	// NodeToNode handler uses only base interceptors (no test-bypass)
 	nodeServicePattern, nodeServiceHandler := protocolconnect.NewNodeToNodeHandler(
 		s,
@@ -859,8 +954,8 @@ func (s *Service) initNotificationHandlers() error {
 
 	authInceptor, err := authentication.NewAuthenticationInterceptor(
 		s.NotificationService.ShortServiceName(),
-		s.config.Notifications.Authentication.SessionToken.Key.Algorithm,
-		s.config.Notifications.Authentication.SessionToken.Key.Key,
+		s.config.Notifications.Authentication.SessionToken.Key,
+		s.NotificationService.Sessions(),
 	)
 	if err != nil {
 		return err
@@ -896,8 +991,8 @@ func (s *Service) initAppRegistryHandlers() error {
 
 	authInceptor, err := authentication.NewAuthenticationInterceptor(
 		s.AppRegistryService.ShortServiceName(),
-		s.config.AppRegistry.Authentication.SessionToken.Key.Algorithm,
-		s.config.AppRegistry.Authentication.SessionToken.Key.Key,
+		s.config.AppRegistry.Authentication.SessionToken.Key,
+		s.AppRegistryService.Sessions(),
 		"/river.AppRegistryService/GetStatus",
 		"/river.AppRegistryService/GetAppMetadata",
 		"/river.AppRegistryService/ValidateBotName",
@@ -926,6 +1021,42 @@ func (s *Service) initAppRegistryHandlers() error {
 	return nil
 }
 
+func (s *Service) initSearchHandlers() error {
+	var ii []connect.Interceptor
+	if s.otelConnectIterceptor != nil {
+		ii = append(ii, s.otelConnectIterceptor)
+	}
+	ii = append(ii, s.NewMetricsInterceptor())
+	ii = append(ii, NewTimeoutInterceptor(s.config.Network.RequestTimeout))
+
+	authInceptor, err := authentication.NewAuthenticationInterceptor(
+		s.SearchService.ShortServiceName(),
+		s.config.Search.Authentication.SessionToken.Key,
+		s.SearchService.Sessions(),
+	)
+	if err != nil {
+		return err
+	}
+	ii = append(ii, authInceptor)
+
+	interceptors := connect.WithInterceptors(ii...)
+	searchServicePattern, searchServiceHandler := protocolconnect.NewSearchServiceHandler(
+		s.SearchService,
+		interceptors,
+	)
+	searchAuthServicePattern, searchAuthServiceHandler := protocolconnect.NewAuthenticationServiceHandler(
+		s.SearchService,
+		interceptors,
+	)
+
+	s.mux.Handle(searchServicePattern, newHttpHandler(searchServiceHandler, s.defaultLogger))
+	s.mux.Handle(searchAuthServicePattern, newHttpHandler(searchAuthServiceHandler, s.defaultLogger))
+
+	s.registerDebugHandlers()
+
+	return nil
+}
+
 type ServerStartOpts struct {
 	RiverChain              *crypto.Blockchain
 	Listener                net.Listener
@@ -933,6 +1064,8 @@ type ServerStartOpts struct {
 	HttpClientMakerWithCert HttpClientMakerWithCertFunc
 	ScrubberMaker           func(context.Context, *Service) events.Scrubber
 	StreamEventListener     track_streams.StreamEventListener
+	// AnalyticsSinks are passed to the app registry analytics next to the sinks enabled in configuration.
+	AnalyticsSinks []analytics.Sink
 }
 
 // StartServer starts the server with the given configuration.
@@ -26,7 +26,9 @@ import (
 	. "github.com/towns-protocol/towns/core/node/protocol/protocolconnect"
 	"github.com/towns-protocol/towns/core/node/registries"
 	"github.com/towns-protocol/towns/core/node/rpc/highusage"
+	"github.com/towns-protocol/towns/core/node/rpc/node2nodeauth"
 	riversyncv3 "github.com/towns-protocol/towns/core/node/rpc/syncv3"
+	"github.com/towns-protocol/towns/core/node/search"
 	"github.com/towns-protocol/towns/core/node/storage"
 	"github.com/towns-protocol/towns/core/xchain/entitlement"
 )
@@ -61,11 +63,15 @@ type Service struct {
 	syncv3Svc riversyncv3.Service
 
 	// Notifications
-	notifications notifications.UserPreferencesStore
+	notifications        notifications.UserPreferencesStore
+	notificationsPgStore *storage.PostgresNotificationStore
 
 	// App Registry
 	appStore storage.AppRegistryStore
 
+	// Search
+	searchStore *storage.PostgresSearchStore
+
 	// River chain
 	riverChain       *crypto.Blockchain
 	registryContract *registries.RiverRegistryContract
@@ -87,6 +93,10 @@ type Service struct {
 	httpClientMaker         HttpClientMakerFunc
 	httpClientMakerWithCert HttpClientMakerWithCertFunc
 
+	// Full miniblocks, signer is nil when the service has no wallet
+	fullMiniblocksSigner   *node2nodeauth.FullMiniblocksSigner
+	fullMiniblocksVerifier *node2nodeauth.FullMiniblocksVerifier
+
 	// Status string
 	status atomic.Pointer[string]
 
@@ -99,6 +109,9 @@ type Service struct {
 	// AppRegistryService is not nil if running in app registry mode
 	AppRegistryService *app_registry.Service
 
+	// SearchService is not nil if running in search mode
+	SearchService *search.Service
+
 	// Metrics
 	metrics               infra.MetricsFactory
 	metricsPublisher      *infra.MetricsPublisher
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
 	"github.com/towns-protocol/towns/core/node/events"
@@ -146,9 +147,9 @@ func TestSyncSubscriptionWithTooSlowClient_NoRace(t *testing.T) {
 	// subscribe to channel updates on node 1 direct through a sync op to have better control over it
 	testfmt.Logf(t, "subscribe on node %s", node1.address)
 	eventBus := eventbus.New(ctx, node1.address, node1.service.cache, node1.service.nodeRegistry, nil, nil)
-	handlerRegistry := handler.NewRegistry(node1.service.cache, eventBus, nil)
+	handlerRegistry := handler.NewRegistry(node1.service.cache, eventBus, nil, config.SyncConfig{})
 	slowSubscriber := slowStreamsResponseSender{sendDuration: time.Second}
-	syncHandler, err := handlerRegistry.New(ctx, syncID, slowSubscriber)
+	syncHandler, err := handlerRegistry.New(ctx, syncID, slowSubscriber, false)
 	req.NoError(err, "handlerRegistry.New")
 	resp, err := syncHandler.Modify(ctx, &protocol.ModifySyncRequest{AddStreams: syncPos})
 	req.NoError(err, "syncHandler.Modify")
@@ -34,11 +34,25 @@ func (s *Service) SyncStreams(
 	ctx, log := utils.CtxAndLogForRequest(ctx, req)
 	startTime := time.Now()
 	syncId := GenNanoid()
-	log.Debugw("SyncStreams START", "syncId", syncId)
+	resume := req.Msg.GetResumeSyncId() != ""
+	if resume {
+		syncId = req.Msg.GetResumeSyncId()
+	}
+	log.Debugw("SyncStreams START", "syncId", syncId, "resume", resume)
 
 	var err error
 	runWithLabels(ctx, syncId, func(ctx context.Context) {
-		err = s.syncv3Svc.SyncStreams(ctx, syncId, req.Msg.GetSyncPos(), res)
+		switch {
+		case resume && len(req.Msg.GetSyncPos()) > 0:
+			err = RiverError(Err_INVALID_ARGUMENT, "sync_pos must be empty when resuming a sync")
+		case resume && req.Msg.GetFilter() != nil:
+			err = RiverError(Err_INVALID_ARGUMENT, "filter must be empty when resuming a sync")
+		case resume:
+			err = s.syncv3Svc.ResumeSync(ctx, syncId, res)
+		default:
+			err = s.syncv3Svc.SyncStreams(
+				ctx, syncId, req.Msg.GetSyncPos(), req.Msg.GetFilter(), fullMiniblocks(s, req), res)
+		}
 	})
 	if err != nil {
 		level := zap.WarnLevel
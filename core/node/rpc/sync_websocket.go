@@ -0,0 +1,359 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/auth"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rpc/syncv3"
+	"github.com/towns-protocol/towns/core/node/rpc/syncv3/handler"
+)
+
+// SyncWebSocketPath is the path of the sync WebSocket endpoint.
+const SyncWebSocketPath = "/sync/ws"
+
+// maxCloseReasonLen is the maximum length of a WebSocket close frame reason.
+const maxCloseReasonLen = 123
+
+// syncWebSocketHandler serves sync sessions over WebSocket connections. Each connection runs a single
+// sync session through the same syncv3.Service as the SyncStreams RPC.
+type syncWebSocketHandler struct {
+	svc          syncv3.Service
+	cfg          config.SyncWebSocketConfig
+	bypassSecret string
+	upgrader     websocket.Upgrader
+	connections  prometheus.Gauge
+}
+
+var _ http.Handler = (*syncWebSocketHandler)(nil)
+
+func newSyncWebSocketHandler(
+	svc syncv3.Service,
+	cfg config.SyncWebSocketConfig,
+	bypassSecret string,
+	metrics infra.MetricsFactory,
+) *syncWebSocketHandler {
+	return &syncWebSocketHandler{
+		svc:          svc,
+		cfg:          cfg,
+		bypassSecret: bypassSecret,
+		upgrader: websocket.Upgrader{
+			EnableCompression: !cfg.DisableCompression,
+			// Browsers don't apply CORS to WebSocket handshakes, origins are checked against the allowlist instead.
+			CheckOrigin: func(r *http.Request) bool { return syncWebSocketOriginAllowed(cfg.AllowedOrigins, r) },
+		},
+		connections: metrics.NewGaugeEx("sync_websocket_connections", "Number of open sync WebSocket connections"),
+	}
+}
+
+// syncWebSocketOriginAllowed returns true if the request has no Origin header or if its origin matches an entry
+// of allowed, see config.SyncWebSocketConfig.AllowedOrigins.
+func syncWebSocketOriginAllowed(allowed []string, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return false
+	}
+	for _, entry := range allowed {
+		if entry == "*" || strings.EqualFold(entry, origin) {
+			return true
+		}
+		scheme, host, ok := strings.Cut(entry, "://*.")
+		if ok && strings.EqualFold(scheme, u.Scheme) &&
+			strings.HasSuffix(strings.ToLower(u.Host), "."+strings.ToLower(host)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *syncWebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := auth.WithTestEntitlementBypass(r.Context(), h.bypassSecret, r.Header)
+	log := logging.FromCtx(ctx)
+
+	// Upgrade writes the error response to the client if the request is not a valid WebSocket handshake.
+	conn, err := h.upgrader.Upgrade(w, r, w.Header())
+	if err != nil {
+		log.Debugw("Unable to upgrade sync WebSocket connection", "error", err)
+		return
+	}
+
+	h.connections.Inc()
+	defer h.connections.Dec()
+
+	c := &syncWebSocketConn{
+		svc:     h.svc,
+		cfg:     h.cfg,
+		conn:    conn,
+		log:     log,
+		started: make(chan struct{}),
+	}
+	c.serve(ctx)
+}
+
+// syncWebSocketConn runs a sync session over a single WebSocket connection.
+type syncWebSocketConn struct {
+	svc  syncv3.Service
+	cfg  config.SyncWebSocketConfig
+	conn *websocket.Conn
+	log  *logging.Log
+
+	// syncID is the id of the sync session, it is set before the session starts.
+	syncID string
+	// msgType is the frame type of the first client message, responses are sent in the same encoding.
+	msgType int
+	// started is closed when the first update, SYNC_NEW, is sent to the client.
+	started     chan struct{}
+	startedOnce sync.Once
+
+	// writeMu serializes writes of sync updates and request responses.
+	writeMu sync.Mutex
+}
+
+var _ handler.Receiver = (*syncWebSocketConn)(nil)
+
+func (c *syncWebSocketConn) serve(ctx context.Context) {
+	defer c.conn.Close()
+
+	pingInterval := c.cfg.GetPingInterval()
+	c.conn.SetReadLimit(c.cfg.GetMaxMessageSize())
+	_ = c.conn.SetReadDeadline(time.Now().Add(2 * pingInterval))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(2 * pingInterval))
+	})
+	if !c.cfg.DisableCompression {
+		c.conn.EnableWriteCompression(true)
+		_ = c.conn.SetCompressionLevel(c.cfg.GetCompressionLevel())
+	}
+
+	msgType, req, err := c.readRequest()
+	if err != nil {
+		c.log.Debugw("Unable to read sync WebSocket request", "error", err)
+		c.close(err)
+		return
+	}
+	c.msgType = msgType
+
+	syncReq := req.GetSyncStreams()
+	if syncReq == nil {
+		c.close(RiverError(Err_INVALID_ARGUMENT, "First sync WebSocket message must be a sync_streams request"))
+		return
+	}
+
+	resume := syncReq.GetResumeSyncId() != ""
+	c.syncID = GenNanoid()
+	if resume {
+		c.syncID = syncReq.GetResumeSyncId()
+	}
+	c.log = c.log.With("syncId", c.syncID)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go c.keepAlive(ctx, pingInterval)
+	go c.readLoop(ctx, cancel)
+
+	startTime := time.Now()
+	c.log.Debugw("SyncStreams WebSocket START", "resume", resume)
+
+	runWithLabels(ctx, c.syncID, func(ctx context.Context) {
+		switch {
+		case resume && len(syncReq.GetSyncPos()) > 0:
+			err = RiverError(Err_INVALID_ARGUMENT, "sync_pos must be empty when resuming a sync")
+		case resume && syncReq.GetFilter() != nil:
+			err = RiverError(Err_INVALID_ARGUMENT, "filter must be empty when resuming a sync")
+		case resume:
+			err = c.svc.ResumeSync(ctx, c.syncID, c)
+		default:
+			err = c.svc.SyncStreams(ctx, c.syncID, syncReq.GetSyncPos(), syncReq.GetFilter(), false, c)
+		}
+	})
+
+	if err != nil && !errors.Is(err, context.Canceled) {
+		c.log.Warnw("SyncStreams WebSocket failed", "error", err, "duration", time.Since(startTime))
+	} else {
+		c.log.Debugw("SyncStreams WebSocket DONE", "duration", time.Since(startTime))
+	}
+
+	c.close(err)
+}
+
+// Send implements handler.Receiver.
+func (c *syncWebSocketConn) Send(msg *SyncStreamsResponse) error {
+	err := c.write(&SyncWebSocketResponse{Response: &SyncWebSocketResponse_SyncStreams{SyncStreams: msg}})
+	c.startedOnce.Do(func() { close(c.started) })
+	return err
+}
+
+// readLoop processes client requests until the connection fails or ctx expires.
+// The sync session is cancelled when the connection fails.
+func (c *syncWebSocketConn) readLoop(ctx context.Context, cancel context.CancelFunc) {
+	defer cancel()
+
+	for {
+		_, req, err := c.readRequest()
+		if err != nil {
+			if ctx.Err() == nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.log.Debugw("Sync WebSocket connection read failed", "error", err)
+			}
+			return
+		}
+
+		// Requests refer to the sync session which is registered when SYNC_NEW is sent.
+		select {
+		case <-c.started:
+		case <-ctx.Done():
+			return
+		}
+
+		c.handleRequest(ctx, req)
+	}
+}
+
+func (c *syncWebSocketConn) handleRequest(ctx context.Context, req *SyncWebSocketRequest) {
+	var (
+		res *SyncWebSocketResponse
+		err error
+	)
+
+	runWithLabels(ctx, c.syncID, func(ctx context.Context) {
+		switch r := req.GetRequest().(type) {
+		case *SyncWebSocketRequest_ModifySync:
+			r.ModifySync.SyncId = c.syncID
+			var modifyRes *ModifySyncResponse
+			if modifyRes, err = c.svc.ModifySync(ctx, r.ModifySync); err == nil {
+				res = &SyncWebSocketResponse{Response: &SyncWebSocketResponse_ModifySync{ModifySync: modifyRes}}
+			}
+		case *SyncWebSocketRequest_PingSync:
+			err = c.svc.PingSync(ctx, c.syncID, r.PingSync.GetNonce())
+		case *SyncWebSocketRequest_CancelSync:
+			err = c.svc.CancelSync(ctx, c.syncID)
+		case *SyncWebSocketRequest_SyncStreams:
+			err = RiverError(Err_INVALID_ARGUMENT, "Sync WebSocket session already started")
+		default:
+			err = RiverError(Err_INVALID_ARGUMENT, "Unknown sync WebSocket request")
+		}
+	})
+
+	if err != nil {
+		rvrErr := AsRiverError(err)
+		res = &SyncWebSocketResponse{Response: &SyncWebSocketResponse_Error{Error: &SyncWebSocketError{
+			Code:    int32(rvrErr.Code),
+			Message: rvrErr.GetMessage(),
+		}}}
+	}
+	if res == nil {
+		return
+	}
+
+	res.RequestId = req.GetRequestId()
+	if err := c.write(res); err != nil {
+		c.log.Debugw("Unable to write sync WebSocket response", "error", err)
+	}
+}
+
+// keepAlive pings the client until ctx expires. The read deadline is extended when the client responds.
+func (c *syncWebSocketConn) keepAlive(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deadline := time.Now().Add(c.cfg.GetWriteTimeout())
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, deadline); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *syncWebSocketConn) readRequest() (int, *SyncWebSocketRequest, error) {
+	msgType, data, err := c.conn.ReadMessage()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var req SyncWebSocketRequest
+	if msgType == websocket.TextMessage {
+		err = protojson.Unmarshal(data, &req)
+	} else {
+		err = proto.Unmarshal(data, &req)
+	}
+	if err != nil {
+		return 0, nil, AsRiverError(err, Err_INVALID_ARGUMENT).Message("Unable to decode sync WebSocket request")
+	}
+	return msgType, &req, nil
+}
+
+func (c *syncWebSocketConn) write(res *SyncWebSocketResponse) error {
+	var (
+		data []byte
+		err  error
+	)
+	if c.msgType == websocket.TextMessage {
+		data, err = protojson.Marshal(res)
+	} else {
+		data, err = proto.Marshal(res)
+	}
+	if err != nil {
+		return AsRiverError(err, Err_INTERNAL).Message("Unable to encode sync WebSocket response")
+	}
+
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := c.conn.SetWriteDeadline(time.Now().Add(c.cfg.GetWriteTimeout())); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE)
+	}
+	if err := c.conn.WriteMessage(c.msgType, data); err != nil {
+		return AsRiverError(err, Err_UNAVAILABLE).Message("Unable to write to sync WebSocket")
+	}
+	return nil
+}
+
+// close sends the error, if any, and a close frame to the client.
+func (c *syncWebSocketConn) close(err error) {
+	code := websocket.CloseNormalClosure
+	reason := ""
+	if err != nil && !errors.Is(err, context.Canceled) {
+		rvrErr := AsRiverError(err)
+		if c.msgType != 0 {
+			_ = c.write(&SyncWebSocketResponse{Response: &SyncWebSocketResponse_Error{Error: &SyncWebSocketError{
+				Code:    int32(rvrErr.Code),
+				Message: rvrErr.GetMessage(),
+			}}})
+		}
+		code = websocket.CloseInternalServerErr
+		if rvrErr.Code == Err_INVALID_ARGUMENT {
+			code = websocket.ClosePolicyViolation
+		}
+		reason = rvrErr.GetMessage()
+		if len(reason) > maxCloseReasonLen {
+			reason = reason[:maxCloseReasonLen]
+		}
+	}
+
+	deadline := time.Now().Add(c.cfg.GetWriteTimeout())
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), deadline)
+}
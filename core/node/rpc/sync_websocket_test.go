@@ -0,0 +1,241 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rpc/syncv3"
+	"github.com/towns-protocol/towns/core/node/rpc/syncv3/handler"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// fakeSyncService runs a sync session that sends SYNC_NEW and answers pings until it is cancelled.
+type fakeSyncService struct {
+	syncv3.Service
+
+	mu       sync.Mutex
+	syncID   string
+	streams  []*SyncCookie
+	modifies []*ModifySyncRequest
+	rec      handler.Receiver
+	cancel   chan struct{}
+}
+
+func (f *fakeSyncService) SyncStreams(
+	ctx context.Context,
+	syncID string,
+	streams []*SyncCookie,
+	_ *SyncFilter,
+	_ bool,
+	rec handler.Receiver,
+) error {
+	f.mu.Lock()
+	f.syncID, f.streams, f.rec = syncID, streams, rec
+	f.mu.Unlock()
+
+	if err := rec.Send(&SyncStreamsResponse{SyncId: syncID, SyncOp: SyncOp_SYNC_NEW}); err != nil {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-f.cancel:
+		return rec.Send(&SyncStreamsResponse{SyncId: syncID, SyncOp: SyncOp_SYNC_CLOSE})
+	}
+}
+
+func (f *fakeSyncService) ModifySync(_ context.Context, req *ModifySyncRequest) (*ModifySyncResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.modifies = append(f.modifies, req)
+	return &ModifySyncResponse{}, nil
+}
+
+func (f *fakeSyncService) PingSync(_ context.Context, syncID, nonce string) error {
+	f.mu.Lock()
+	rec := f.rec
+	f.mu.Unlock()
+	return rec.Send(&SyncStreamsResponse{SyncId: syncID, SyncOp: SyncOp_SYNC_PONG, PongNonce: nonce})
+}
+
+func (f *fakeSyncService) CancelSync(context.Context, string) error {
+	close(f.cancel)
+	return nil
+}
+
+func newSyncWebSocketTestServer(t *testing.T, svc syncv3.Service) string {
+	h := newSyncWebSocketHandler(
+		svc,
+		config.SyncWebSocketConfig{PingInterval: time.Second},
+		"",
+		infra.NewMetricsFactory(prometheus.NewRegistry(), "", ""),
+	)
+	server := httptest.NewServer(h)
+	t.Cleanup(server.Close)
+	return "ws" + strings.TrimPrefix(server.URL, "http") + SyncWebSocketPath
+}
+
+func TestSyncWebSocket(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	svc := &fakeSyncService{cancel: make(chan struct{})}
+	url := newSyncWebSocketTestServer(t, svc)
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	req.NoError(err)
+	defer conn.Close()
+
+	send := func(msg *SyncWebSocketRequest) {
+		data, err := proto.Marshal(msg)
+		req.NoError(err)
+		req.NoError(conn.WriteMessage(websocket.BinaryMessage, data))
+	}
+	receive := func() *SyncWebSocketResponse {
+		msgType, data, err := conn.ReadMessage()
+		req.NoError(err)
+		req.Equal(websocket.BinaryMessage, msgType)
+		var res SyncWebSocketResponse
+		req.NoError(proto.Unmarshal(data, &res))
+		return &res
+	}
+
+	streamID := StreamId{STREAM_CHANNEL_BIN}
+	send(&SyncWebSocketRequest{Request: &SyncWebSocketRequest_SyncStreams{SyncStreams: &SyncStreamsRequest{
+		SyncPos: []*SyncCookie{{StreamId: streamID[:]}},
+	}}})
+
+	res := receive()
+	req.Equal(SyncOp_SYNC_NEW, res.GetSyncStreams().GetSyncOp())
+	syncID := res.GetSyncStreams().GetSyncId()
+	req.NotEmpty(syncID)
+
+	// requests refer to the session of the connection
+	send(&SyncWebSocketRequest{
+		RequestId: "modify-1",
+		Request: &SyncWebSocketRequest_ModifySync{ModifySync: &ModifySyncRequest{
+			RemoveStreams: [][]byte{streamID[:]},
+		}},
+	})
+	res = receive()
+	req.Equal("modify-1", res.GetRequestId())
+	req.NotNil(res.GetModifySync())
+	svc.mu.Lock()
+	req.Len(svc.modifies, 1)
+	req.Equal(syncID, svc.modifies[0].GetSyncId())
+	svc.mu.Unlock()
+
+	send(&SyncWebSocketRequest{Request: &SyncWebSocketRequest_PingSync{PingSync: &PingSyncRequest{Nonce: "n1"}}})
+	res = receive()
+	req.Equal(SyncOp_SYNC_PONG, res.GetSyncStreams().GetSyncOp())
+	req.Equal("n1", res.GetSyncStreams().GetPongNonce())
+
+	// a second sync_streams request is rejected
+	send(&SyncWebSocketRequest{
+		RequestId: "sync-2",
+		Request:   &SyncWebSocketRequest_SyncStreams{SyncStreams: &SyncStreamsRequest{}},
+	})
+	res = receive()
+	req.Equal("sync-2", res.GetRequestId())
+	req.EqualValues(Err_INVALID_ARGUMENT, res.GetError().GetCode())
+
+	send(&SyncWebSocketRequest{Request: &SyncWebSocketRequest_CancelSync{CancelSync: &CancelSyncRequest{}}})
+	res = receive()
+	req.Equal(SyncOp_SYNC_CLOSE, res.GetSyncStreams().GetSyncOp())
+
+	_, _, err = conn.ReadMessage()
+	req.True(websocket.IsCloseError(err, websocket.CloseNormalClosure), "unexpected error: %v", err)
+}
+
+func TestSyncWebSocketJSON(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	svc := &fakeSyncService{cancel: make(chan struct{})}
+	url := newSyncWebSocketTestServer(t, svc)
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	req.NoError(err)
+	defer conn.Close()
+
+	data, err := protojson.Marshal(&SyncWebSocketRequest{
+		Request: &SyncWebSocketRequest_SyncStreams{SyncStreams: &SyncStreamsRequest{}},
+	})
+	req.NoError(err)
+	req.NoError(conn.WriteMessage(websocket.TextMessage, data))
+
+	msgType, data, err := conn.ReadMessage()
+	req.NoError(err)
+	req.Equal(websocket.TextMessage, msgType)
+	var res SyncWebSocketResponse
+	req.NoError(protojson.Unmarshal(data, &res))
+	req.Equal(SyncOp_SYNC_NEW, res.GetSyncStreams().GetSyncOp())
+}
+
+func TestSyncWebSocketInvalidFirstRequest(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	req := require.New(t)
+
+	url := newSyncWebSocketTestServer(t, &fakeSyncService{cancel: make(chan struct{})})
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+	req.NoError(err)
+	defer conn.Close()
+
+	data, err := proto.Marshal(&SyncWebSocketRequest{
+		Request: &SyncWebSocketRequest_PingSync{PingSync: &PingSyncRequest{Nonce: "n1"}},
+	})
+	req.NoError(err)
+	req.NoError(conn.WriteMessage(websocket.BinaryMessage, data))
+
+	msgType, data, err := conn.ReadMessage()
+	req.NoError(err)
+	req.Equal(websocket.BinaryMessage, msgType)
+	var res SyncWebSocketResponse
+	req.NoError(proto.Unmarshal(data, &res))
+	req.EqualValues(Err_INVALID_ARGUMENT, res.GetError().GetCode())
+
+	_, _, err = conn.ReadMessage()
+	req.True(websocket.IsCloseError(err, websocket.ClosePolicyViolation), "unexpected error: %v", err)
+}
+
+func TestSyncWebSocketOriginAllowed(t *testing.T) {
+	allowed := []string{"https://app.towns.com", "https://*.towns.dev"}
+	for origin, expected := range map[string]bool{
+		"":                           true,
+		"https://app.towns.com":      true,
+		"https://APP.towns.com":      true,
+		"https://pr-1.towns.dev":     true,
+		"https://towns.dev":          false,
+		"http://pr-1.towns.dev":      false,
+		"https://evil.com":           false,
+		"https://app.towns.com.evil": false,
+		"null":                       false,
+	} {
+		r := httptest.NewRequest(http.MethodGet, SyncWebSocketPath, nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		require.Equal(t, expected, syncWebSocketOriginAllowed(allowed, r), origin)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, SyncWebSocketPath, nil)
+	r.Header.Set("Origin", "https://evil.com")
+	require.True(t, syncWebSocketOriginAllowed([]string{"*"}, r))
+}
@@ -359,7 +359,7 @@ func (e *eventBusImpl) processTargetedStreamUpdateCommand(streamID StreamId, msg
 			if ver == syncer.PendingSubscribersVersion {
 				subscribers.movePendingToVersion(targetSyncID, version)
 			}
-			subscriber.OnUpdate(msg)
+			deliver(subscriber, msg, true)
 		}
 	} else {
 		e.log.Errorw("received unsupported targeted stream update message",
@@ -0,0 +1,233 @@
+package eventbus
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+)
+
+// payloadCases returns the set of valid payload case names, "<payload>" and "<payload>.<content>".
+var payloadCases = sync.OnceValue(func() map[string]struct{} {
+	cases := make(map[string]struct{})
+	fields := (&StreamEvent{}).ProtoReflect().Descriptor().Oneofs().ByName("payload").Fields()
+	for i := range fields.Len() {
+		payload := fields.Get(i)
+		cases[string(payload.Name())] = struct{}{}
+		content := payload.Message().Oneofs().ByName("content")
+		if content == nil {
+			continue
+		}
+		for j := range content.Fields().Len() {
+			cases[string(payload.Name())+"."+string(content.Fields().Get(j).Name())] = struct{}{}
+		}
+	}
+	return cases
+})
+
+// SubscriptionFilter decides which events of a stream update are sent to a subscriber.
+// An event is sent when it matches all criteria that are set in the filter.
+type SubscriptionFilter struct {
+	payloads         map[string]struct{}
+	excludedPayloads map[string]struct{}
+	creators         map[common.Address]struct{}
+	interactionTypes map[MessageInteractionType]struct{}
+}
+
+// NewSubscriptionFilter compiles the given client filter. It returns nil when the filter doesn't filter anything.
+func NewSubscriptionFilter(filter *SyncFilter) (*SubscriptionFilter, error) {
+	if len(filter.GetPayloadCases()) == 0 && len(filter.GetExcludePayloadCases()) == 0 &&
+		len(filter.GetCreatorAddresses()) == 0 && len(filter.GetMessageInteractionTypes()) == 0 {
+		return nil, nil
+	}
+
+	payloads, err := toPayloadCaseSet(filter.GetPayloadCases())
+	if err != nil {
+		return nil, err
+	}
+	excludedPayloads, err := toPayloadCaseSet(filter.GetExcludePayloadCases())
+	if err != nil {
+		return nil, err
+	}
+
+	f := &SubscriptionFilter{
+		payloads:         payloads,
+		excludedPayloads: excludedPayloads,
+	}
+
+	if len(filter.GetCreatorAddresses()) > 0 {
+		f.creators = make(map[common.Address]struct{}, len(filter.GetCreatorAddresses()))
+		for _, addr := range filter.GetCreatorAddresses() {
+			if len(addr) != common.AddressLength {
+				return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid creator address in sync filter",
+					"address", addr)
+			}
+			f.creators[common.BytesToAddress(addr)] = struct{}{}
+		}
+	}
+
+	if len(filter.GetMessageInteractionTypes()) > 0 {
+		f.interactionTypes = make(map[MessageInteractionType]struct{}, len(filter.GetMessageInteractionTypes()))
+		for _, typ := range filter.GetMessageInteractionTypes() {
+			f.interactionTypes[typ] = struct{}{}
+		}
+	}
+
+	return f, nil
+}
+
+func toPayloadCaseSet(names []string) (map[string]struct{}, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	valid := payloadCases()
+	set := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := valid[name]; !ok {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Unknown payload case in sync filter", "payloadCase", name)
+		}
+		set[name] = struct{}{}
+	}
+	return set, nil
+}
+
+// matches returns true when the given event must be sent to the subscriber.
+func (f *SubscriptionFilter) matches(envelope *Envelope) bool {
+	var event StreamEvent
+	if err := proto.Unmarshal(envelope.GetEvent(), &event); err != nil {
+		// Let the client decide what to do with events it can't parse.
+		return true
+	}
+
+	// Miniblock headers are needed by clients to keep track of the stream.
+	if event.GetMiniblockHeader() != nil {
+		return true
+	}
+
+	if f.payloads != nil || f.excludedPayloads != nil {
+		payload, content := payloadCase(&event)
+		if f.payloads != nil && !containsAny(f.payloads, payload, content) {
+			return false
+		}
+		if f.excludedPayloads != nil && containsAny(f.excludedPayloads, payload, content) {
+			return false
+		}
+	}
+
+	if f.creators != nil {
+		if _, ok := f.creators[common.BytesToAddress(event.GetCreatorAddress())]; !ok {
+			return false
+		}
+	}
+
+	if f.interactionTypes != nil {
+		if _, ok := f.interactionTypes[event.GetTags().GetMessageInteractionType()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// payloadCase returns the name of the payload and the name of the payload with its content of the given event.
+func payloadCase(event *StreamEvent) (string, string) {
+	msg := event.ProtoReflect()
+	payloadField := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("payload"))
+	if payloadField == nil {
+		return "", ""
+	}
+	payload := string(payloadField.Name())
+
+	inner := msg.Get(payloadField).Message()
+	contentOneof := inner.Descriptor().Oneofs().ByName("content")
+	if contentOneof == nil {
+		return payload, payload
+	}
+	contentField := inner.WhichOneof(contentOneof)
+	if contentField == nil {
+		return payload, payload
+	}
+	return payload, payload + "." + string(contentField.Name())
+}
+
+func containsAny(set map[string]struct{}, names ...string) bool {
+	for _, name := range names {
+		if _, ok := set[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns the update that is sent to a filtered subscriber, or nil if the update must not be sent.
+//
+// Only the events of a stream update are filtered, miniblocks and snapshots are always sent. The given update is
+// shared with other subscribers and is therefore never modified. An update without events left is dropped unless it
+// is a backfill, carries miniblocks, a snapshot or resets the sync. The client cookie stays at the position of
+// the last sent update which is a valid position to resume the sync from.
+func (f *SubscriptionFilter) apply(update *SyncStreamsResponse, backfill bool) *SyncStreamsResponse {
+	stream := update.GetStream()
+	if update.GetSyncOp() != SyncOp_SYNC_UPDATE || len(stream.GetEvents()) == 0 {
+		return update
+	}
+
+	events := make([]*Envelope, 0, len(stream.GetEvents()))
+	for _, envelope := range stream.GetEvents() {
+		if f.matches(envelope) {
+			events = append(events, envelope)
+		}
+	}
+
+	if len(events) == len(stream.GetEvents()) {
+		return update
+	}
+
+	if len(events) == 0 && !backfill && len(stream.GetMiniblocks()) == 0 &&
+		stream.Snapshot == nil && !stream.GetSyncReset() {
+		return nil
+	}
+
+	return &SyncStreamsResponse{
+		SyncId:        update.GetSyncId(),
+		SyncOp:        update.GetSyncOp(),
+		StreamId:      update.GetStreamId(),
+		TargetSyncIds: update.GetTargetSyncIds(),
+		Stream: &StreamAndCookie{
+			Events:                 events,
+			NextSyncCookie:         stream.GetNextSyncCookie(),
+			Miniblocks:             stream.GetMiniblocks(),
+			SyncReset:              stream.GetSyncReset(),
+			Snapshot:               stream.Snapshot,
+			SnapshotMiniblockIndex: stream.GetSnapshotMiniblockIndex(),
+		},
+	}
+}
+
+// filteredSubscriber applies a SubscriptionFilter to the updates of the wrapped subscriber.
+type filteredSubscriber struct {
+	StreamSubscriber
+	filter *SubscriptionFilter
+}
+
+// WithFilter returns a subscriber that receives the updates that pass the given filter.
+// If filter is nil the given subscriber is returned.
+func WithFilter(subscriber StreamSubscriber, filter *SubscriptionFilter) StreamSubscriber {
+	if filter == nil {
+		return subscriber
+	}
+	return &filteredSubscriber{StreamSubscriber: subscriber, filter: filter}
+}
+
+// deliver sends the given update to the subscriber, applying the subscriber's filter if it has one.
+func deliver(subscriber StreamSubscriber, update *SyncStreamsResponse, backfill bool) {
+	if fs, ok := subscriber.(*filteredSubscriber); ok {
+		if update = fs.filter.apply(update, backfill); update == nil {
+			return
+		}
+	}
+	subscriber.OnUpdate(update)
+}
@@ -0,0 +1,241 @@
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/rpc/syncv3/syncer"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+var (
+	alice = common.HexToAddress("0xa1")
+	bob   = common.HexToAddress("0xb0")
+)
+
+func makeEnvelope(t *testing.T, event *protocol.StreamEvent) *protocol.Envelope {
+	t.Helper()
+	data, err := proto.Marshal(event)
+	require.NoError(t, err)
+	return &protocol.Envelope{Event: data}
+}
+
+func messageEvent(t *testing.T, creator common.Address, typ protocol.MessageInteractionType) *protocol.Envelope {
+	return makeEnvelope(t, &protocol.StreamEvent{
+		CreatorAddress: creator.Bytes(),
+		Tags:           &protocol.Tags{MessageInteractionType: typ},
+		Payload: &protocol.StreamEvent_ChannelPayload{ChannelPayload: &protocol.ChannelPayload{
+			Content: &protocol.ChannelPayload_Message{Message: &protocol.EncryptedData{}},
+		}},
+	})
+}
+
+func keySolicitationEvent(t *testing.T, creator common.Address) *protocol.Envelope {
+	return makeEnvelope(t, &protocol.StreamEvent{
+		CreatorAddress: creator.Bytes(),
+		Payload: &protocol.StreamEvent_MemberPayload{MemberPayload: &protocol.MemberPayload{
+			Content: &protocol.MemberPayload_KeySolicitation_{KeySolicitation: &protocol.MemberPayload_KeySolicitation{}},
+		}},
+	})
+}
+
+func miniblockHeaderEvent(t *testing.T) *protocol.Envelope {
+	return makeEnvelope(t, &protocol.StreamEvent{
+		Payload: &protocol.StreamEvent_MiniblockHeader{MiniblockHeader: &protocol.MiniblockHeader{}},
+	})
+}
+
+func update(events ...*protocol.Envelope) *protocol.SyncStreamsResponse {
+	return &protocol.SyncStreamsResponse{
+		SyncOp: protocol.SyncOp_SYNC_UPDATE,
+		Stream: &protocol.StreamAndCookie{
+			Events:         events,
+			NextSyncCookie: &protocol.SyncCookie{MinipoolGen: 7},
+		},
+	}
+}
+
+func TestNewSubscriptionFilter(t *testing.T) {
+	req := require.New(t)
+
+	f, err := NewSubscriptionFilter(nil)
+	req.NoError(err)
+	req.Nil(f)
+
+	f, err = NewSubscriptionFilter(&protocol.SyncFilter{})
+	req.NoError(err)
+	req.Nil(f)
+
+	f, err = NewSubscriptionFilter(&protocol.SyncFilter{
+		PayloadCases:        []string{"channel_payload", "member_payload.key_solicitation"},
+		ExcludePayloadCases: []string{"member_payload.key_fulfillment"},
+	})
+	req.NoError(err)
+	req.NotNil(f)
+
+	_, err = NewSubscriptionFilter(&protocol.SyncFilter{PayloadCases: []string{"channel_payload.unknown"}})
+	req.Error(err)
+
+	_, err = NewSubscriptionFilter(&protocol.SyncFilter{CreatorAddresses: [][]byte{{1, 2, 3}}})
+	req.Error(err)
+}
+
+func TestSubscriptionFilterApply(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *protocol.SyncFilter
+		events []*protocol.Envelope
+		want   []int
+	}{
+		{
+			name:   "payload",
+			filter: &protocol.SyncFilter{PayloadCases: []string{"channel_payload"}},
+			events: []*protocol.Envelope{
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+				keySolicitationEvent(t, alice),
+			},
+			want: []int{0},
+		},
+		{
+			name:   "payload content",
+			filter: &protocol.SyncFilter{PayloadCases: []string{"member_payload.key_solicitation"}},
+			events: []*protocol.Envelope{
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+				keySolicitationEvent(t, alice),
+			},
+			want: []int{1},
+		},
+		{
+			name:   "excluded payload",
+			filter: &protocol.SyncFilter{ExcludePayloadCases: []string{"member_payload.key_solicitation"}},
+			events: []*protocol.Envelope{
+				keySolicitationEvent(t, alice),
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+			},
+			want: []int{1},
+		},
+		{
+			name:   "creator",
+			filter: &protocol.SyncFilter{CreatorAddresses: [][]byte{bob.Bytes()}},
+			events: []*protocol.Envelope{
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+				messageEvent(t, bob, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+			},
+			want: []int{1},
+		},
+		{
+			name: "message interaction type",
+			filter: &protocol.SyncFilter{MessageInteractionTypes: []protocol.MessageInteractionType{
+				protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_REPLY,
+			}},
+			events: []*protocol.Envelope{
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_REACTION),
+				messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_REPLY),
+			},
+			want: []int{1},
+		},
+		{
+			name:   "miniblock header",
+			filter: &protocol.SyncFilter{CreatorAddresses: [][]byte{bob.Bytes()}},
+			events: []*protocol.Envelope{
+				keySolicitationEvent(t, alice),
+				miniblockHeaderEvent(t),
+			},
+			want: []int{1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := require.New(t)
+
+			f, err := NewSubscriptionFilter(tc.filter)
+			req.NoError(err)
+
+			msg := update(tc.events...)
+			got := f.apply(msg, false)
+			req.NotNil(got)
+
+			var want []*protocol.Envelope
+			for _, i := range tc.want {
+				want = append(want, tc.events[i])
+			}
+			req.Equal(want, got.GetStream().GetEvents())
+			req.Same(msg.GetStream().GetNextSyncCookie(), got.GetStream().GetNextSyncCookie())
+
+			// the shared update is not modified
+			req.Len(msg.GetStream().GetEvents(), len(tc.events))
+		})
+	}
+}
+
+func TestSubscriptionFilterDropsEmptyUpdates(t *testing.T) {
+	req := require.New(t)
+
+	f, err := NewSubscriptionFilter(&protocol.SyncFilter{CreatorAddresses: [][]byte{bob.Bytes()}})
+	req.NoError(err)
+
+	msg := update(keySolicitationEvent(t, alice))
+	req.Nil(f.apply(msg, false))
+
+	// backfills are always sent
+	backfill := f.apply(msg, true)
+	req.NotNil(backfill)
+	req.Empty(backfill.GetStream().GetEvents())
+	req.NotNil(backfill.GetStream().GetNextSyncCookie())
+
+	// updates with miniblocks are sent
+	msg.Stream.Miniblocks = []*protocol.Miniblock{{}}
+	req.NotNil(f.apply(msg, false))
+
+	// stream down messages are not filtered
+	down := &protocol.SyncStreamsResponse{SyncOp: protocol.SyncOp_SYNC_DOWN}
+	req.Same(down, f.apply(down, false))
+}
+
+func TestFilteredSubscriber(t *testing.T) {
+	req := require.New(t)
+
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	filtered := newCloningSubscriber("filtered")
+	plain := newCloningSubscriber("plain")
+
+	f, err := NewSubscriptionFilter(&protocol.SyncFilter{ExcludePayloadCases: []string{"member_payload"}})
+	req.NoError(err)
+
+	e := &eventBusImpl{subscribers: map[shared.StreamId]streamSubscribers{streamID: {
+		syncer.PendingSubscribersVersion: {WithFilter(filtered, f)},
+		2:                                {plain},
+	}}}
+
+	// the backfill of the filtered subscriber is sent even when all its events are filtered out
+	e.processTargetedStreamUpdateCommand(streamID, &protocol.SyncStreamsResponse{
+		SyncOp:        protocol.SyncOp_SYNC_UPDATE,
+		TargetSyncIds: []string{filtered.SyncID()},
+		Stream:        &protocol.StreamAndCookie{Events: []*protocol.Envelope{keySolicitationEvent(t, alice)}},
+	}, 2)
+	req.Len(filtered.updates, 1)
+	req.Empty(filtered.updates[0].GetStream().GetEvents())
+
+	e.processStreamUpdateCommand(streamID, update(keySolicitationEvent(t, alice)), 2)
+	req.Len(filtered.updates, 1)
+	req.Len(plain.updates, 1)
+
+	e.processStreamUpdateCommand(streamID, update(
+		keySolicitationEvent(t, alice),
+		messageEvent(t, alice, protocol.MessageInteractionType_MESSAGE_INTERACTION_TYPE_POST),
+	), 2)
+	req.Len(filtered.updates, 2)
+	req.Len(filtered.updates[1].GetStream().GetEvents(), 1)
+	req.Len(plain.updates, 2)
+	req.Len(plain.updates[1].GetStream().GetEvents(), 2)
+
+	// a wrapped subscriber is found and removed by its sync ID
+	req.False(e.subscribers[streamID].addPendingUnique(filtered))
+	req.NotNil(e.subscribers[streamID].removeBySyncID(filtered.SyncID()))
+}
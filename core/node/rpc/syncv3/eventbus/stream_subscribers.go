@@ -12,10 +12,9 @@ import (
 type streamSubscribers map[int][]StreamSubscriber
 
 func (ss streamSubscribers) addPendingUnique(subscriber StreamSubscriber) bool {
-	for _, subscribers := range ss {
-		if slices.Contains(subscribers, subscriber) {
-			return false
-		}
+	// Compare by sync ID because a subscriber can be wrapped, e.g. by a filter.
+	if sub, _ := ss.findBySyncID(subscriber.SyncID()); sub != nil {
+		return false
 	}
 
 	ss[syncer.PendingSubscribersVersion] = append(ss[syncer.PendingSubscribersVersion], subscriber)
@@ -102,11 +101,11 @@ func (ss streamSubscribers) clearVersion(version int) {
 func (ss streamSubscribers) sendUpdateToVersion(version int, msg *SyncStreamsResponse) {
 	for _, sub := range ss[version] {
 		// Create a copy of the update and unset syncID because each subscriber has its own unique syncID.
-		sub.OnUpdate(&SyncStreamsResponse{
+		deliver(sub, &SyncStreamsResponse{
 			SyncOp:   msg.GetSyncOp(),
 			Stream:   msg.GetStream(),
 			StreamId: msg.GetStreamId(),
-		})
+		}, false)
 	}
 }
 
@@ -114,11 +113,11 @@ func (ss streamSubscribers) sendUpdateToAll(msg *SyncStreamsResponse) {
 	for _, subscribers := range ss {
 		for _, sub := range subscribers {
 			// Create a copy of the update and unset syncID because each subscriber has its own unique syncID.
-			sub.OnUpdate(&SyncStreamsResponse{
+			deliver(sub, &SyncStreamsResponse{
 				SyncOp:   msg.GetSyncOp(),
 				Stream:   msg.GetStream(),
 				StreamId: msg.GetStreamId(),
-			})
+			}, false)
 		}
 	}
 }
@@ -2,6 +2,8 @@ package handler
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"time"
 
 	. "github.com/towns-protocol/towns/core/node/base"
@@ -10,7 +12,6 @@ import (
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/rpc/syncv3/eventbus"
 	. "github.com/towns-protocol/towns/core/node/shared"
-	"github.com/towns-protocol/towns/core/node/utils/dynmsgbuf"
 )
 
 const (
@@ -42,6 +43,14 @@ type SyncStreamHandler interface {
 	// Run runs the sync stream handler.
 	Run() error
 
+	// Resume attaches the given receiver to a sync operation that lost its client. Updates that were
+	// buffered while the operation was detached are sent after the SyncOp_SYNC_NEW message. It blocks
+	// until the client disconnects again or the sync operation ends.
+	Resume(ctx context.Context, receiver Receiver) error
+
+	// Resumable returns true if the sync operation outlived its last client and can be resumed.
+	Resumable() bool
+
 	// SyncID returns the unique identifier for the sync operation that is shared with the client.
 	SyncID() string
 
@@ -78,16 +87,87 @@ type syncStreamHandlerImpl struct {
 	syncID        string
 	receiver      Receiver
 	eventBus      eventbus.StreamSubscriptionManager
-	streamUpdates *dynmsgbuf.DynamicBuffer[*SyncStreamsResponse]
+	streamUpdates *sendQueue
 	streamCache   StreamCache
+
+	// clientCtx is the context of the client connection that started the sync operation.
+	clientCtx context.Context
+	// detachClient ends the current client attachment without ending the sync operation.
+	detachClient context.CancelCauseFunc
+	// resumeGracePeriod is the time the sync operation is kept after its client disconnected, 0 disables resuming.
+	resumeGracePeriod time.Duration
+	// resumeBufferSize is the maximum number of updates that are buffered while the client is disconnected.
+	resumeBufferSize int
+	// onExpire is called when the sync operation wasn't resumed within the grace period.
+	onExpire func()
+	// fullMiniblocks is set for nodes and node services that need all events, expired DM and GDM messages
+	// are removed from the updates of other sync operations.
+	fullMiniblocks bool
+
+	// mu guards the fields below.
+	mu sync.Mutex
+	// dropped is the set of streams that were dropped by a reset or the slow consumer policy and not added
+	// again by the client.
+	dropped map[StreamId]struct{}
+	// expirations holds the message expiration of the subscribed streams of which messages expire.
+	expirations map[StreamId]time.Duration
+
+	// The fields below are only relevant when resuming is enabled.
+	// attached is true while a client receives updates.
+	attached bool
+	// detachedGen identifies the current detachment, an expiry timer of an earlier detachment is ignored.
+	detachedGen uint64
+	// detachedTimer expires the sync operation when the client doesn't resume it in time.
+	detachedTimer *time.Timer
+	// pending holds messages that are sent to the client before the buffered updates when it resumes.
+	pending []*SyncStreamsResponse
+	// streams is the set of streams the sync operation is subscribed on.
+	streams map[StreamId]struct{}
 }
 
 func (s *syncStreamHandlerImpl) Run() error {
+	s.mu.Lock()
+	s.attached = true
+	s.mu.Unlock()
+
+	return s.run(s.clientCtx)
+}
+
+func (s *syncStreamHandlerImpl) Resume(ctx context.Context, receiver Receiver) error {
+	s.mu.Lock()
+	if s.resumeGracePeriod <= 0 || s.ctx.Err() != nil {
+		s.mu.Unlock()
+		return RiverError(Err_NOT_FOUND, "sync operation not found")
+	}
+	if s.attached {
+		s.mu.Unlock()
+		return RiverError(Err_FAILED_PRECONDITION, "sync operation is attached to another client")
+	}
+	if s.detachedTimer != nil {
+		s.detachedTimer.Stop()
+		s.detachedTimer = nil
+	}
+	s.attached = true
+	s.receiver = receiver
+	s.mu.Unlock()
+
+	s.log.Debugw("client resumed sync operation")
+
+	return s.run(ctx)
+}
+
+func (s *syncStreamHandlerImpl) Resumable() bool {
+	return s.resumeGracePeriod > 0 && s.ctx.Err() == nil
+}
+
+// run sends updates to the current receiver until the client disconnects or the sync operation ends.
+func (s *syncStreamHandlerImpl) run(ctx context.Context) (err error) {
+	clientCtx, detachClient := context.WithCancelCause(ctx)
 	defer func() {
-		if err := s.eventBus.EnqueueRemoveSubscriber(s.syncID); err != nil {
-			s.log.Errorw("failed to remove sync operation from the event bus", "error", err)
-		}
+		detachClient(nil)
+		s.detach(err)
 	}()
+	s.detachClient = detachClient
 
 	// The first message must be a SyncOp_SYNC_NEW message to notify the receiver about the new sync operation with ID.
 	if err := s.receiver.Send(&SyncStreamsResponse{
@@ -97,12 +177,24 @@ func (s *syncStreamHandlerImpl) Run() error {
 		return err
 	}
 
+	// Replay the messages that were not delivered to the previous client of the sync operation.
+	s.mu.Lock()
+	pending := s.pending
+	s.pending = nil
+	s.mu.Unlock()
+
+	if stop, err := s.processMessages(clientCtx, pending); stop {
+		return err
+	}
+
 	// Start processing stream updates.
 	var msgs []*SyncStreamsResponse
 	for {
 		select {
 		case <-s.ctx.Done():
 			return context.Cause(s.ctx)
+		case <-clientCtx.Done():
+			return context.Cause(clientCtx)
 		case <-s.streamUpdates.Wait():
 			msgs = s.streamUpdates.GetBatch(msgs)
 
@@ -116,17 +208,139 @@ func (s *syncStreamHandlerImpl) Run() error {
 				return nil
 			}
 
-			// Process each message in the batch.
-			// Messages must be processed in the order they were received.
-			for _, msg := range msgs {
-				if stop := s.processMessage(msg); stop {
-					return context.Cause(s.ctx)
-				}
+			if stop, err := s.processMessages(clientCtx, msgs); stop {
+				return err
 			}
 		}
 	}
 }
 
+// processMessages sends the given messages in order. If the client disconnected the unsent messages are
+// kept for when it resumes. Returns true and the cause if the processor should stop processing messages.
+func (s *syncStreamHandlerImpl) processMessages(clientCtx context.Context, msgs []*SyncStreamsResponse) (bool, error) {
+	for i, msg := range msgs {
+		if stop := s.processMessage(msg); stop {
+			if s.ctx.Err() != nil {
+				return true, context.Cause(s.ctx)
+			}
+
+			s.mu.Lock()
+			s.pending = append(slices.Clone(msgs[i:]), s.pending...)
+			s.mu.Unlock()
+			return true, context.Cause(clientCtx)
+		}
+	}
+	return false, nil
+}
+
+// detach is called when the client disconnected. When resuming is enabled the sync operation is kept for the
+// grace period, otherwise it is removed from the event bus.
+func (s *syncStreamHandlerImpl) detach(cause error) {
+	s.mu.Lock()
+	s.attached = false
+	if s.Resumable() {
+		s.detachedGen++
+		gen := s.detachedGen
+		s.detachedTimer = time.AfterFunc(s.resumeGracePeriod, func() { s.expire(gen) })
+		s.mu.Unlock()
+
+		s.log.Debugw("client detached from sync operation", "gracePeriod", s.resumeGracePeriod, "cause", cause)
+		return
+	}
+	s.mu.Unlock()
+
+	if err := s.eventBus.EnqueueRemoveSubscriber(s.syncID); err != nil {
+		s.log.Errorw("failed to remove sync operation from the event bus", "error", err)
+	}
+}
+
+// expire ends the sync operation when the client didn't resume it within the grace period.
+func (s *syncStreamHandlerImpl) expire(gen uint64) {
+	s.mu.Lock()
+	if s.attached || gen != s.detachedGen || s.ctx.Err() != nil {
+		s.mu.Unlock()
+		return
+	}
+	s.detachedTimer = nil
+	s.cancel(RiverError(Err_DEADLINE_EXCEEDED, "sync operation was not resumed in time"))
+	s.mu.Unlock()
+
+	s.log.Debugw("detached sync operation expired")
+	s.close()
+}
+
+// close removes a sync operation without client from the event bus and the registry.
+func (s *syncStreamHandlerImpl) close() {
+	s.streamUpdates.Close()
+	if err := s.eventBus.EnqueueRemoveSubscriber(s.syncID); err != nil {
+		s.log.Errorw("failed to remove sync operation from the event bus", "error", err)
+	}
+	if s.onExpire != nil {
+		s.onExpire()
+	}
+}
+
+// resetLocked drops the buffered updates and all subscriptions because the client can't catch up from the
+// buffer anymore. When the client resumes it receives a SyncOp_SYNC_DOWN message for each dropped stream.
+// Must be called with s.mu held.
+func (s *syncStreamHandlerImpl) resetLocked() {
+	// Keep the notifications about streams that already went down, the client must add them again as well.
+	var pending []*SyncStreamsResponse
+	for _, msg := range append(s.pending, s.streamUpdates.GetBatch(nil)...) {
+		if msg.GetSyncOp() == SyncOp_SYNC_DOWN {
+			pending = append(pending, msg)
+		}
+	}
+
+	if s.dropped == nil {
+		s.dropped = make(map[StreamId]struct{}, len(s.streams))
+	}
+	for streamID := range s.streams {
+		s.dropped[streamID] = struct{}{}
+		pending = append(pending, &SyncStreamsResponse{
+			SyncOp:   SyncOp_SYNC_DOWN,
+			StreamId: streamID[:],
+		})
+	}
+
+	s.log.Infow("reset detached sync operation, update buffer is full", "streams", len(s.streams))
+
+	s.pending = pending
+	s.streams = nil
+
+	if err := s.eventBus.EnqueueRemoveSubscriber(s.syncID); err != nil {
+		s.log.Errorw("failed to remove sync operation from the event bus", "error", err)
+	}
+}
+
+// trackStream records that the sync operation subscribes on the given stream.
+func (s *syncStreamHandlerImpl) trackStream(streamID StreamId) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.dropped, streamID)
+
+	if s.resumeGracePeriod <= 0 {
+		return
+	}
+
+	if s.streams == nil {
+		s.streams = make(map[StreamId]struct{})
+	}
+	s.streams[streamID] = struct{}{}
+}
+
+// untrackStream records that the sync operation no longer subscribes on the given stream.
+func (s *syncStreamHandlerImpl) untrackStream(streamID StreamId) {
+	if s.resumeGracePeriod <= 0 {
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.streams, streamID)
+	s.mu.Unlock()
+}
+
 func (s *syncStreamHandlerImpl) SyncID() string {
 	return s.syncID
 }
@@ -137,6 +351,13 @@ func (s *syncStreamHandlerImpl) Modify(ctx context.Context, req *ModifySyncReque
 		return nil, err
 	}
 
+	// The filter applies to the streams that are added with this request.
+	filter, err := eventbus.NewSubscriptionFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	subscriber := eventbus.WithFilter(s, filter)
+
 	var res ModifySyncResponse
 
 	for _, cookie := range req.GetAddStreams() {
@@ -144,7 +365,11 @@ func (s *syncStreamHandlerImpl) Modify(ctx context.Context, req *ModifySyncReque
 
 		// Check if the stream exists in the cache before subscribing.
 		// If not found, add the error to the response and continue with the next stream.
-		if _, err := s.streamCache.GetStreamNoWait(ctx, streamId); err != nil {
+		stream, err := s.streamCache.GetStreamNoWait(ctx, streamId)
+		if err == nil {
+			err = s.trackMessageExpiration(ctx, stream)
+		}
+		if err != nil {
 			rvrErr := AsRiverError(err)
 			res.Adds = append(res.Adds, &SyncStreamOpStatus{
 				StreamId: cookie.GetStreamId(),
@@ -154,7 +379,9 @@ func (s *syncStreamHandlerImpl) Modify(ctx context.Context, req *ModifySyncReque
 			continue
 		}
 
-		if err := s.eventBus.EnqueueSubscribe(cookie, s); err != nil {
+		s.trackStream(streamId)
+		if err := s.eventBus.EnqueueSubscribe(cookie, subscriber); err != nil {
+			s.untrackStream(streamId)
 			rvrErr := AsRiverError(err)
 			res.Adds = append(res.Adds, &SyncStreamOpStatus{
 				StreamId: cookie.GetStreamId(),
@@ -165,6 +392,7 @@ func (s *syncStreamHandlerImpl) Modify(ctx context.Context, req *ModifySyncReque
 	}
 
 	for _, streamID := range req.GetRemoveStreams() {
+		s.untrackStream(StreamId(streamID))
 		if err := s.eventBus.EnqueueUnsubscribe(StreamId(streamID), s); err != nil {
 			rvrErr := AsRiverError(err)
 			res.Removals = append(res.Removals, &SyncStreamOpStatus{
@@ -189,11 +417,45 @@ func (s *syncStreamHandlerImpl) Modify(ctx context.Context, req *ModifySyncReque
 	return &res, nil
 }
 
+// trackMessageExpiration records the message expiration of the given stream so that expired messages are
+// removed from its updates. The expiration is resolved here, in the context of the request, because resolving
+// it can require a call to a remote node which must not block the event bus.
+func (s *syncStreamHandlerImpl) trackMessageExpiration(ctx context.Context, stream *events.Stream) error {
+	if s.fullMiniblocks {
+		return nil
+	}
+
+	expiration, err := stream.MessageExpiration(ctx)
+	if err != nil || expiration <= 0 {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.expirations == nil {
+		s.expirations = make(map[StreamId]time.Duration)
+	}
+	s.expirations[stream.StreamId()] = expiration
+	s.mu.Unlock()
+	return nil
+}
+
 func (s *syncStreamHandlerImpl) Cancel(ctx context.Context) error {
 	if err := s.ctx.Err(); err != nil {
 		return err
 	}
 
+	// Without client there is nobody to send the close message to.
+	s.mu.Lock()
+	if s.resumeGracePeriod > 0 && !s.attached && s.detachedTimer != nil {
+		s.detachedTimer.Stop()
+		s.detachedTimer = nil
+		s.cancel(nil)
+		s.mu.Unlock()
+		s.close()
+		return nil
+	}
+	s.mu.Unlock()
+
 	if err := s.streamUpdates.AddMessage(&SyncStreamsResponse{
 		SyncOp: SyncOp_SYNC_CLOSE,
 	}); err != nil {
@@ -234,6 +496,7 @@ func (s *syncStreamHandlerImpl) DebugDropStream(_ context.Context, streamId Stre
 	if err := s.eventBus.EnqueueUnsubscribe(streamId, s); err != nil {
 		return err
 	}
+	s.untrackStream(streamId)
 
 	return s.streamUpdates.AddMessage(&SyncStreamsResponse{
 		SyncOp:   SyncOp_SYNC_DOWN,
@@ -248,9 +511,59 @@ func (s *syncStreamHandlerImpl) OnUpdate(update *SyncStreamsResponse) {
 		return
 	}
 
-	if err := s.streamUpdates.AddMessage(update); err != nil {
+	streamID, _ := StreamIdFromBytes(update.StreamID())
+
+	s.mu.Lock()
+	if _, dropped := s.dropped[streamID]; dropped {
+		// stale update for a stream that was dropped by a reset or the slow consumer policy
+		s.mu.Unlock()
+		return
+	}
+	if expiration, ok := s.expirations[streamID]; ok && update.GetSyncOp() == SyncOp_SYNC_UPDATE {
+		update = removeExpiredMessages(update, expiration)
+	}
+	if s.resumeGracePeriod > 0 {
+		if update.GetSyncOp() == SyncOp_SYNC_DOWN {
+			delete(s.streams, streamID)
+		}
+		if !s.attached && s.detachedTimer != nil && s.streamUpdates.Len() >= s.resumeBufferSize {
+			s.resetLocked()
+			s.mu.Unlock()
+			return
+		}
+	}
+	s.mu.Unlock()
+
+	dropped, err := s.streamUpdates.add(update)
+	if err != nil {
 		s.cancel(err)
+		return
+	}
+	if len(dropped) > 0 {
+		s.dropStreams(dropped)
+	}
+}
+
+// dropStreams unsubscribes from the given streams after the slow consumer policy replaced their queued
+// updates with a SyncOp_SYNC_DOWN message. The client is expected to add the streams again.
+func (s *syncStreamHandlerImpl) dropStreams(streamIDs []StreamId) {
+	s.mu.Lock()
+	if s.dropped == nil {
+		s.dropped = make(map[StreamId]struct{}, len(streamIDs))
 	}
+	for _, streamID := range streamIDs {
+		s.dropped[streamID] = struct{}{}
+		delete(s.streams, streamID)
+	}
+	s.mu.Unlock()
+
+	for _, streamID := range streamIDs {
+		if err := s.eventBus.EnqueueUnsubscribe(streamID, s); err != nil {
+			s.log.Errorw("failed to unsubscribe dropped stream", "streamID", streamID, "error", err)
+		}
+	}
+
+	s.log.Infow("dropped streams of slow sync client", "streams", streamIDs)
 }
 
 // processMessage processes a single message from the stream updates queue.
@@ -262,7 +575,12 @@ func (s *syncStreamHandlerImpl) processMessage(msg *SyncStreamsResponse) bool {
 
 	msg.SyncId = s.syncID
 	if err := s.receiver.Send(msg); err != nil {
-		s.cancel(err)
+		// A resumable sync operation outlives its client, only the client attachment ends.
+		if s.Resumable() && s.detachClient != nil {
+			s.detachClient(err)
+		} else {
+			s.cancel(err)
+		}
 		return true
 	}
 
@@ -274,3 +592,19 @@ func (s *syncStreamHandlerImpl) processMessage(msg *SyncStreamsResponse) bool {
 
 	return false
 }
+
+// removeExpiredMessages returns the given update without the messages that are expired. The given update is
+// shared with other subscribers and is therefore never modified.
+func removeExpiredMessages(update *SyncStreamsResponse, expiration time.Duration) *SyncStreamsResponse {
+	stream := events.RemoveExpiredMessagesFromStream(update.GetStream(), expiration, time.Now())
+	if stream == update.GetStream() {
+		return update
+	}
+	return &SyncStreamsResponse{
+		SyncId:        update.GetSyncId(),
+		SyncOp:        update.GetSyncOp(),
+		StreamId:      update.GetStreamId(),
+		TargetSyncIds: update.GetTargetSyncIds(),
+		Stream:        stream,
+	}
+}
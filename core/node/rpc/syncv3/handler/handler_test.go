@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/shared"
@@ -182,6 +183,40 @@ func TestSyncStreamHandlerImpl_Modify(t *testing.T) {
 		require.Equal(t, []string{env.handler.syncID, "backfill-sync"}, backfills[0].syncIDs)
 	})
 
+	t.Run("filtered subscription", func(t *testing.T) {
+		env := newHandlerTestEnv(t)
+
+		addID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		env.streamCache.AddStream(addID)
+
+		res, err := env.handler.Modify(t.Context(), &protocol.ModifySyncRequest{
+			AddStreams: []*protocol.SyncCookie{{StreamId: addID.Bytes()}},
+			Filter:     &protocol.SyncFilter{ExcludePayloadCases: []string{"member_payload.key_solicitation"}},
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Adds)
+
+		subs := env.eventBus.SubscribeCalls()
+		require.Len(t, subs, 1)
+		require.NotSame(t, env.handler, subs[0].subscriber)
+		require.Equal(t, env.handler.SyncID(), subs[0].subscriber.SyncID())
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		env := newHandlerTestEnv(t)
+
+		addID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		env.streamCache.AddStream(addID)
+
+		_, err := env.handler.Modify(t.Context(), &protocol.ModifySyncRequest{
+			AddStreams: []*protocol.SyncCookie{{StreamId: addID.Bytes()}},
+			Filter:     &protocol.SyncFilter{PayloadCases: []string{"no_such_payload"}},
+		})
+		require.Error(t, err)
+		require.Equal(t, protocol.Err_INVALID_ARGUMENT, base.AsRiverError(err).Code)
+		require.Empty(t, env.eventBus.SubscribeCalls())
+	})
+
 	t.Run("operation errors collected", func(t *testing.T) {
 		env := newHandlerTestEnv(t)
 
@@ -434,3 +469,166 @@ func TestSyncStreamHandlerImpl_ProcessMessage(t *testing.T) {
 		require.Nil(t, env.cancelErrAt(0))
 	})
 }
+
+func TestSyncStreamHandlerImpl_Resume(t *testing.T) {
+	newResumableHandler := func(cfg config.SyncConfig) (*syncStreamHandlerRegistryImpl, *fakeEventBus) {
+		bus := newFakeEventBus()
+		return NewRegistry(newStubStreamCache(), bus, nil, cfg).(*syncStreamHandlerRegistryImpl), bus
+	}
+
+	streamUpdate := func(streamID shared.StreamId) *protocol.SyncStreamsResponse {
+		return &protocol.SyncStreamsResponse{
+			SyncOp: protocol.SyncOp_SYNC_UPDATE,
+			Stream: &protocol.StreamAndCookie{NextSyncCookie: &protocol.SyncCookie{StreamId: streamID[:]}},
+		}
+	}
+
+	// attach runs fn in the background and returns a function that disconnects the client and waits for fn.
+	attach := func(t *testing.T, fn func(ctx context.Context) error) func() error {
+		ctx, cancel := context.WithCancel(t.Context())
+		done := make(chan error, 1)
+		go func() { done <- fn(ctx) }()
+		return func() error {
+			cancel()
+			return <-done
+		}
+	}
+
+	t.Run("replays buffered updates", func(t *testing.T) {
+		registry, _ := newResumableHandler(config.SyncConfig{ResumeGracePeriod: time.Minute})
+		streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+		receiver := newFakeReceiver()
+		disconnect := attach(t, func(ctx context.Context) error {
+			h, err := registry.New(ctx, "sync-resume", receiver, false)
+			require.NoError(t, err)
+			return h.Run()
+		})
+		require.Eventually(t, func() bool { return len(receiver.Messages()) == 1 }, time.Second, time.Millisecond)
+		require.ErrorIs(t, disconnect(), context.Canceled)
+
+		h, ok := registry.Get("sync-resume")
+		require.True(t, ok)
+		require.True(t, h.Resumable())
+
+		// updates are buffered while the client is disconnected
+		h.(*syncStreamHandlerImpl).OnUpdate(streamUpdate(streamID))
+
+		resumed := newFakeReceiver()
+		disconnect = attach(t, func(ctx context.Context) error { return h.Resume(ctx, resumed) })
+		require.Eventually(t, func() bool { return len(resumed.Messages()) == 2 }, time.Second, time.Millisecond)
+
+		// only a single client can be attached
+		require.Error(t, h.Resume(t.Context(), newFakeReceiver()))
+
+		msgs := resumed.Messages()
+		require.Equal(t, protocol.SyncOp_SYNC_NEW, msgs[0].GetSyncOp())
+		require.Equal(t, "sync-resume", msgs[0].GetSyncId())
+		require.Equal(t, protocol.SyncOp_SYNC_UPDATE, msgs[1].GetSyncOp())
+		require.Equal(t, streamID[:], msgs[1].StreamID())
+		require.ErrorIs(t, disconnect(), context.Canceled)
+
+		// cancelling a detached sync operation removes it
+		require.NoError(t, h.Cancel(t.Context()))
+		_, ok = registry.Get("sync-resume")
+		require.False(t, ok)
+	})
+
+	t.Run("keeps messages that were not delivered", func(t *testing.T) {
+		registry, _ := newResumableHandler(config.SyncConfig{ResumeGracePeriod: time.Minute})
+		streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+		receiver := newFakeReceiver()
+		sendErr := errors.New("connection lost")
+		receiver.SetErrAt(2, sendErr)
+
+		h, err := registry.New(t.Context(), "sync-send-failure", receiver, false)
+		require.NoError(t, err)
+		h.(*syncStreamHandlerImpl).OnUpdate(streamUpdate(streamID))
+		require.ErrorIs(t, h.Run(), sendErr)
+		require.True(t, h.Resumable())
+
+		resumed := newFakeReceiver()
+		disconnect := attach(t, func(ctx context.Context) error { return h.Resume(ctx, resumed) })
+		require.Eventually(t, func() bool { return len(resumed.Messages()) == 2 }, time.Second, time.Millisecond)
+		require.Equal(t, streamID[:], resumed.Messages()[1].StreamID())
+		require.ErrorIs(t, disconnect(), context.Canceled)
+	})
+
+	t.Run("buffer overflow resets", func(t *testing.T) {
+		registry, bus := newResumableHandler(config.SyncConfig{ResumeGracePeriod: time.Minute, ResumeBufferSize: 2})
+		streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+		registry.streamCache.(*stubStreamCache).AddStream(streamID)
+
+		receiver := newFakeReceiver()
+		var h SyncStreamHandler
+		disconnect := attach(t, func(ctx context.Context) error {
+			var err error
+			h, err = registry.New(ctx, "sync-reset", receiver, false)
+			require.NoError(t, err)
+			res, err := h.Modify(ctx, &protocol.ModifySyncRequest{
+				AddStreams: []*protocol.SyncCookie{{StreamId: streamID[:]}},
+			})
+			require.NoError(t, err)
+			require.Empty(t, res.GetAdds())
+			return h.Run()
+		})
+		require.Eventually(t, func() bool { return len(receiver.Messages()) == 1 }, time.Second, time.Millisecond)
+		require.ErrorIs(t, disconnect(), context.Canceled)
+		require.Empty(t, bus.RemoveCalls())
+
+		impl := h.(*syncStreamHandlerImpl)
+		for range 3 {
+			impl.OnUpdate(streamUpdate(streamID))
+		}
+		require.Equal(t, []string{"sync-reset"}, bus.RemoveCalls())
+
+		// stale updates of the dropped stream are ignored
+		impl.OnUpdate(streamUpdate(streamID))
+		require.Equal(t, 0, impl.streamUpdates.Len())
+
+		resumed := newFakeReceiver()
+		disconnect = attach(t, func(ctx context.Context) error { return h.Resume(ctx, resumed) })
+		require.Eventually(t, func() bool { return len(resumed.Messages()) == 2 }, time.Second, time.Millisecond)
+
+		msgs := resumed.Messages()
+		require.Equal(t, protocol.SyncOp_SYNC_NEW, msgs[0].GetSyncOp())
+		require.Equal(t, protocol.SyncOp_SYNC_DOWN, msgs[1].GetSyncOp())
+		require.Equal(t, streamID[:], msgs[1].GetStreamId())
+		require.ErrorIs(t, disconnect(), context.Canceled)
+	})
+
+	t.Run("expires after grace period", func(t *testing.T) {
+		registry, bus := newResumableHandler(config.SyncConfig{ResumeGracePeriod: 10 * time.Millisecond})
+
+		receiver := newFakeReceiver()
+		receiver.SetErr(errors.New("connection lost"))
+		h, err := registry.New(t.Context(), "sync-expire", receiver, false)
+		require.NoError(t, err)
+		require.Error(t, h.Run())
+
+		require.Eventually(t, func() bool {
+			_, ok := registry.Get("sync-expire")
+			return !ok
+		}, time.Second, time.Millisecond)
+		require.False(t, h.Resumable())
+		require.Equal(t, []string{"sync-expire"}, bus.RemoveCalls())
+
+		err = h.Resume(t.Context(), newFakeReceiver())
+		var riverErr *base.RiverErrorImpl
+		require.True(t, errors.As(err, &riverErr))
+		require.Equal(t, protocol.Err_NOT_FOUND, riverErr.Code)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		registry, _ := newResumableHandler(config.SyncConfig{})
+
+		receiver := newFakeReceiver()
+		receiver.SetErr(errors.New("connection lost"))
+		h, err := registry.New(t.Context(), "sync-no-resume", receiver, false)
+		require.NoError(t, err)
+		require.Error(t, h.Run())
+		require.False(t, h.Resumable())
+		require.Error(t, h.Resume(t.Context(), newFakeReceiver()))
+	})
+}
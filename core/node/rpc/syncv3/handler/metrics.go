@@ -19,4 +19,14 @@ func (s *syncStreamHandlerRegistryImpl) runMetricsCollector(metrics infra.Metric
 			return float64(count)
 		},
 	)
+
+	metrics.NewGaugeFunc(
+		prometheus.GaugeOpts{
+			Name: "stream_syncv3_send_queue_bytes",
+			Help: "Encoded size of the updates queued for all sync v3 operations and not yet sent to the clients",
+		},
+		func() float64 {
+			return float64(s.Stats().PendingBytes)
+		},
+	)
 }
@@ -4,12 +4,12 @@ import (
 	"context"
 	"sync"
 
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/infra"
 	"github.com/towns-protocol/towns/core/node/logging"
 	. "github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/rpc/syncv3/eventbus"
-	"github.com/towns-protocol/towns/core/node/utils/dynmsgbuf"
 )
 
 var _ Registry = (*syncStreamHandlerRegistryImpl)(nil)
@@ -21,11 +21,28 @@ type Registry interface {
 	// Get sync stream handler by sync id.
 	Get(syncID string) (SyncStreamHandler, bool)
 
-	// New creates a new sync stream handler.
-	New(ctx context.Context, syncID string, receiver Receiver) (SyncStreamHandler, error)
+	// New creates a new sync stream handler. If fullMiniblocks is set expired DM and GDM messages are
+	// not removed from the updates, it is set for nodes and node services.
+	New(ctx context.Context, syncID string, receiver Receiver, fullMiniblocks bool) (SyncStreamHandler, error)
 
 	// Remove removes the sync stream handler from the registry by the given sync ID.
 	Remove(syncID string)
+
+	// Stats returns a snapshot of the active sync operations.
+	Stats() RegistryStats
+}
+
+// RegistryStats describes the active sync operations in the registry.
+type RegistryStats struct {
+	// Sessions is the number of active sync operations.
+	Sessions int
+	// PendingMessages is the number of messages that are buffered for all sync operations
+	// and not yet sent to the clients.
+	PendingMessages int
+	// LargestPendingMessages is the largest number of buffered messages of a single sync operation.
+	LargestPendingMessages int
+	// PendingBytes is the encoded size of the messages that are buffered for all sync operations.
+	PendingBytes int
 }
 
 type syncStreamHandlerRegistryImpl struct {
@@ -33,20 +50,26 @@ type syncStreamHandlerRegistryImpl struct {
 	handlers     map[string]*syncStreamHandlerImpl
 	eventBus     eventbus.StreamSubscriptionManager
 	streamCache  StreamCache
+	cfg          config.SyncConfig
+	// sendQueueMetrics is nil when metrics are disabled.
+	sendQueueMetrics *sendQueueMetrics
 }
 
 func NewRegistry(
 	streamCache StreamCache,
 	eventBus eventbus.StreamSubscriptionManager,
 	metrics infra.MetricsFactory,
+	cfg config.SyncConfig,
 ) Registry {
 	h := &syncStreamHandlerRegistryImpl{
 		handlers:    make(map[string]*syncStreamHandlerImpl),
 		eventBus:    eventBus,
 		streamCache: streamCache,
+		cfg:         cfg,
 	}
 
 	if metrics != nil {
+		h.sendQueueMetrics = newSendQueueMetrics(metrics)
 		h.runMetricsCollector(metrics)
 	}
 
@@ -60,6 +83,20 @@ func (s *syncStreamHandlerRegistryImpl) Get(syncID string) (SyncStreamHandler, b
 	return handler, ok
 }
 
+func (s *syncStreamHandlerRegistryImpl) Stats() RegistryStats {
+	s.handlersLock.Lock()
+	defer s.handlersLock.Unlock()
+
+	stats := RegistryStats{Sessions: len(s.handlers)}
+	for _, handler := range s.handlers {
+		pending := handler.streamUpdates.Len()
+		stats.PendingMessages += pending
+		stats.LargestPendingMessages = max(stats.LargestPendingMessages, pending)
+		stats.PendingBytes += handler.streamUpdates.Bytes()
+	}
+	return stats
+}
+
 func (s *syncStreamHandlerRegistryImpl) Remove(syncID string) {
 	var handler *syncStreamHandlerImpl
 
@@ -89,6 +126,7 @@ func (s *syncStreamHandlerRegistryImpl) New(
 	ctx context.Context,
 	syncID string,
 	receiver Receiver,
+	fullMiniblocks bool,
 ) (SyncStreamHandler, error) {
 	s.handlersLock.Lock()
 	defer s.handlersLock.Unlock()
@@ -97,17 +135,27 @@ func (s *syncStreamHandlerRegistryImpl) New(
 		return nil, RiverError(Err_ALREADY_EXISTS, "sync operation with the given ID already exists")
 	}
 
+	clientCtx := ctx
+	if s.cfg.ResumeGracePeriod > 0 {
+		// A resumable sync operation outlives the client connection that started it.
+		ctx = context.WithoutCancel(ctx)
+	}
 	ctx, cancel := context.WithCancelCause(ctx)
 
 	handler := &syncStreamHandlerImpl{
-		ctx:           ctx,
-		cancel:        cancel,
-		log:           logging.FromCtx(ctx).Named("syncv3.handler").With("syncID", syncID),
-		syncID:        syncID,
-		receiver:      receiver,
-		eventBus:      s.eventBus,
-		streamUpdates: dynmsgbuf.NewDynamicBuffer[*SyncStreamsResponse](),
-		streamCache:   s.streamCache,
+		ctx:               ctx,
+		cancel:            cancel,
+		log:               logging.FromCtx(ctx).Named("syncv3.handler").With("syncID", syncID),
+		syncID:            syncID,
+		receiver:          receiver,
+		eventBus:          s.eventBus,
+		streamUpdates:     newSendQueue(s.cfg.SendQueue, s.sendQueueMetrics),
+		streamCache:       s.streamCache,
+		clientCtx:         clientCtx,
+		resumeGracePeriod: s.cfg.ResumeGracePeriod,
+		resumeBufferSize:  s.cfg.GetResumeBufferSize(),
+		onExpire:          func() { s.Remove(syncID) },
+		fullMiniblocks:    fullMiniblocks,
 	}
 
 	s.handlers[syncID] = handler
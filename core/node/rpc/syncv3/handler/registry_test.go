@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
+	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/protocol"
 )
@@ -15,7 +17,7 @@ func TestSyncStreamHandlerRegistry_NewGetRemove(t *testing.T) {
 	metrics := newCapturingMetrics()
 	bus := newFakeEventBus()
 	streamCache := newStubStreamCache()
-	registry := NewRegistry(streamCache, bus, metrics)
+	registry := NewRegistry(streamCache, bus, metrics, config.SyncConfig{})
 	impl := registry.(*syncStreamHandlerRegistryImpl)
 
 	handler, ok := impl.Get("missing")
@@ -25,14 +27,15 @@ func TestSyncStreamHandlerRegistry_NewGetRemove(t *testing.T) {
 	recv := &nopReceiver{}
 
 	ctx := context.Background()
-	h, err := impl.New(ctx, "sync-1", recv)
+	h, err := impl.New(ctx, "sync-1", recv, false)
 	require.NoError(t, err)
 	require.NotNil(t, h)
 
 	captured := metrics.gaugeSnapshot()
-	require.Len(t, captured.opts, 1)
+	require.Len(t, captured.opts, 2)
 	require.Equal(t, "stream_syncv3_sync_ops_count", captured.opts[0].Name)
-	require.Len(t, captured.fns, 1)
+	require.Equal(t, "stream_syncv3_send_queue_bytes", captured.opts[1].Name)
+	require.Len(t, captured.fns, 2)
 	require.Equal(t, 1.0, captured.fns[0]())
 
 	got, ok := impl.Get("sync-1")
@@ -54,7 +57,7 @@ func TestSyncStreamHandlerRegistry_NewGetRemove(t *testing.T) {
 	require.False(t, ok)
 
 	captured = metrics.gaugeSnapshot()
-	require.Len(t, captured.fns, 1)
+	require.Len(t, captured.fns, 2)
 	require.Equal(t, 0.0, captured.fns[0]())
 
 	// Removing again should be a noop without panics or errors.
@@ -63,18 +66,44 @@ func TestSyncStreamHandlerRegistry_NewGetRemove(t *testing.T) {
 
 func TestSyncStreamHandlerRegistry_NewDuplicate(t *testing.T) {
 	metrics := newCapturingMetrics()
-	registry := NewRegistry(newStubStreamCache(), newFakeEventBus(), metrics)
+	registry := NewRegistry(newStubStreamCache(), newFakeEventBus(), metrics, config.SyncConfig{})
 	impl := registry.(*syncStreamHandlerRegistryImpl)
 
 	recv := nopReceiver{}
 
-	_, err := impl.New(context.Background(), "sync-dup", recv)
+	_, err := impl.New(context.Background(), "sync-dup", recv, false)
 	require.NoError(t, err)
 
-	_, err = impl.New(context.Background(), "sync-dup", recv)
+	_, err = impl.New(context.Background(), "sync-dup", recv, false)
 	require.Error(t, err)
 
 	var riverErr *base.RiverErrorImpl
 	require.True(t, errors.As(err, &riverErr))
 	require.Equal(t, protocol.Err_ALREADY_EXISTS, riverErr.Code)
 }
+
+func TestSyncStreamHandlerRegistry_Stats(t *testing.T) {
+	registry := NewRegistry(newStubStreamCache(), newFakeEventBus(), nil, config.SyncConfig{})
+	require.Equal(t, RegistryStats{}, registry.Stats())
+
+	ctx := context.Background()
+	h1, err := registry.New(ctx, "sync-1", &nopReceiver{}, false)
+	require.NoError(t, err)
+	_, err = registry.New(ctx, "sync-2", &nopReceiver{}, false)
+	require.NoError(t, err)
+
+	// Updates are buffered until the handler runs.
+	h1.Ping(ctx, "nonce-1")
+	h1.Ping(ctx, "nonce-2")
+
+	pongSize := proto.Size(&protocol.SyncStreamsResponse{SyncOp: protocol.SyncOp_SYNC_PONG, PongNonce: "nonce-1"})
+	require.Equal(t, RegistryStats{
+		Sessions:               2,
+		PendingMessages:        2,
+		LargestPendingMessages: 2,
+		PendingBytes:           2 * pongSize,
+	}, registry.Stats())
+
+	registry.Remove("sync-1")
+	require.Equal(t, RegistryStats{Sessions: 1}, registry.Stats())
+}
@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/config"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+// sendQueueMetrics counts the actions taken when the send queue of a sync operation overflows.
+type sendQueueMetrics struct {
+	coalesced prometheus.Counter
+	dropped   prometheus.Counter
+	cancelled prometheus.Counter
+}
+
+func newSendQueueMetrics(metrics infra.MetricsFactory) *sendQueueMetrics {
+	actions := metrics.NewCounterVecEx(
+		"stream_syncv3_slow_consumer_actions",
+		"Actions taken on sync operations of which the send queue is full",
+		"action",
+	)
+	return &sendQueueMetrics{
+		coalesced: actions.WithLabelValues("coalesce"),
+		dropped:   actions.WithLabelValues("drop_stream"),
+		cancelled: actions.WithLabelValues("cancel"),
+	}
+}
+
+// sendQueue holds the messages of a sync operation that are not yet sent to the client.
+// It is limited in the number of messages and their encoded size, when an update doesn't fit
+// the configured slow consumer policy is applied.
+//
+// Control messages, everything except SyncOp_SYNC_UPDATE, are always queued. There is at most one
+// SyncOp_SYNC_DOWN message per stream and the client controls the number of pong messages.
+type sendQueue struct {
+	mu sync.Mutex
+	// msgs and sizes are nil when the queue is closed.
+	msgs  []*SyncStreamsResponse
+	sizes []int
+	bytes int
+
+	maxMessages int
+	maxBytes    int
+	policy      string
+	metrics     *sendQueueMetrics
+	signal      chan struct{}
+}
+
+func newSendQueue(cfg config.SyncSendQueueConfig, metrics *sendQueueMetrics) *sendQueue {
+	return &sendQueue{
+		msgs:        make([]*SyncStreamsResponse, 0, 16),
+		sizes:       make([]int, 0, 16),
+		maxMessages: cfg.GetMaxMessages(),
+		maxBytes:    cfg.GetMaxBytes(),
+		policy:      cfg.GetPolicy(),
+		metrics:     metrics,
+		signal:      make(chan struct{}, 1),
+	}
+}
+
+// AddMessage adds the given message to the queue. Streams dropped by the slow consumer policy are ignored,
+// use add to learn about them.
+func (q *sendQueue) AddMessage(msg *SyncStreamsResponse) error {
+	_, err := q.add(msg)
+	return err
+}
+
+// add adds the given message to the queue and returns the streams that were dropped to make room for it.
+// The message itself is not queued when its stream was dropped. Returns an error if the queue is closed or
+// full and the policy is to cancel the sync operation.
+func (q *sendQueue) add(msg *SyncStreamsResponse) ([]StreamId, error) {
+	size := proto.Size(msg)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.msgs == nil {
+		return nil, RiverError(Err_UNAVAILABLE, "Message buffer is closed").Func("sendQueue.add")
+	}
+
+	if msg.GetSyncOp() != SyncOp_SYNC_UPDATE || q.fits(1, size) {
+		q.push(msg, size)
+		return nil, nil
+	}
+
+	switch q.policy {
+	case config.SlowConsumerPolicyCoalesce:
+		if q.coalesce(msg, size) {
+			return nil, nil
+		}
+		return q.drop(msg, size), nil
+	case config.SlowConsumerPolicyDrop:
+		return q.drop(msg, size), nil
+	default:
+		if q.metrics != nil {
+			q.metrics.cancelled.Inc()
+		}
+		return nil, RiverError(Err_BUFFER_FULL, "Message buffer is full").
+			Tags("maxMessages", q.maxMessages, "maxBytes", q.maxBytes, "bytes", q.bytes).
+			Func("sendQueue.add")
+	}
+}
+
+func (q *sendQueue) fits(msgs int, bytes int) bool {
+	return len(q.msgs)+msgs <= q.maxMessages && q.bytes+bytes <= q.maxBytes
+}
+
+func (q *sendQueue) push(msg *SyncStreamsResponse, size int) {
+	q.msgs = append(q.msgs, msg)
+	q.sizes = append(q.sizes, size)
+	q.bytes += size
+	q.notify()
+}
+
+func (q *sendQueue) notify() {
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+// coalesce merges the given update into the last queued update of the same stream.
+// Returns false if there is no such update or the queue is over its byte limit after merging.
+func (q *sendQueue) coalesce(msg *SyncStreamsResponse, size int) bool {
+	if len(msg.GetTargetSyncIds()) > 0 {
+		return false
+	}
+
+	streamID := msg.StreamID()
+	i := len(q.msgs) - 1
+	for ; i >= 0; i-- {
+		if slices.Equal(q.msgs[i].StreamID(), streamID) {
+			break
+		}
+	}
+	if i < 0 {
+		return false
+	}
+
+	prev := q.msgs[i]
+	if prev.GetSyncOp() != SyncOp_SYNC_UPDATE || len(prev.GetTargetSyncIds()) > 0 {
+		return false
+	}
+
+	merged := mergeUpdates(prev, msg)
+	mergedSize := proto.Size(merged)
+	if q.bytes-q.sizes[i]+mergedSize > q.maxBytes {
+		return false
+	}
+
+	q.msgs[i] = merged
+	q.bytes += mergedSize - q.sizes[i]
+	q.sizes[i] = mergedSize
+	if q.metrics != nil {
+		q.metrics.coalesced.Inc()
+	}
+	q.notify()
+	return true
+}
+
+// mergeUpdates returns a single update that is equivalent to sending prev and next. Updates are shared
+// between subscribers and are therefore not modified.
+func mergeUpdates(prev, next *SyncStreamsResponse) *SyncStreamsResponse {
+	// A reset replaces everything the client received before.
+	if next.GetStream().GetSyncReset() {
+		return next
+	}
+
+	prevStream, nextStream := prev.GetStream(), next.GetStream()
+	return &SyncStreamsResponse{
+		SyncOp:   SyncOp_SYNC_UPDATE,
+		StreamId: prev.GetStreamId(),
+		Stream: &StreamAndCookie{
+			Events:                 slices.Concat(prevStream.GetEvents(), nextStream.GetEvents()),
+			NextSyncCookie:         nextStream.GetNextSyncCookie(),
+			Miniblocks:             slices.Concat(prevStream.GetMiniblocks(), nextStream.GetMiniblocks()),
+			SyncReset:              prevStream.GetSyncReset(),
+			Snapshot:               prevStream.Snapshot,
+			SnapshotMiniblockIndex: prevStream.GetSnapshotMiniblockIndex(),
+		},
+	}
+}
+
+// drop removes the queued updates of streams until the given update fits. When the queue has too many
+// messages the stream with the most queued updates is dropped, otherwise the stream with the most queued bytes.
+// A SyncOp_SYNC_DOWN message is queued for each dropped stream. The given update is queued unless its own
+// stream is dropped.
+func (q *sendQueue) drop(msg *SyncStreamsResponse, size int) []StreamId {
+	streamID, _ := StreamIdFromBytes(msg.StreamID())
+
+	type queued struct {
+		msgs  int
+		bytes int
+	}
+
+	var dropped []StreamId
+	for !q.fits(1, size) {
+		// Count the queued updates per stream, the given update included.
+		streams := map[StreamId]queued{streamID: {msgs: 1, bytes: size}}
+		for i, m := range q.msgs {
+			if m.GetSyncOp() != SyncOp_SYNC_UPDATE || len(m.GetTargetSyncIds()) > 0 {
+				continue
+			}
+			id, err := StreamIdFromBytes(m.StreamID())
+			if err != nil {
+				continue
+			}
+			s := streams[id]
+			s.msgs++
+			s.bytes += q.sizes[i]
+			streams[id] = s
+		}
+
+		tooMany := len(q.msgs)+1 > q.maxMessages
+		largest := streamID
+		for id, s := range streams {
+			if (tooMany && s.msgs > streams[largest].msgs) || (!tooMany && s.bytes > streams[largest].bytes) {
+				largest = id
+			}
+		}
+		// Replacing a single update with a SyncOp_SYNC_DOWN message doesn't free a slot.
+		if tooMany && streams[largest].msgs <= 1 {
+			largest = streamID
+		}
+
+		q.dropStream(largest)
+		dropped = append(dropped, largest)
+		if q.metrics != nil {
+			q.metrics.dropped.Inc()
+		}
+
+		if largest == streamID {
+			return dropped
+		}
+	}
+
+	q.push(msg, size)
+	return dropped
+}
+
+// dropStream replaces the queued updates of the given stream with a SyncOp_SYNC_DOWN message.
+func (q *sendQueue) dropStream(streamID StreamId) {
+	n := 0
+	for i, m := range q.msgs {
+		if m.GetSyncOp() == SyncOp_SYNC_UPDATE && len(m.GetTargetSyncIds()) == 0 &&
+			slices.Equal(m.StreamID(), streamID[:]) {
+			q.bytes -= q.sizes[i]
+			continue
+		}
+		q.msgs[n], q.sizes[n] = m, q.sizes[i]
+		n++
+	}
+	clear(q.msgs[n:])
+	q.msgs, q.sizes = q.msgs[:n], q.sizes[:n]
+
+	down := &SyncStreamsResponse{SyncOp: SyncOp_SYNC_DOWN, StreamId: streamID[:]}
+	q.push(down, proto.Size(down))
+}
+
+// GetBatch returns all queued messages and empties the queue. The given slice is reused when possible.
+// Returns nil when the queue is closed.
+func (q *sendQueue) GetBatch(prev []*SyncStreamsResponse) []*SyncStreamsResponse {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.msgs == nil {
+		return nil
+	}
+
+	ret := q.msgs
+	clear(prev)
+	q.msgs = prev[:0]
+	if q.msgs == nil {
+		q.msgs = make([]*SyncStreamsResponse, 0, 16)
+	}
+	q.sizes = q.sizes[:0]
+	q.bytes = 0
+	return ret
+}
+
+// Len returns the number of queued messages.
+func (q *sendQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.msgs)
+}
+
+// Bytes returns the encoded size of the queued messages.
+func (q *sendQueue) Bytes() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.bytes
+}
+
+// Wait returns a channel that receives a signal when messages are added or the queue is closed.
+func (q *sendQueue) Wait() <-chan struct{} {
+	return q.signal
+}
+
+// Close closes the queue and drops the queued messages.
+func (q *sendQueue) Close() {
+	q.mu.Lock()
+	q.msgs, q.sizes, q.bytes = nil, nil, 0
+	q.mu.Unlock()
+	q.notify()
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func streamUpdate(streamID shared.StreamId, gen int64, events int) *protocol.SyncStreamsResponse {
+	envelopes := make([]*protocol.Envelope, events)
+	for i := range envelopes {
+		envelopes[i] = &protocol.Envelope{Hash: []byte{byte(gen), byte(i)}, Event: make([]byte, 100)}
+	}
+	return &protocol.SyncStreamsResponse{
+		SyncOp: protocol.SyncOp_SYNC_UPDATE,
+		Stream: &protocol.StreamAndCookie{
+			Events:         envelopes,
+			NextSyncCookie: &protocol.SyncCookie{StreamId: streamID[:], MinipoolGen: gen},
+		},
+	}
+}
+
+func newTestSendQueue(cfg config.SyncSendQueueConfig) (*sendQueue, *sendQueueMetrics) {
+	metrics := newSendQueueMetrics(infra.NewMetricsFactory(prometheus.NewRegistry(), "", ""))
+	return newSendQueue(cfg, metrics), metrics
+}
+
+func TestSendQueue_Cancel(t *testing.T) {
+	q, metrics := newTestSendQueue(config.SyncSendQueueConfig{MaxMessages: 2})
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	require.NoError(t, q.AddMessage(streamUpdate(streamID, 1, 1)))
+	require.NoError(t, q.AddMessage(streamUpdate(streamID, 2, 1)))
+
+	err := q.AddMessage(streamUpdate(streamID, 3, 1))
+	require.True(t, base.IsRiverErrorCode(err, protocol.Err_BUFFER_FULL))
+	require.EqualValues(t, 1, testutil.ToFloat64(metrics.cancelled))
+
+	// control messages are always queued
+	require.NoError(t, q.AddMessage(&protocol.SyncStreamsResponse{SyncOp: protocol.SyncOp_SYNC_PONG}))
+	require.Equal(t, 3, q.Len())
+}
+
+func TestSendQueue_Coalesce(t *testing.T) {
+	q, metrics := newTestSendQueue(config.SyncSendQueueConfig{
+		MaxMessages: 2,
+		Policy:      config.SlowConsumerPolicyCoalesce,
+	})
+	stream1 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	stream2 := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	first := streamUpdate(stream1, 1, 2)
+	require.NoError(t, q.AddMessage(first))
+	require.NoError(t, q.AddMessage(streamUpdate(stream2, 1, 1)))
+
+	dropped, err := q.add(streamUpdate(stream1, 2, 3))
+	require.NoError(t, err)
+	require.Empty(t, dropped)
+	require.EqualValues(t, 1, testutil.ToFloat64(metrics.coalesced))
+
+	msgs := q.GetBatch(nil)
+	require.Len(t, msgs, 2)
+	require.Len(t, msgs[0].GetStream().GetEvents(), 5)
+	require.EqualValues(t, 2, msgs[0].GetStream().GetNextSyncCookie().GetMinipoolGen())
+	require.Equal(t, stream1[:], msgs[0].StreamID())
+
+	// the queued update is shared with other subscribers and must not be modified
+	require.Len(t, first.GetStream().GetEvents(), 2)
+	require.Zero(t, q.Bytes())
+}
+
+func TestSendQueue_CoalesceReset(t *testing.T) {
+	q, _ := newTestSendQueue(config.SyncSendQueueConfig{MaxMessages: 1, Policy: config.SlowConsumerPolicyCoalesce})
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	require.NoError(t, q.AddMessage(streamUpdate(streamID, 1, 2)))
+	reset := streamUpdate(streamID, 2, 1)
+	reset.Stream.SyncReset = true
+	require.NoError(t, q.AddMessage(reset))
+
+	msgs := q.GetBatch(nil)
+	require.Len(t, msgs, 1)
+	require.Same(t, reset, msgs[0])
+}
+
+func TestSendQueue_Drop(t *testing.T) {
+	update := streamUpdate(testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN), 1, 1)
+	q, metrics := newTestSendQueue(config.SyncSendQueueConfig{
+		MaxBytes: 4 * proto.Size(update),
+		Policy:   config.SlowConsumerPolicyDrop,
+	})
+	busy := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	quiet := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	require.NoError(t, q.AddMessage(streamUpdate(busy, 1, 1)))
+	require.NoError(t, q.AddMessage(streamUpdate(quiet, 1, 1)))
+	require.NoError(t, q.AddMessage(streamUpdate(busy, 2, 1)))
+	require.NoError(t, q.AddMessage(streamUpdate(busy, 3, 1)))
+
+	// the stream with the most queued bytes is dropped to make room for the update
+	dropped, err := q.add(streamUpdate(quiet, 2, 1))
+	require.NoError(t, err)
+	require.Equal(t, []shared.StreamId{busy}, dropped)
+	require.EqualValues(t, 1, testutil.ToFloat64(metrics.dropped))
+
+	msgs := q.GetBatch(nil)
+	require.Len(t, msgs, 3)
+	require.Equal(t, quiet[:], msgs[0].StreamID())
+	require.Equal(t, protocol.SyncOp_SYNC_DOWN, msgs[1].GetSyncOp())
+	require.Equal(t, busy[:], msgs[1].GetStreamId())
+	require.Equal(t, quiet[:], msgs[2].StreamID())
+}
+
+func TestSendQueue_DropOwnStream(t *testing.T) {
+	q, _ := newTestSendQueue(config.SyncSendQueueConfig{MaxMessages: 2, Policy: config.SlowConsumerPolicyDrop})
+	streamID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	require.NoError(t, q.AddMessage(streamUpdate(streamID, 1, 1)))
+	require.NoError(t, q.AddMessage(streamUpdate(streamID, 2, 1)))
+
+	// the stream of the update is the only one with queued updates, it is replaced by a SYNC_DOWN message
+	dropped, err := q.add(streamUpdate(streamID, 3, 1))
+	require.NoError(t, err)
+	require.Equal(t, []shared.StreamId{streamID}, dropped)
+
+	msgs := q.GetBatch(nil)
+	require.Len(t, msgs, 1)
+	require.Equal(t, protocol.SyncOp_SYNC_DOWN, msgs[0].GetSyncOp())
+}
+
+func TestSendQueue_Closed(t *testing.T) {
+	q, _ := newTestSendQueue(config.SyncSendQueueConfig{})
+	q.Close()
+	require.Error(t, q.AddMessage(&protocol.SyncStreamsResponse{SyncOp: protocol.SyncOp_SYNC_PONG}))
+	require.Nil(t, q.GetBatch(nil))
+}
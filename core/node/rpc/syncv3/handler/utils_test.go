@@ -9,6 +9,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/towns-protocol/towns/core/config"
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/infra"
@@ -16,7 +17,6 @@ import (
 	"github.com/towns-protocol/towns/core/node/protocol"
 	"github.com/towns-protocol/towns/core/node/rpc/syncv3/eventbus"
 	"github.com/towns-protocol/towns/core/node/shared"
-	"github.com/towns-protocol/towns/core/node/utils/dynmsgbuf"
 )
 
 type handlerTestEnv struct {
@@ -24,7 +24,7 @@ type handlerTestEnv struct {
 	receiver      *fakeReceiver
 	eventBus      *fakeEventBus
 	streamCache   *stubStreamCache
-	streamUpdates *dynmsgbuf.DynamicBuffer[*protocol.SyncStreamsResponse]
+	streamUpdates *sendQueue
 	ctx           context.Context
 
 	cancelMu   sync.Mutex
@@ -38,7 +38,7 @@ func newHandlerTestEnv(t *testing.T) *handlerTestEnv {
 	receiver := newFakeReceiver()
 	eventBus := newFakeEventBus()
 	streamCache := newStubStreamCache()
-	streamUpdates := dynmsgbuf.NewUnboundedDynamicBuffer[*protocol.SyncStreamsResponse]()
+	streamUpdates := newSendQueue(config.SyncSendQueueConfig{}, nil)
 
 	ctx, baseCancel := context.WithCancelCause(context.Background())
 
@@ -67,6 +67,7 @@ func newHandlerTestEnv(t *testing.T) *handlerTestEnv {
 		eventBus:      eventBus,
 		streamUpdates: streamUpdates,
 		streamCache:   streamCache,
+		clientCtx:     ctx,
 	}
 
 	return env
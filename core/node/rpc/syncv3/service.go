@@ -6,6 +6,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/towns-protocol/towns/core/config"
 	. "github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/events"
 	"github.com/towns-protocol/towns/core/node/infra"
@@ -21,7 +22,20 @@ type Service interface {
 	// SyncStreams creates and starts a sync operation. Given streams will be added
 	// to the sync operation. If the function returns without error, it is gurarnteed that the given recipient
 	// will receive >= 1 update for each stream (either backfill or stream down message).
-	SyncStreams(ctx context.Context, syncID string, streams []*SyncCookie, rec handler.Receiver) error
+	// The optional filter limits the events that are sent for the given streams.
+	// Expired DM and GDM messages are removed from the updates unless fullMiniblocks is set.
+	SyncStreams(
+		ctx context.Context,
+		syncID string,
+		streams []*SyncCookie,
+		filter *SyncFilter,
+		fullMiniblocks bool,
+		rec handler.Receiver,
+	) error
+
+	// ResumeSync reattaches the given recipient to an existing sync operation that lost its client
+	// within the resume grace period. Updates buffered in the meantime are replayed to the recipient.
+	ResumeSync(ctx context.Context, syncID string, rec handler.Receiver) error
 
 	// ModifySync modifies an existing sync operation. It can add or remove streams from the sync.
 	// It can also backfill a specific stream by the given cookie which is already syncing.
@@ -35,6 +49,9 @@ type Service interface {
 
 	// DebugDropStream is a debug method to drop a specific stream from the sync operation.
 	DebugDropStream(ctx context.Context, syncID string, streamID StreamId) error
+
+	// Stats returns a snapshot of the active sync operations.
+	Stats() handler.RegistryStats
 }
 
 // serviceImpl implements the Service interface with the default business logic.
@@ -51,9 +68,10 @@ func NewService(
 	nodeRegistry nodes.NodeRegistry,
 	metrics infra.MetricsFactory,
 	otelTracer trace.Tracer,
+	cfg config.SyncConfig,
 ) Service {
 	eventBus := eventbus.New(ctx, localAddr, streamCache, nodeRegistry, metrics, otelTracer)
-	registry := handler.NewRegistry(streamCache, eventBus, metrics)
+	registry := handler.NewRegistry(streamCache, eventBus, metrics, cfg)
 	return &serviceImpl{
 		handlerRegistry: registry,
 		otelTracer:      otelTracer,
@@ -64,16 +82,18 @@ func (s *serviceImpl) SyncStreams(
 	ctx context.Context,
 	syncID string,
 	streams []*SyncCookie,
+	filter *SyncFilter,
+	fullMiniblocks bool,
 	rec handler.Receiver,
 ) error {
-	h, err := s.handlerRegistry.New(ctx, syncID, rec)
+	h, err := s.handlerRegistry.New(ctx, syncID, rec, fullMiniblocks)
 	if err != nil {
 		return err
 	}
-	defer s.handlerRegistry.Remove(syncID)
+	defer s.removeUnlessResumable(h)
 
 	if len(streams) > 0 {
-		res, err := h.Modify(ctx, &ModifySyncRequest{SyncId: syncID, AddStreams: streams})
+		res, err := h.Modify(ctx, &ModifySyncRequest{SyncId: syncID, AddStreams: streams, Filter: filter})
 		if err != nil {
 			return err
 		}
@@ -90,6 +110,24 @@ func (s *serviceImpl) SyncStreams(
 	return h.Run()
 }
 
+func (s *serviceImpl) ResumeSync(ctx context.Context, syncID string, rec handler.Receiver) error {
+	h, ok := s.handlerRegistry.Get(syncID)
+	if !ok {
+		return RiverError(Err_NOT_FOUND, "sync operation not found")
+	}
+	defer s.removeUnlessResumable(h)
+
+	return h.Resume(ctx, rec)
+}
+
+// removeUnlessResumable removes the sync operation from the registry after its client disconnected,
+// unless it can be resumed. A resumable sync operation removes itself when its grace period expires.
+func (s *serviceImpl) removeUnlessResumable(h handler.SyncStreamHandler) {
+	if !h.Resumable() {
+		s.handlerRegistry.Remove(h.SyncID())
+	}
+}
+
 func (s *serviceImpl) ModifySync(ctx context.Context, req *ModifySyncRequest) (*ModifySyncResponse, error) {
 	h, ok := s.handlerRegistry.Get(req.GetSyncId())
 	if !ok {
@@ -127,3 +165,7 @@ func (s *serviceImpl) DebugDropStream(ctx context.Context, syncID string, stream
 
 	return h.DebugDropStream(ctx, streamID)
 }
+
+func (s *serviceImpl) Stats() handler.RegistryStats {
+	return s.handlerRegistry.Stats()
+}
@@ -100,6 +100,8 @@ func NewRemoteStreamUpdateEmitter(
 		NodeAddress: remoteAddr[:],
 	}}})
 	req.Header().Set(headers.RiverUseSharedSyncHeaderName, headers.RiverHeaderTrueValue)
+	// Expired messages are removed by this node for the sync operations of its clients.
+	req.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 	responseStream, err := client.SyncStreams(ctx, req)
 	if err != nil {
 		cancel(err)
@@ -172,6 +172,17 @@ func CanCreateStream(
 		)
 	}
 
+	if inceptionPayload.GetSettings().GetMessageExpirationSeconds() > 0 &&
+		streamId.Type() != shared.STREAM_DM_CHANNEL_BIN &&
+		streamId.Type() != shared.STREAM_GDM_CHANNEL_BIN {
+		return nil, RiverError(
+			Err_BAD_STREAM_CREATION_PARAMS,
+			"message expiration is only supported for dm and gdm channels",
+			"streamId",
+			streamId,
+		)
+	}
+
 	settings := chainConfig.Get()
 
 	r := &csParams{
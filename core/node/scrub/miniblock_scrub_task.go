@@ -26,8 +26,12 @@ type CorruptStreamRecord struct {
 	CorruptionReason     string
 }
 
+// StreamRepairRecord is the audit trail entry of an attempt to repair a corrupt stream.
+type StreamRepairRecord = storage.StreamRepairRecord
+
 type CorruptStreamTrackingService interface {
 	GetCorruptStreams(ctx context.Context) []CorruptStreamRecord
+	GetStreamRepairs(ctx context.Context) []StreamRepairRecord
 }
 
 type MiniblockScrubber interface {
@@ -149,7 +153,17 @@ func (m *miniblockScrubTaskProcessorImpl) Close() {
 
 var maxBlocksPerScan = 100
 
-func optsFromPrevMiniblock(streamID shared.StreamId, prevMb *events.MiniblockInfo) *events.ParsedMiniblockInfoOpts {
+// GenesisMiniblockOpts returns the options that validate the genesis miniblock of a stream.
+func GenesisMiniblockOpts() *events.ParsedMiniblockInfoOpts {
+	return events.NewParsedMiniblockInfoOpts().
+		WithExpectedBlockNumber(0).
+		WithExpectedEventNumOffset(0).
+		WithExpectedPrevMiniblockHash(common.Hash{}).
+		WithExpectedPrevSnapshotMiniblockNum(0)
+}
+
+// OptsFromPrevMiniblock returns the options that validate the miniblock that follows prevMb.
+func OptsFromPrevMiniblock(streamID shared.StreamId, prevMb *events.MiniblockInfo) *events.ParsedMiniblockInfoOpts {
 	expectedPrevSnapshotNum := prevMb.Header().PrevSnapshotMiniblockNum
 	if prevMb.Snapshot != nil {
 		expectedPrevSnapshotNum = prevMb.Header().MiniblockNum
@@ -238,13 +252,9 @@ func (m *miniblockScrubTaskProcessorImpl) scrubMiniblocks(
 			)
 		}
 
-		opts = optsFromPrevMiniblock(streamId, prevMb)
+		opts = OptsFromPrevMiniblock(streamId, prevMb)
 	} else {
-		opts = opts.
-			WithExpectedBlockNumber(0).
-			WithExpectedEventNumOffset(0).
-			WithExpectedPrevMiniblockHash(common.Hash{}).
-			WithExpectedPrevSnapshotMiniblockNum(0)
+		opts = GenesisMiniblockOpts()
 	}
 
 	for blockNum <= latest {
@@ -284,7 +294,7 @@ func (m *miniblockScrubTaskProcessorImpl) scrubMiniblocks(
 					Tag("miniblockNum", blockNum+int64(offset))
 				return newCorruptStreamReport(streamId, err, blockNum+int64(offset))
 			}
-			opts = optsFromPrevMiniblock(streamId, mbInfo)
+			opts = OptsFromPrevMiniblock(streamId, mbInfo)
 		}
 		blockNum = blockNum + int64(len(blocks))
 	}
@@ -0,0 +1,167 @@
+package search
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/contracts/types"
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// isTrackedStream returns true for streams of which the cleartext content is indexed.
+func isTrackedStream(streamID shared.StreamId) bool {
+	switch streamID.Type() {
+	case shared.STREAM_SPACE_BIN,
+		shared.STREAM_CHANNEL_BIN,
+		shared.STREAM_DM_CHANNEL_BIN,
+		shared.STREAM_GDM_CHANNEL_BIN:
+		return true
+	default:
+		return false
+	}
+}
+
+// cleartext returns the content of the given data if it is not encrypted.
+// Data is considered cleartext when no encryption algorithm or session is set.
+func cleartext(data *EncryptedData) (string, bool) {
+	if data == nil ||
+		data.GetAlgorithm() != "" ||
+		data.GetSessionId() != "" ||
+		len(data.GetSessionIdBytes()) != 0 ||
+		data.GetCiphertext() == "" {
+		return "", false
+	}
+	return data.GetCiphertext(), true
+}
+
+// searchDocumentFromEvent returns the search document for the cleartext content of the given event,
+// or nil when the event has no content that is indexed.
+func searchDocumentFromEvent(streamID shared.StreamId, event *ParsedEvent) *storage.SearchDocument {
+	var (
+		kind SearchKind
+		data *EncryptedData
+	)
+
+	switch payload := event.Event.GetPayload().(type) {
+	case *StreamEvent_ChannelPayload:
+		data, kind = payload.ChannelPayload.GetMessage(), SearchKind_SEARCH_KIND_MESSAGE
+	case *StreamEvent_DmChannelPayload:
+		data, kind = payload.DmChannelPayload.GetMessage(), SearchKind_SEARCH_KIND_MESSAGE
+	case *StreamEvent_GdmChannelPayload:
+		if msg := payload.GdmChannelPayload.GetMessage(); msg != nil {
+			data, kind = msg, SearchKind_SEARCH_KIND_MESSAGE
+		} else {
+			data, kind = payload.GdmChannelPayload.GetChannelProperties(), SearchKind_SEARCH_KIND_CHANNEL_PROPERTIES
+		}
+	case *StreamEvent_MemberPayload:
+		if username := payload.MemberPayload.GetUsername(); username != nil {
+			data, kind = username, SearchKind_SEARCH_KIND_USERNAME
+		} else {
+			data, kind = payload.MemberPayload.GetDisplayName(), SearchKind_SEARCH_KIND_DISPLAY_NAME
+		}
+	default:
+		return nil
+	}
+
+	text, ok := cleartext(data)
+	if !ok {
+		return nil
+	}
+
+	doc := &storage.SearchDocument{
+		StreamID:  streamID,
+		EventHash: event.Hash,
+		Creator:   common.BytesToAddress(event.Event.GetCreatorAddress()),
+		Kind:      kind,
+		Text:      text,
+		CreatedAt: time.UnixMilli(event.Event.GetCreatedAtEpochMs()),
+	}
+
+	switch streamID.Type() {
+	case shared.STREAM_SPACE_BIN:
+		doc.SpaceID = &streamID
+	case shared.STREAM_CHANNEL_BIN:
+		spaceID := streamID.SpaceID()
+		doc.SpaceID = &spaceID
+	}
+
+	return doc
+}
+
+// redactedEventHash returns the hash of the event that is redacted by the given event. Events are redacted by
+// admins through a channel redaction, and by their creator through a message in a channel, DM or GDM stream
+// that is tagged as redaction and references the redacted event.
+func redactedEventHash(event *ParsedEvent) (common.Hash, bool) {
+	var eventID []byte
+	if redaction := event.Event.GetChannelPayload().GetRedaction(); redaction != nil {
+		eventID = redaction.GetEventId()
+	} else if event.Event.GetTags().GetMessageInteractionType() ==
+		MessageInteractionType_MESSAGE_INTERACTION_TYPE_REDACTION {
+		eventID = common.FromHex(eventMessage(event).GetRefEventId())
+	}
+
+	if len(eventID) != common.HashLength {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(eventID), true
+}
+
+// eventMessage returns the message of the given channel, DM or GDM event, or nil if the event isn't a message.
+func eventMessage(event *ParsedEvent) *EncryptedData {
+	switch payload := event.Event.GetPayload().(type) {
+	case *StreamEvent_ChannelPayload:
+		return payload.ChannelPayload.GetMessage()
+	case *StreamEvent_DmChannelPayload:
+		return payload.DmChannelPayload.GetMessage()
+	case *StreamEvent_GdmChannelPayload:
+		return payload.GdmChannelPayload.GetMessage()
+	default:
+		return nil
+	}
+}
+
+// updatedSpaceChannel returns the id of the channel that is created or updated by the given space event.
+func updatedSpaceChannel(event *ParsedEvent) (shared.StreamId, bool) {
+	update := event.Event.GetSpacePayload().GetChannel()
+	if update == nil || (update.GetOp() != ChannelOp_CO_CREATED && update.GetOp() != ChannelOp_CO_UPDATED) {
+		return shared.StreamId{}, false
+	}
+	channelID, err := shared.StreamIdFromBytes(update.GetChannelId())
+	if err != nil || channelID.Type() != shared.STREAM_CHANNEL_BIN {
+		return shared.StreamId{}, false
+	}
+	return channelID, true
+}
+
+// channelNameDocument returns the search document for the name of the given space channel, or nil when the
+// channel is disabled or has no name. Space channel names are stored in the space contract and not in an
+// event, the document is keyed by the channel id and replaces the previous name of the channel.
+func channelNameDocument(
+	channel *types.BaseChannel,
+	creator common.Address,
+	createdAt time.Time,
+) *storage.SearchDocument {
+	if channel.Disabled || channel.Metadata == "" {
+		return nil
+	}
+
+	spaceID := channel.Id.SpaceID()
+	return &storage.SearchDocument{
+		StreamID:  channel.Id,
+		SpaceID:   &spaceID,
+		EventHash: common.Hash(channel.Id),
+		Creator:   creator,
+		Kind:      SearchKind_SEARCH_KIND_CHANNEL_PROPERTIES,
+		Text:      channel.Metadata,
+		CreatedAt: createdAt,
+	}
+}
+
+// isMembershipChange returns true if the given event changes the members of the stream.
+func isMembershipChange(event *ParsedEvent) bool {
+	return event.Event.GetMemberPayload().GetMembership() != nil
+}
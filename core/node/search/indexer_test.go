@@ -0,0 +1,171 @@
+package search
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/contracts/types"
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func makeParsedEvent(t *testing.T, wallet *crypto.Wallet, payload IsStreamEvent_Payload) *ParsedEvent {
+	envelope, err := MakeEnvelopeWithPayload(wallet, payload, nil)
+	require.NoError(t, err)
+	parsed, err := ParseEvent(envelope)
+	require.NoError(t, err)
+	return parsed
+}
+
+func TestSearchDocumentFromEvent(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+	wallet, _ := crypto.NewWallet(ctx)
+
+	channelID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	spaceID := channelID.SpaceID()
+
+	msg := makeParsedEvent(t, wallet, Make_ChannelPayload_Message("hello world"))
+	doc := searchDocumentFromEvent(channelID, msg)
+	require.NotNil(doc)
+	require.Equal(channelID, doc.StreamID)
+	require.Equal(&spaceID, doc.SpaceID)
+	require.Equal(msg.Hash, doc.EventHash)
+	require.Equal(wallet.Address, doc.Creator)
+	require.Equal(SearchKind_SEARCH_KIND_MESSAGE, doc.Kind)
+	require.Equal("hello world", doc.Text)
+
+	// encrypted messages are never indexed
+	encrypted := makeParsedEvent(
+		t,
+		wallet,
+		Make_ChannelPayload_Message_WithSessionBytes("ciphertext", []byte{1, 2, 3}, "device"),
+	)
+	require.Nil(searchDocumentFromEvent(channelID, encrypted))
+
+	dmID := testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+	dm := makeParsedEvent(t, wallet, Make_DMChannelPayload_Message("direct"))
+	doc = searchDocumentFromEvent(dmID, dm)
+	require.NotNil(doc)
+	require.Nil(doc.SpaceID)
+	require.Equal(SearchKind_SEARCH_KIND_MESSAGE, doc.Kind)
+
+	username := makeParsedEvent(t, wallet, Make_MemberPayload_Username(&EncryptedData{Ciphertext: "alice"}))
+	doc = searchDocumentFromEvent(dmID, username)
+	require.NotNil(doc)
+	require.Equal(SearchKind_SEARCH_KIND_USERNAME, doc.Kind)
+	require.Equal("alice", doc.Text)
+
+	displayName := makeParsedEvent(
+		t,
+		wallet,
+		Make_MemberPayload_DisplayName(&EncryptedData{Ciphertext: "Alice", Algorithm: "r.group-encryption.v1"}),
+	)
+	require.Nil(searchDocumentFromEvent(dmID, displayName))
+
+	join := makeParsedEvent(
+		t,
+		wallet,
+		Make_MemberPayload_Membership(MembershipOp_SO_JOIN, wallet.Address[:], wallet.Address[:], nil, common.Address{}),
+	)
+	require.Nil(searchDocumentFromEvent(dmID, join))
+	require.True(isMembershipChange(join))
+	require.False(isMembershipChange(username))
+}
+
+func TestRedactedEventHash(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+	wallet, _ := crypto.NewWallet(ctx)
+
+	msg := makeParsedEvent(t, wallet, Make_ChannelPayload_Message("hello"))
+	redaction := makeParsedEvent(t, wallet, &StreamEvent_ChannelPayload{
+		ChannelPayload: &ChannelPayload{
+			Content: &ChannelPayload_Redaction_{
+				Redaction: &ChannelPayload_Redaction{EventId: msg.Hash[:]},
+			},
+		},
+	})
+
+	hash, ok := redactedEventHash(redaction)
+	require.True(ok)
+	require.Equal(msg.Hash, hash)
+
+	_, ok = redactedEventHash(msg)
+	require.False(ok)
+
+	// creators redact their own messages with a message that is tagged as redaction
+	dmRedaction, err := MakeEnvelopeWithPayloadAndTags(
+		wallet,
+		&StreamEvent_DmChannelPayload{
+			DmChannelPayload: &DmChannelPayload{
+				Content: &DmChannelPayload_Message{
+					Message: &EncryptedData{
+						Ciphertext: "ciphertext",
+						Algorithm:  "r.group-encryption.v1",
+						RefEventId: &[]string{hex.EncodeToString(msg.Hash[:])}[0],
+					},
+				},
+			},
+		},
+		nil,
+		&Tags{MessageInteractionType: MessageInteractionType_MESSAGE_INTERACTION_TYPE_REDACTION},
+	)
+	require.NoError(err)
+	parsed, err := ParseEvent(dmRedaction)
+	require.NoError(err)
+
+	hash, ok = redactedEventHash(parsed)
+	require.True(ok)
+	require.Equal(msg.Hash, hash)
+}
+
+func TestChannelNameDocument(t *testing.T) {
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+	wallet, _ := crypto.NewWallet(ctx)
+
+	channelID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	spaceID := channelID.SpaceID()
+
+	update := makeParsedEvent(t, wallet, Make_SpacePayload_ChannelUpdate(ChannelOp_CO_UPDATED, channelID, nil, nil))
+	id, ok := updatedSpaceChannel(update)
+	require.True(ok)
+	require.Equal(channelID, id)
+
+	deleted := makeParsedEvent(t, wallet, Make_SpacePayload_ChannelUpdate(ChannelOp_CO_DELETED, channelID, nil, nil))
+	_, ok = updatedSpaceChannel(deleted)
+	require.False(ok)
+
+	now := time.Now()
+	doc := channelNameDocument(&types.BaseChannel{Id: channelID, Metadata: "general"}, wallet.Address, now)
+	require.NotNil(doc)
+	require.Equal(channelID, doc.StreamID)
+	require.Equal(&spaceID, doc.SpaceID)
+	require.Equal(common.Hash(channelID), doc.EventHash)
+	require.Equal(SearchKind_SEARCH_KIND_CHANNEL_PROPERTIES, doc.Kind)
+	require.Equal("general", doc.Text)
+
+	disabled := &types.BaseChannel{Id: channelID, Metadata: "general", Disabled: true}
+	require.Nil(channelNameDocument(disabled, wallet.Address, now))
+	require.Nil(channelNameDocument(&types.BaseChannel{Id: channelID}, wallet.Address, now))
+}
+
+func TestIsTrackedStream(t *testing.T) {
+	require := require.New(t)
+
+	require.True(isTrackedStream(testutils.FakeStreamId(shared.STREAM_SPACE_BIN)))
+	require.True(isTrackedStream(testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)))
+	require.True(isTrackedStream(testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)))
+	require.True(isTrackedStream(testutils.FakeStreamId(shared.STREAM_GDM_CHANNEL_BIN)))
+	require.False(isTrackedStream(testutils.FakeStreamId(shared.STREAM_USER_BIN)))
+	require.False(isTrackedStream(testutils.FakeStreamId(shared.STREAM_USER_INBOX_BIN)))
+}
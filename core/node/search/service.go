@@ -0,0 +1,282 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/auth"
+	"github.com/towns-protocol/towns/core/node/authentication"
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/logging"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/track_streams"
+)
+
+const (
+	searchServiceChallengePrefix = "SS_AUTH:"
+
+	// defaultSearchPageSize is the number of results returned by Search when the client didn't
+	// specify a page size.
+	defaultSearchPageSize = 50
+
+	// maxSearchPages is the number of pages Search reads to fill a page with results the user is
+	// entitled to read, a partial page is returned with the next offset after that.
+	maxSearchPages = 5
+)
+
+type Service struct {
+	authentication.AuthServiceMixin
+	cfg            *config.Config
+	store          storage.SearchStore
+	chainAuth      auth.ChainAuth
+	streamsTracker track_streams.StreamsTracker
+}
+
+// NewService creates the search service. It tracks space, channel, DM and GDM streams, indexes their
+// cleartext content in store and serves search requests for authenticated users. Space channel names
+// are read from channels, they are not indexed when channels is nil.
+func NewService(
+	ctx context.Context,
+	cfg *config.Config,
+	onChainConfig crypto.OnChainConfiguration,
+	store storage.SearchStore,
+	chainAuth auth.ChainAuth,
+	channels SpaceChannels,
+	riverRegistry *registries.RiverRegistryContract,
+	nodes []nodes.NodeRegistry,
+	metrics infra.MetricsFactory,
+	otelTracer trace.Tracer,
+	cookieStore track_streams.SyncCookieStore,
+	challengeStore authentication.ChallengeStore,
+	sessionStore authentication.SessionStore,
+) (*Service, error) {
+	tracker, err := NewSearchStreamsTracker(
+		ctx,
+		cfg.Search,
+		onChainConfig,
+		riverRegistry,
+		nodes,
+		metrics,
+		store,
+		channels,
+		cookieStore,
+		otelTracer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	service := &Service{
+		cfg:            cfg,
+		store:          store,
+		chainAuth:      chainAuth,
+		streamsTracker: tracker,
+	}
+	if err := service.AuthServiceMixin.InitAuthentication(
+		searchServiceChallengePrefix,
+		&cfg.Search.Authentication,
+		challengeStore,
+		sessionStore,
+		nil,
+	); err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (s *Service) Start(ctx context.Context) {
+	log := logging.FromCtx(ctx)
+
+	go func() {
+		for {
+			log.Infow("Start search streams tracker")
+
+			if err := s.streamsTracker.Run(ctx); err != nil {
+				log.Errorw("tracking streams failed", "error", err)
+			}
+
+			select {
+			case <-time.After(10 * time.Second):
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Search returns the cleartext content that matches the query in streams the user is a member of.
+// Results from space channels the user isn't entitled to read are omitted, Search reads on until the
+// page is filled.
+func (s *Service) Search(
+	ctx context.Context,
+	req *connect.Request[SearchRequest],
+) (*connect.Response[SearchResponse], error) {
+	userID := authentication.UserFromAuthenticatedContext(ctx)
+	if userID == (common.Address{}) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid user id")
+	}
+
+	query, err := s.searchQueryFromRequest(req.Msg)
+	if err != nil {
+		return nil, AsRiverError(err).Func("Search")
+	}
+
+	entitled := make(map[shared.StreamId]bool)
+	canRead := func(doc *storage.SearchDocument) (bool, error) {
+		if doc.StreamID.Type() != shared.STREAM_CHANNEL_BIN {
+			return true, nil
+		}
+		isEntitled, ok := entitled[doc.StreamID]
+		if !ok {
+			isEntitled, err = s.canReadChannel(ctx, userID, doc.StreamID.SpaceID(), doc.StreamID)
+			if err != nil {
+				return false, AsRiverError(err).Tag("channelID", doc.StreamID)
+			}
+			entitled[doc.StreamID] = isEntitled
+		}
+		return isEntitled, nil
+	}
+
+	docs, nextOffset, err := searchReadable(ctx, s.store, userID, query, canRead)
+	if err != nil {
+		return nil, AsRiverError(err).Func("Search").Tag("userID", userID)
+	}
+
+	resp := &SearchResponse{NextOffset: int32(nextOffset)}
+	for _, doc := range docs {
+		result := &SearchResult{
+			StreamId:         doc.StreamID[:],
+			EventHash:        doc.EventHash[:],
+			CreatorAddress:   doc.Creator[:],
+			Kind:             doc.Kind,
+			Text:             doc.Text,
+			CreatedAtEpochMs: doc.CreatedAt.UnixMilli(),
+		}
+		if doc.SpaceID != nil {
+			result.SpaceId = doc.SpaceID[:]
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// searchReadable returns up to query.Limit documents that match the query and that the user can read.
+// Documents are filtered after the query, pages are read until the limit is reached or maxSearchPages
+// are read. The returned offset is the offset of the first matching document that isn't returned, 0 if
+// all matching documents are returned.
+func searchReadable(
+	ctx context.Context,
+	store storage.SearchStore,
+	user common.Address,
+	query *storage.SearchQuery,
+	canRead func(doc *storage.SearchDocument) (bool, error),
+) ([]*storage.SearchDocument, int, error) {
+	var (
+		result    []*storage.SearchDocument
+		offset    = query.Offset
+		exhausted = false
+		pageQuery = *query
+	)
+	for page := 0; page < maxSearchPages && !exhausted && len(result) < query.Limit; page++ {
+		pageQuery.Offset = offset
+		docs, err := store.Search(ctx, user, &pageQuery)
+		if err != nil {
+			return nil, 0, err
+		}
+		exhausted = len(docs) < query.Limit
+
+		for i, doc := range docs {
+			if len(result) == query.Limit {
+				exhausted = false
+				break
+			}
+			offset = pageQuery.Offset + i + 1
+
+			ok, err := canRead(doc)
+			if err != nil {
+				return nil, 0, err
+			}
+			if ok {
+				result = append(result, doc)
+			}
+		}
+	}
+
+	if exhausted {
+		return result, 0, nil
+	}
+	return result, offset, nil
+}
+
+func (s *Service) searchQueryFromRequest(msg *SearchRequest) (*storage.SearchQuery, error) {
+	if msg.GetQuery() == "" {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Missing query")
+	}
+	if msg.GetPageSize() < 0 || msg.GetOffset() < 0 {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Page size and offset must not be negative")
+	}
+
+	query := &storage.SearchQuery{
+		Text:   msg.GetQuery(),
+		Kinds:  msg.GetKinds(),
+		Limit:  int(msg.GetPageSize()),
+		Offset: int(msg.GetOffset()),
+	}
+	if query.Limit == 0 {
+		query.Limit = defaultSearchPageSize
+	}
+	query.Limit = min(query.Limit, s.cfg.Search.GetMaxPageSize())
+
+	for _, id := range msg.GetStreamIds() {
+		streamID, err := shared.StreamIdFromBytes(id)
+		if err != nil {
+			return nil, err
+		}
+		query.StreamIDs = append(query.StreamIDs, streamID)
+	}
+
+	if msg.SpaceId != nil {
+		spaceID, err := shared.StreamIdFromBytes(msg.GetSpaceId())
+		if err != nil {
+			return nil, err
+		}
+		if spaceID.Type() != shared.STREAM_SPACE_BIN {
+			return nil, RiverError(Err_INVALID_ARGUMENT, "Invalid space id", "spaceID", spaceID)
+		}
+		query.SpaceID = &spaceID
+	}
+
+	return query, nil
+}
+
+// canReadChannel returns true if the user is entitled to read the given space channel.
+func (s *Service) canReadChannel(
+	ctx context.Context,
+	user common.Address,
+	spaceID shared.StreamId,
+	channelID shared.StreamId,
+) (bool, error) {
+	result, err := s.chainAuth.IsEntitled(
+		ctx,
+		s.cfg,
+		auth.NewChainAuthArgsForChannel(spaceID, channelID, user, auth.PermissionRead, common.Address{}),
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.IsEntitled(), nil
+}
@@ -0,0 +1,76 @@
+package search
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// pagedSearchStore returns pages of docs as the search store would for any query.
+type pagedSearchStore struct {
+	storage.SearchStore
+	docs []*storage.SearchDocument
+}
+
+func (s *pagedSearchStore) Search(
+	_ context.Context,
+	_ common.Address,
+	query *storage.SearchQuery,
+) ([]*storage.SearchDocument, error) {
+	from := min(query.Offset, len(s.docs))
+	to := min(query.Offset+query.Limit, len(s.docs))
+	return s.docs[from:to], nil
+}
+
+func TestSearchReadable(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	readable := testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+	unreadable := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+
+	// every third document is readable
+	store := &pagedSearchStore{}
+	for i := range 12 {
+		doc := &storage.SearchDocument{StreamID: unreadable, EventHash: common.BytesToHash([]byte{byte(i)})}
+		if i%3 == 2 {
+			doc.StreamID = readable
+		}
+		store.docs = append(store.docs, doc)
+	}
+	canRead := func(doc *storage.SearchDocument) (bool, error) {
+		return doc.StreamID == readable, nil
+	}
+
+	// pages are read until the limit is reached, the offset points after the last returned document
+	docs, next, err := searchReadable(ctx, store, common.Address{}, &storage.SearchQuery{Limit: 2}, canRead)
+	require.NoError(err)
+	require.Len(docs, 2)
+	require.Equal(common.BytesToHash([]byte{2}), docs[0].EventHash)
+	require.Equal(common.BytesToHash([]byte{5}), docs[1].EventHash)
+	require.Equal(6, next)
+
+	docs, next, err = searchReadable(ctx, store, common.Address{}, &storage.SearchQuery{Limit: 2, Offset: next}, canRead)
+	require.NoError(err)
+	require.Len(docs, 2)
+	require.Equal(common.BytesToHash([]byte{8}), docs[0].EventHash)
+	require.Equal(common.BytesToHash([]byte{11}), docs[1].EventHash)
+	require.Equal(12, next)
+
+	// no next offset once all documents are read
+	docs, next, err = searchReadable(ctx, store, common.Address{}, &storage.SearchQuery{Limit: 2, Offset: next}, canRead)
+	require.NoError(err)
+	require.Empty(docs)
+	require.Zero(next)
+
+	docs, next, err = searchReadable(ctx, store, common.Address{}, &storage.SearchQuery{Limit: 5, Offset: 9}, canRead)
+	require.NoError(err)
+	require.Len(docs, 1)
+	require.Zero(next)
+}
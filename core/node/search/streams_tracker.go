@@ -0,0 +1,73 @@
+package search
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/towns-protocol/towns/core/config"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	"github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/infra"
+	"github.com/towns-protocol/towns/core/node/nodes"
+	"github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/registries"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/track_streams"
+)
+
+// SearchStreamsTracker tracks space, channel, DM and GDM streams and indexes their cleartext content.
+type SearchStreamsTracker struct {
+	track_streams.StreamsTrackerImpl
+	store    storage.SearchStore
+	channels SpaceChannels
+}
+
+func NewSearchStreamsTracker(
+	ctx context.Context,
+	config config.SearchConfig,
+	onChainConfig crypto.OnChainConfiguration,
+	riverRegistry *registries.RiverRegistryContract,
+	nodes []nodes.NodeRegistry,
+	metricsFactory infra.MetricsFactory,
+	store storage.SearchStore,
+	channels SpaceChannels,
+	cookieStore track_streams.SyncCookieStore,
+	otelTracer trace.Tracer,
+) (track_streams.StreamsTracker, error) {
+	tracker := &SearchStreamsTracker{
+		store:    store,
+		channels: channels,
+	}
+
+	if err := tracker.StreamsTrackerImpl.Init(
+		ctx,
+		onChainConfig,
+		riverRegistry,
+		nodes,
+		nil,
+		tracker,
+		metricsFactory,
+		config.StreamTracking,
+		otelTracer,
+		cookieStore,
+	); err != nil {
+		return nil, err
+	}
+
+	return tracker, nil
+}
+
+func (tracker *SearchStreamsTracker) TrackStream(_ context.Context, streamID shared.StreamId, _ bool) bool {
+	return isTrackedStream(streamID)
+}
+
+func (tracker *SearchStreamsTracker) NewTrackedStream(
+	ctx context.Context,
+	streamID shared.StreamId,
+	cfg crypto.OnChainConfiguration,
+	stream *protocol.StreamAndCookie,
+) (events.TrackedStreamView, error) {
+	return NewTrackedStreamForSearchService(ctx, streamID, cfg, stream, tracker.store, tracker.channels)
+}
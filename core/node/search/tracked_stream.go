@@ -0,0 +1,204 @@
+package search
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/towns-protocol/towns/core/contracts/types"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/events"
+	"github.com/towns-protocol/towns/core/node/logging"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// SpaceChannels returns the channels of a space from the space contract. Space channel names are only
+// stored on-chain.
+type SpaceChannels interface {
+	GetChannels(ctx context.Context, spaceID shared.StreamId) ([]types.BaseChannel, error)
+}
+
+// SearchTrackedStreamView indexes the cleartext content of a tracked stream and keeps the members
+// of the stream up to date in the search store.
+type SearchTrackedStreamView struct {
+	TrackedStreamViewImpl
+	store    storage.SearchStore
+	channels SpaceChannels
+	// messagesExpire is set for DM and GDM streams of which messages expire, these are not indexed.
+	messagesExpire bool
+}
+
+// NewTrackedStreamForSearchService constructs a TrackedStreamView instance from the given stream and
+// indexes all events that are included in the stream. Indexing is idempotent, events that are indexed
+// again after a restart are ignored.
+//
+// DM and GDM streams of which messages expire are not indexed. The tracker receives the full miniblocks
+// of these streams, including expired messages, and the index would outlive the messages.
+//
+// The names of the channels of a space stream are indexed when channels is not nil.
+func NewTrackedStreamForSearchService(
+	ctx context.Context,
+	streamID shared.StreamId,
+	cfg crypto.OnChainConfiguration,
+	stream *StreamAndCookie,
+	store storage.SearchStore,
+	channels SpaceChannels,
+) (TrackedStreamView, error) {
+	trackedView := &SearchTrackedStreamView{
+		store:    store,
+		channels: channels,
+	}
+
+	view, err := trackedView.TrackedStreamViewImpl.Init(
+		streamID,
+		cfg,
+		stream,
+		trackedView.onNewEvent,
+		trackedView.shouldPersistCookie,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if view.MessageExpiration() > 0 {
+		// Remove documents that were indexed before expiring streams were excluded.
+		if err := store.DeleteStreamSearchDocuments(ctx, streamID); err != nil {
+			return nil, err
+		}
+		trackedView.messagesExpire = true
+		return trackedView, nil
+	}
+
+	if err := trackedView.updateMembers(ctx, view); err != nil {
+		return nil, err
+	}
+
+	if err := trackedView.indexSpaceChannels(ctx, streamID); err != nil {
+		return nil, err
+	}
+
+	for event := range view.AllEvents() {
+		if err := trackedView.indexEvent(ctx, streamID, event); err != nil {
+			return nil, err
+		}
+	}
+
+	return trackedView, nil
+}
+
+func (b *SearchTrackedStreamView) onNewEvent(ctx context.Context, view *StreamView, event *ParsedEvent) error {
+	if b.messagesExpire {
+		return nil
+	}
+
+	log := logging.FromCtx(ctx).With("func", "SearchTrackedStreamView.onNewEvent", "streamId", view.StreamId())
+
+	if isMembershipChange(event) {
+		if err := b.updateMembers(ctx, view); err != nil {
+			log.Errorw("Unable to update stream members", "error", err)
+			return err
+		}
+	}
+
+	if err := b.indexEvent(ctx, *view.StreamId(), event); err != nil {
+		log.Errorw("Unable to index event", "eventHash", event.Hash, "error", err)
+		return err
+	}
+
+	if channelID, ok := updatedSpaceChannel(event); ok {
+		if err := b.indexChannelName(ctx, channelID, event); err != nil {
+			log.Errorw("Unable to index channel name", "channelId", channelID, "error", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexSpaceChannels indexes the names of all channels of the given space stream.
+func (b *SearchTrackedStreamView) indexSpaceChannels(ctx context.Context, streamID shared.StreamId) error {
+	if b.channels == nil || streamID.Type() != shared.STREAM_SPACE_BIN {
+		return nil
+	}
+
+	channels, err := b.channels.GetChannels(ctx, streamID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range channels {
+		if doc := channelNameDocument(&channels[i], common.Address{}, now); doc != nil {
+			if err := b.store.AddSearchDocument(ctx, doc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexChannelName indexes the name of the channel that is created or updated by the given space event.
+func (b *SearchTrackedStreamView) indexChannelName(
+	ctx context.Context,
+	channelID shared.StreamId,
+	event *ParsedEvent,
+) error {
+	if b.channels == nil {
+		return nil
+	}
+
+	channels, err := b.channels.GetChannels(ctx, channelID.SpaceID())
+	if err != nil {
+		return err
+	}
+
+	for i := range channels {
+		if channels[i].Id != channelID {
+			continue
+		}
+		doc := channelNameDocument(
+			&channels[i],
+			common.BytesToAddress(event.Event.GetCreatorAddress()),
+			time.UnixMilli(event.Event.GetCreatedAtEpochMs()),
+		)
+		if doc != nil {
+			return b.store.AddSearchDocument(ctx, doc)
+		}
+	}
+	return nil
+}
+
+func (b *SearchTrackedStreamView) indexEvent(ctx context.Context, streamID shared.StreamId, event *ParsedEvent) error {
+	if redacted, ok := redactedEventHash(event); ok {
+		return b.store.DeleteSearchDocument(ctx, streamID, redacted)
+	}
+
+	if doc := searchDocumentFromEvent(streamID, event); doc != nil {
+		return b.store.AddSearchDocument(ctx, doc)
+	}
+
+	return nil
+}
+
+func (b *SearchTrackedStreamView) updateMembers(ctx context.Context, view *StreamView) error {
+	members, err := view.GetChannelMembers()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]common.Address, 0, members.Cardinality())
+	for member := range members.Iter() {
+		addresses = append(addresses, common.HexToAddress(member))
+	}
+
+	return b.store.SetStreamMembers(ctx, *view.StreamId(), addresses)
+}
+
+// shouldPersistCookie always returns true, this allows the service to resume indexing where it
+// stopped after a restart instead of reindexing the full stream history.
+func (b *SearchTrackedStreamView) shouldPersistCookie(context.Context, *StreamView) bool {
+	return true
+}
@@ -0,0 +1,9 @@
+-- pending authentication challenges, shared between service instances so a user
+-- can finish authentication on another instance than where it was started
+CREATE TABLE IF NOT EXISTS auth_challenges (
+    challenge   BYTEA PRIMARY KEY NOT NULL,
+    user_id     BYTEA NOT NULL,
+    expires_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS AUTH_CHALLENGES_EXPIRES_AT_IDX ON auth_challenges (expires_at);
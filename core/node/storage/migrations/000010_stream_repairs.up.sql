@@ -0,0 +1,15 @@
+-- Audit log of the repairs of corrupt archive streams. Only archive nodes write it, each row is
+-- a repair attempt and the archiver counts them to limit the attempts per stream across restarts.
+CREATE TABLE IF NOT EXISTS stream_repairs (
+    id BIGSERIAL PRIMARY KEY,
+    stream_id CHAR(64) NOT NULL,          -- repaired stream
+    from_inclusive BIGINT NOT NULL,       -- first replaced miniblock
+    to_exclusive BIGINT NOT NULL,         -- end of the replaced miniblock range
+    source_node BYTEA NOT NULL,           -- replica the miniblocks were fetched from
+    last_miniblock_hash BYTEA NOT NULL,   -- hash of the last replaced miniblock
+    corruption_error TEXT NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    error TEXT NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,6 @@
+-- DM and GDM streams of which messages expire as configured in the immutable stream settings.
+-- The stream trimmer only sweeps these streams for expired messages.
+CREATE TABLE IF NOT EXISTS expiring_streams (
+    stream_id CHAR(64) PRIMARY KEY NOT NULL,
+    message_expiration_seconds BIGINT NOT NULL
+);
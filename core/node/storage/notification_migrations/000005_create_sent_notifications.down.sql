@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS SENT_NOTIFICATIONS_SENT_AT_IDX;
+DROP TABLE IF EXISTS sentnotifications;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS sentnotifications (
+    event_hash CHAR(64)    NOT NULL,
+    user_id    CHAR(40)    NOT NULL,
+    channel_id CHAR(64)    NOT NULL,
+    sender_id  CHAR(40)    NOT NULL,
+    kind       VARCHAR(32) NOT NULL,
+    sent_at    TIMESTAMP   NOT NULL,
+    PRIMARY KEY (event_hash, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS SENT_NOTIFICATIONS_SENT_AT_IDX ON sentnotifications (sent_at);
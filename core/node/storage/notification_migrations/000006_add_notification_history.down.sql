@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS SENT_NOTIFICATIONS_USER_ID_IDX;
+
+ALTER TABLE sentnotifications DROP COLUMN IF EXISTS seen;
+ALTER TABLE sentnotifications DROP COLUMN IF EXISTS id;
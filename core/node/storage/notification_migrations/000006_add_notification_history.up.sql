@@ -0,0 +1,4 @@
+ALTER TABLE sentnotifications ADD COLUMN IF NOT EXISTS id BIGSERIAL;
+ALTER TABLE sentnotifications ADD COLUMN IF NOT EXISTS seen BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX IF NOT EXISTS SENT_NOTIFICATIONS_USER_ID_IDX ON sentnotifications (user_id, id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS stream_partition_leases;
+DROP TABLE IF EXISTS stream_lease_instances;
+DROP TABLE IF EXISTS stream_sync_cookies;
@@ -0,0 +1,25 @@
+-- stream sync cookies allow an instance that takes over a partition to resume tracking
+-- its streams from the position where the previous owner stopped
+CREATE TABLE IF NOT EXISTS stream_sync_cookies (
+    stream_id            CHAR(64) PRIMARY KEY NOT NULL,
+    minipool_gen         BIGINT NOT NULL,
+    prev_miniblock_hash  BYTEA NOT NULL,
+    updated_at           TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stream_sync_cookies_updated_at ON stream_sync_cookies(updated_at);
+
+-- notification service instances that participate in stream partitioning
+CREATE TABLE IF NOT EXISTS stream_lease_instances (
+    instance_id   VARCHAR(64) PRIMARY KEY NOT NULL,
+    heartbeat_at  TIMESTAMP NOT NULL
+);
+
+-- stream partition ownership, owner is NULL when the partition is released
+CREATE TABLE IF NOT EXISTS stream_partition_leases (
+    partition   INTEGER PRIMARY KEY NOT NULL,
+    owner       VARCHAR(64),
+    expires_at  TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS STREAM_PARTITION_LEASES_OWNER_IDX ON stream_partition_leases (owner);
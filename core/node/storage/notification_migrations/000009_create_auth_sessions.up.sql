@@ -0,0 +1,14 @@
+-- authenticated sessions, session tokens are only accepted while their session exists
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    session_id          BYTEA PRIMARY KEY NOT NULL,
+    user_id             BYTEA NOT NULL,
+    refresh_token_hash  BYTEA NOT NULL,
+    -- hash of the refresh token that was rotated last, presenting it again revokes the session
+    prev_refresh_token_hash BYTEA,
+    created_at          TIMESTAMP NOT NULL,
+    last_refreshed_at   TIMESTAMP NOT NULL,
+    expires_at          TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS AUTH_SESSIONS_USER_ID_IDX ON auth_sessions (user_id);
+CREATE INDEX IF NOT EXISTS AUTH_SESSIONS_EXPIRES_AT_IDX ON auth_sessions (expires_at);
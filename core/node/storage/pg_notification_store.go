@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/SherClockHolmes/webpush-go"
@@ -133,6 +134,58 @@ type (
 			deviceToken []byte,
 			userID common.Address,
 		) error
+
+		// AddSentNotifications records that a notification for the given event was sent to the given users.
+		AddSentNotifications(
+			ctx context.Context,
+			notifications []*types.SentNotification,
+		) error
+
+		// GetSentNotifications returns the notifications that were sent for the given event.
+		GetSentNotifications(
+			ctx context.Context,
+			eventHash common.Hash,
+		) ([]*types.SentNotification, error)
+
+		// DeleteSentNotificationsBefore deletes sent notification records that were sent before the given time
+		// and returns the number of deleted records.
+		DeleteSentNotificationsBefore(
+			ctx context.Context,
+			before time.Time,
+		) (int64, error)
+
+		// GetNotificationHistory returns up to limit notifications that were sent to the given user with an
+		// id lower than beforeID, most recent first. If beforeID is 0 the most recent notifications are returned.
+		GetNotificationHistory(
+			ctx context.Context,
+			userID common.Address,
+			beforeID int64,
+			limit int,
+			unseenOnly bool,
+		) ([]*types.SentNotification, error)
+
+		// MarkNotificationsSeen marks the notifications with the given ids and if upToID is set all notifications
+		// with an id up to and including upToID in the user's notification history as seen.
+		MarkNotificationsSeen(
+			ctx context.Context,
+			userID common.Address,
+			ids []int64,
+			upToID int64,
+		) error
+
+		// CountUnseenNotifications returns the number of notifications in the user's notification history that
+		// are not marked as seen.
+		CountUnseenNotifications(
+			ctx context.Context,
+			userID common.Address,
+		) (int64, error)
+
+		// TrimNotificationHistory deletes the oldest sent notification records for users that have more than
+		// maxPerUser records and returns the number of deleted records.
+		TrimNotificationHistory(
+			ctx context.Context,
+			maxPerUser int,
+		) (int64, error)
 	}
 )
 
@@ -958,3 +1011,314 @@ func (s *PostgresNotificationStore) setGDMChannelSetting(
 
 	return err
 }
+
+func (s *PostgresNotificationStore) AddSentNotifications(
+	ctx context.Context,
+	notifications []*types.SentNotification,
+) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"AddSentNotifications",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.addSentNotificationsTx(ctx, tx, notifications)
+		},
+		nil,
+		"event", notifications[0].EventHash,
+	)
+}
+
+func (s *PostgresNotificationStore) addSentNotificationsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	notifications []*types.SentNotification,
+) error {
+	batch := &pgx.Batch{}
+
+	for _, n := range notifications {
+		batch.Queue(
+			`INSERT INTO sentnotifications (event_hash, user_id, channel_id, sender_id, kind, sent_at) VALUES ($1, $2, $3, $4, $5, $6) ON CONFLICT (event_hash, user_id) DO UPDATE SET kind = $5, sent_at = $6`,
+			hex.EncodeToString(n.EventHash[:]),
+			hex.EncodeToString(n.UserID[:]),
+			n.ChannelID,
+			hex.EncodeToString(n.Sender[:]),
+			n.Kind,
+			n.SentAt.UTC(),
+		)
+	}
+
+	return tx.SendBatch(ctx, batch).Close()
+}
+
+func (s *PostgresNotificationStore) GetSentNotifications(
+	ctx context.Context,
+	eventHash common.Hash,
+) ([]*types.SentNotification, error) {
+	var (
+		err    error
+		result []*types.SentNotification
+	)
+
+	err = s.txRunner(
+		ctx,
+		"GetSentNotifications",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			result, err = s.getSentNotificationsTx(ctx, tx, eventHash)
+			return err
+		},
+		nil,
+		"event", eventHash,
+	)
+
+	return result, err
+}
+
+func (s *PostgresNotificationStore) getSentNotificationsTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	eventHash common.Hash,
+) ([]*types.SentNotification, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT user_id, channel_id, sender_id, kind, sent_at FROM sentnotifications WHERE event_hash = $1`,
+		hex.EncodeToString(eventHash[:]),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result       []*types.SentNotification
+		userID       string
+		channelIDRaw []byte
+		senderID     string
+		kind         string
+		sentAt       time.Time
+	)
+	if _, err := pgx.ForEachRow(rows, []any{&userID, &channelIDRaw, &senderID, &kind, &sentAt}, func() error {
+		channelID, err := shared.StreamIdFromString(string(channelIDRaw))
+		if err != nil {
+			return err
+		}
+		result = append(result, &types.SentNotification{
+			EventHash: eventHash,
+			UserID:    common.HexToAddress(userID),
+			ChannelID: channelID,
+			Sender:    common.HexToAddress(senderID),
+			Kind:      kind,
+			SentAt:    sentAt,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *PostgresNotificationStore) DeleteSentNotificationsBefore(
+	ctx context.Context,
+	before time.Time,
+) (int64, error) {
+	var deleted int64
+
+	err := s.txRunner(
+		ctx,
+		"DeleteSentNotificationsBefore",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			result, err := tx.Exec(ctx, `DELETE FROM sentnotifications WHERE sent_at < $1`, before.UTC())
+			if err != nil {
+				return err
+			}
+			deleted = result.RowsAffected()
+			return nil
+		},
+		nil,
+		"before", before,
+	)
+
+	return deleted, err
+}
+
+func (s *PostgresNotificationStore) GetNotificationHistory(
+	ctx context.Context,
+	userID common.Address,
+	beforeID int64,
+	limit int,
+	unseenOnly bool,
+) ([]*types.SentNotification, error) {
+	var (
+		err    error
+		result []*types.SentNotification
+	)
+
+	err = s.txRunner(
+		ctx,
+		"GetNotificationHistory",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			result, err = s.getNotificationHistoryTx(ctx, tx, userID, beforeID, limit, unseenOnly)
+			return err
+		},
+		nil,
+		"userID", userID,
+		"beforeID", beforeID,
+	)
+
+	return result, err
+}
+
+func (s *PostgresNotificationStore) getNotificationHistoryTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID common.Address,
+	beforeID int64,
+	limit int,
+	unseenOnly bool,
+) ([]*types.SentNotification, error) {
+	if beforeID <= 0 {
+		beforeID = math.MaxInt64
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT id, event_hash, channel_id, sender_id, kind, sent_at, seen FROM sentnotifications
+			WHERE user_id = $1 AND id < $2 AND (NOT $3 OR NOT seen) ORDER BY id DESC LIMIT $4`,
+		hex.EncodeToString(userID[:]),
+		beforeID,
+		unseenOnly,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result       []*types.SentNotification
+		id           int64
+		eventHash    string
+		channelIDRaw []byte
+		senderID     string
+		kind         string
+		sentAt       time.Time
+		seen         bool
+	)
+	if _, err := pgx.ForEachRow(
+		rows,
+		[]any{&id, &eventHash, &channelIDRaw, &senderID, &kind, &sentAt, &seen},
+		func() error {
+			channelID, err := shared.StreamIdFromString(string(channelIDRaw))
+			if err != nil {
+				return err
+			}
+			result = append(result, &types.SentNotification{
+				ID:        id,
+				EventHash: common.HexToHash(eventHash),
+				UserID:    userID,
+				ChannelID: channelID,
+				Sender:    common.HexToAddress(senderID),
+				Kind:      kind,
+				SentAt:    sentAt,
+				Seen:      seen,
+			})
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *PostgresNotificationStore) MarkNotificationsSeen(
+	ctx context.Context,
+	userID common.Address,
+	ids []int64,
+	upToID int64,
+) error {
+	if len(ids) == 0 && upToID <= 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"MarkNotificationsSeen",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`UPDATE sentnotifications SET seen = true
+					WHERE user_id = $1 AND NOT seen AND (id = ANY($2) OR id <= $3)`,
+				hex.EncodeToString(userID[:]),
+				ids,
+				upToID,
+			)
+			return err
+		},
+		nil,
+		"userID", userID,
+	)
+}
+
+func (s *PostgresNotificationStore) CountUnseenNotifications(
+	ctx context.Context,
+	userID common.Address,
+) (int64, error) {
+	var count int64
+
+	err := s.txRunner(
+		ctx,
+		"CountUnseenNotifications",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return tx.QueryRow(
+				ctx,
+				`SELECT COUNT(*) FROM sentnotifications WHERE user_id = $1 AND NOT seen`,
+				hex.EncodeToString(userID[:]),
+			).Scan(&count)
+		},
+		nil,
+		"userID", userID,
+	)
+
+	return count, err
+}
+
+func (s *PostgresNotificationStore) TrimNotificationHistory(
+	ctx context.Context,
+	maxPerUser int,
+) (int64, error) {
+	var deleted int64
+
+	err := s.txRunner(
+		ctx,
+		"TrimNotificationHistory",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			result, err := tx.Exec(
+				ctx,
+				`DELETE FROM sentnotifications WHERE id IN (
+					SELECT id FROM (
+						SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY id DESC) AS pos FROM sentnotifications
+					) ranked WHERE pos > $1
+				)`,
+				maxPerUser,
+			)
+			if err != nil {
+				return err
+			}
+			deleted = result.RowsAffected()
+			return nil
+		},
+		nil,
+		"maxPerUser", maxPerUser,
+	)
+
+	return deleted, err
+}
@@ -0,0 +1,356 @@
+package storage
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+)
+
+type (
+	// SearchDocument is a cleartext field of a stream event that is indexed for full-text search.
+	SearchDocument struct {
+		StreamID shared.StreamId
+		// SpaceID is set for spaces and space channels
+		SpaceID   *shared.StreamId
+		EventHash common.Hash
+		Creator   common.Address
+		Kind      SearchKind
+		Text      string
+		CreatedAt time.Time
+	}
+
+	// SearchQuery selects the documents that are returned by Search.
+	SearchQuery struct {
+		// Text is the query in web search syntax.
+		Text string
+		// StreamIDs if not empty restricts the search to the given streams.
+		StreamIDs []shared.StreamId
+		// SpaceID if not nil restricts the search to the given space and its channels.
+		SpaceID *shared.StreamId
+		// Kinds if not empty restricts the search to the given kinds of documents.
+		Kinds  []SearchKind
+		Limit  int
+		Offset int
+	}
+
+	PostgresSearchStore struct {
+		PostgresEventStore
+
+		exitSignal chan error
+	}
+
+	SearchStore interface {
+		// AddSearchDocument indexes the given document. Documents are identified by their event hash, indexing
+		// a document twice is a no-op. Usernames and display names replace the previous username or display
+		// name of the creator in the stream, channel properties replace the previous channel properties of
+		// the stream.
+		AddSearchDocument(ctx context.Context, doc *SearchDocument) error
+
+		// DeleteSearchDocument removes the document for the given event from the index, e.g. when the event
+		// is redacted.
+		DeleteSearchDocument(ctx context.Context, streamID shared.StreamId, eventHash common.Hash) error
+
+		// DeleteStreamSearchDocuments removes all documents of the given stream from the index.
+		DeleteStreamSearchDocuments(ctx context.Context, streamID shared.StreamId) error
+
+		// SetStreamMembers replaces the members of the given stream.
+		SetStreamMembers(ctx context.Context, streamID shared.StreamId, members []common.Address) error
+
+		// Search returns the documents that match the given query in streams the given user is a member of,
+		// best match first.
+		Search(ctx context.Context, user common.Address, query *SearchQuery) ([]*SearchDocument, error)
+	}
+)
+
+var _ SearchStore = (*PostgresSearchStore)(nil)
+
+//go:embed search_migrations/*.sql
+var searchMigrationsDir embed.FS
+
+func DbSchemaNameForSearch(riverChainID uint64) string {
+	return fmt.Sprintf("search_%d", riverChainID)
+}
+
+// NewPostgresSearchStore instantiates a new PostgreSQL persistent storage for the search service.
+func NewPostgresSearchStore(
+	ctx context.Context,
+	poolInfo *PgxPoolInfo,
+	exitSignal chan error,
+	metrics infra.MetricsFactory,
+) (*PostgresSearchStore, error) {
+	store := &PostgresSearchStore{
+		exitSignal: exitSignal,
+	}
+
+	if err := store.PostgresEventStore.init(
+		ctx,
+		poolInfo,
+		metrics,
+		nil,
+		&searchMigrationsDir,
+		"search_migrations",
+	); err != nil {
+		return nil, AsRiverError(err).Func("NewPostgresSearchStore")
+	}
+
+	if err := store.initStorage(ctx); err != nil {
+		return nil, AsRiverError(err).Func("NewPostgresSearchStore")
+	}
+
+	return store, nil
+}
+
+// Close releases the listener connection and closes the postgres connection pool.
+func (s *PostgresSearchStore) Close(ctx context.Context) {
+	s.PostgresEventStore.Close(ctx)
+}
+
+// Pool returns the connection pool, it is shared with the authentication and sync cookie stores.
+func (s *PostgresSearchStore) Pool() *pgxpool.Pool {
+	return s.pool
+}
+
+func (s *PostgresSearchStore) AddSearchDocument(ctx context.Context, doc *SearchDocument) error {
+	return s.txRunner(
+		ctx,
+		"AddSearchDocument",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.addSearchDocumentTx(ctx, tx, doc)
+		},
+		nil,
+		"streamId", doc.StreamID,
+		"eventHash", doc.EventHash,
+	)
+}
+
+func (s *PostgresSearchStore) addSearchDocumentTx(ctx context.Context, tx pgx.Tx, doc *SearchDocument) error {
+	switch doc.Kind {
+	case SearchKind_SEARCH_KIND_USERNAME, SearchKind_SEARCH_KIND_DISPLAY_NAME:
+		if _, err := tx.Exec(
+			ctx,
+			`DELETE FROM search_documents WHERE stream_id = $1 AND creator = $2 AND kind = $3 AND created_at <= $4`,
+			doc.StreamID,
+			doc.Creator[:],
+			int16(doc.Kind),
+			doc.CreatedAt,
+		); err != nil {
+			return err
+		}
+	case SearchKind_SEARCH_KIND_CHANNEL_PROPERTIES:
+		if _, err := tx.Exec(
+			ctx,
+			`DELETE FROM search_documents WHERE stream_id = $1 AND kind = $2 AND created_at <= $3`,
+			doc.StreamID,
+			int16(doc.Kind),
+			doc.CreatedAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO search_documents (event_hash, stream_id, space_id, creator, kind, text, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (event_hash) DO NOTHING`,
+		doc.EventHash[:],
+		doc.StreamID,
+		doc.SpaceID,
+		doc.Creator[:],
+		int16(doc.Kind),
+		doc.Text,
+		doc.CreatedAt,
+	)
+	return err
+}
+
+func (s *PostgresSearchStore) DeleteSearchDocument(
+	ctx context.Context,
+	streamID shared.StreamId,
+	eventHash common.Hash,
+) error {
+	return s.txRunner(
+		ctx,
+		"DeleteSearchDocument",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`DELETE FROM search_documents WHERE stream_id = $1 AND event_hash = $2`,
+				streamID,
+				eventHash[:],
+			)
+			return err
+		},
+		nil,
+		"streamId", streamID,
+		"eventHash", eventHash,
+	)
+}
+
+func (s *PostgresSearchStore) DeleteStreamSearchDocuments(ctx context.Context, streamID shared.StreamId) error {
+	return s.txRunner(
+		ctx,
+		"DeleteStreamSearchDocuments",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(ctx, `DELETE FROM search_documents WHERE stream_id = $1`, streamID)
+			return err
+		},
+		nil,
+		"streamId", streamID,
+	)
+}
+
+func (s *PostgresSearchStore) SetStreamMembers(
+	ctx context.Context,
+	streamID shared.StreamId,
+	members []common.Address,
+) error {
+	return s.txRunner(
+		ctx,
+		"SetStreamMembers",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.setStreamMembersTx(ctx, tx, streamID, members)
+		},
+		nil,
+		"streamId", streamID,
+	)
+}
+
+func (s *PostgresSearchStore) setStreamMembersTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamID shared.StreamId,
+	members []common.Address,
+) error {
+	memberBytes := make([][]byte, len(members))
+	for i, member := range members {
+		memberBytes[i] = member.Bytes()
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		`DELETE FROM search_stream_members WHERE stream_id = $1 AND NOT (member = ANY($2))`,
+		streamID,
+		memberBytes,
+	); err != nil {
+		return err
+	}
+
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO search_stream_members (stream_id, member) SELECT $1, unnest($2::BYTEA[])
+			ON CONFLICT (member, stream_id) DO NOTHING`,
+		streamID,
+		memberBytes,
+	)
+	return err
+}
+
+func (s *PostgresSearchStore) Search(
+	ctx context.Context,
+	user common.Address,
+	query *SearchQuery,
+) ([]*SearchDocument, error) {
+	var (
+		err    error
+		result []*SearchDocument
+	)
+
+	err = s.txRunner(
+		ctx,
+		"Search",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			result, err = s.searchTx(ctx, tx, user, query)
+			return err
+		},
+		nil,
+		"user", user,
+	)
+
+	return result, err
+}
+
+func (s *PostgresSearchStore) searchTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	user common.Address,
+	query *SearchQuery,
+) ([]*SearchDocument, error) {
+	streamIDs := make([]string, len(query.StreamIDs))
+	for i, streamID := range query.StreamIDs {
+		streamIDs[i] = streamID.String()
+	}
+	kinds := make([]int16, len(query.Kinds))
+	for i, kind := range query.Kinds {
+		kinds[i] = int16(kind)
+	}
+
+	rows, err := tx.Query(
+		ctx,
+		`SELECT d.event_hash, d.stream_id::TEXT, d.space_id::TEXT, d.creator, d.kind, d.text, d.created_at
+			FROM search_documents d
+			JOIN search_stream_members m ON m.stream_id = d.stream_id AND m.member = $1
+			WHERE d.tsv @@ websearch_to_tsquery('simple', $2)
+				AND (cardinality($3::TEXT[]) = 0 OR d.stream_id = ANY($3))
+				AND ($4::TEXT IS NULL OR d.space_id = $4)
+				AND (cardinality($5::SMALLINT[]) = 0 OR d.kind = ANY($5))
+			ORDER BY ts_rank(d.tsv, websearch_to_tsquery('simple', $2)) DESC, d.created_at DESC
+			LIMIT $6 OFFSET $7`,
+		user[:],
+		query.Text,
+		streamIDs,
+		query.SpaceID,
+		kinds,
+		query.Limit,
+		query.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		result    []*SearchDocument
+		eventHash []byte
+		streamID  shared.StreamId
+		spaceID   *shared.StreamId
+		creator   []byte
+		kind      int16
+		text      string
+		createdAt time.Time
+	)
+	if _, err := pgx.ForEachRow(
+		rows,
+		[]any{&eventHash, &streamID, &spaceID, &creator, &kind, &text, &createdAt},
+		func() error {
+			result = append(result, &SearchDocument{
+				StreamID:  streamID,
+				SpaceID:   spaceID,
+				EventHash: common.BytesToHash(eventHash),
+				Creator:   common.BytesToAddress(creator),
+				Kind:      SearchKind(kind),
+				Text:      text,
+				CreatedAt: createdAt,
+			})
+			spaceID = nil
+			return nil
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
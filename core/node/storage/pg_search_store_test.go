@@ -0,0 +1,166 @@
+package storage_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/towns-protocol/towns/core/node/base/test"
+	"github.com/towns-protocol/towns/core/node/infra"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	"github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+	"github.com/towns-protocol/towns/core/node/testutils/dbtestutils"
+)
+
+func setupSearchStorageTest(t *testing.T) *storage.PostgresSearchStore {
+	require := require.New(t)
+	ctx := test.NewTestContext(t)
+
+	dbCfg, dbSchemaName, dbCloser, err := dbtestutils.ConfigureDbWithPrefix(ctx, "s_")
+	require.NoError(err, "Error configuring db for test")
+
+	dbCfg.StartupDelay = 2 * time.Millisecond
+	dbCfg.Extra = strings.Replace(dbCfg.Extra, "pool_max_conns=1000", "pool_max_conns=10", 1)
+
+	pool, err := storage.CreateAndValidatePgxPool(ctx, dbCfg, dbSchemaName, nil)
+	require.NoError(err, "Error creating pgx pool for test")
+
+	store, err := storage.NewPostgresSearchStore(
+		ctx,
+		pool,
+		make(chan error, 1),
+		infra.NewMetricsFactory(nil, "", ""),
+	)
+	require.NoError(err, "Error creating new postgres search store")
+
+	t.Cleanup(func() {
+		store.Close(ctx)
+		dbCloser()
+	})
+
+	return store
+}
+
+func searchTexts(docs []*storage.SearchDocument) []string {
+	texts := make([]string, len(docs))
+	for i, doc := range docs {
+		texts[i] = doc.Text
+	}
+	return texts
+}
+
+func TestSearchStore(t *testing.T) {
+	store := setupSearchStorageTest(t)
+	ctx := test.NewTestContext(t)
+	require := require.New(t)
+
+	alice, bob := safeAddress(t), safeAddress(t)
+	channelID := testutils.FakeStreamId(shared.STREAM_CHANNEL_BIN)
+	spaceID := channelID.SpaceID()
+	dmID := testutils.FakeStreamId(shared.STREAM_DM_CHANNEL_BIN)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	require.NoError(store.SetStreamMembers(ctx, channelID, []common.Address{alice, bob}))
+	require.NoError(store.SetStreamMembers(ctx, dmID, []common.Address{alice}))
+
+	hello := &storage.SearchDocument{
+		StreamID:  channelID,
+		SpaceID:   &spaceID,
+		EventHash: common.Hash{1},
+		Creator:   alice,
+		Kind:      SearchKind_SEARCH_KIND_MESSAGE,
+		Text:      "hello world",
+		CreatedAt: now,
+	}
+	require.NoError(store.AddSearchDocument(ctx, hello))
+	// indexing the same event twice is a no-op
+	require.NoError(store.AddSearchDocument(ctx, hello))
+	require.NoError(store.AddSearchDocument(ctx, &storage.SearchDocument{
+		StreamID:  dmID,
+		EventHash: common.Hash{2},
+		Creator:   alice,
+		Kind:      SearchKind_SEARCH_KIND_MESSAGE,
+		Text:      "hello from a dm",
+		CreatedAt: now.Add(time.Second),
+	}))
+
+	// results are restricted to streams the user is a member of
+	docs, err := store.Search(ctx, alice, &storage.SearchQuery{Text: "hello", Limit: 10})
+	require.NoError(err)
+	require.ElementsMatch([]string{"hello world", "hello from a dm"}, searchTexts(docs))
+
+	docs, err = store.Search(ctx, bob, &storage.SearchQuery{Text: "hello", Limit: 10})
+	require.NoError(err)
+	require.Equal([]string{"hello world"}, searchTexts(docs))
+	require.Equal(&spaceID, docs[0].SpaceID)
+	require.Equal(alice, docs[0].Creator)
+
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{Text: "hello", SpaceID: &spaceID, Limit: 10})
+	require.NoError(err)
+	require.Equal([]string{"hello world"}, searchTexts(docs))
+
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{
+		Text:      "hello",
+		StreamIDs: []shared.StreamId{dmID},
+		Limit:     10,
+	})
+	require.NoError(err)
+	require.Equal([]string{"hello from a dm"}, searchTexts(docs))
+
+	// members that left no longer find the stream content
+	require.NoError(store.SetStreamMembers(ctx, channelID, []common.Address{alice}))
+	docs, err = store.Search(ctx, bob, &storage.SearchQuery{Text: "hello", Limit: 10})
+	require.NoError(err)
+	require.Empty(docs)
+
+	// redacted events are removed from the index
+	require.NoError(store.DeleteSearchDocument(ctx, channelID, hello.EventHash))
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{Text: "world", Limit: 10})
+	require.NoError(err)
+	require.Empty(docs)
+
+	// a new username replaces the previous username of the member
+	for i, name := range []string{"alice", "wonderland"} {
+		require.NoError(store.AddSearchDocument(ctx, &storage.SearchDocument{
+			StreamID:  dmID,
+			EventHash: common.Hash{10, byte(i)},
+			Creator:   alice,
+			Kind:      SearchKind_SEARCH_KIND_USERNAME,
+			Text:      name,
+			CreatedAt: now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{
+		Text:  "alice or wonderland",
+		Kinds: []SearchKind{SearchKind_SEARCH_KIND_USERNAME},
+		Limit: 10,
+	})
+	require.NoError(err)
+	require.Equal([]string{"wonderland"}, searchTexts(docs))
+	// a channel name replaces the previous name of the channel, regardless of who changed it
+	for i, name := range []string{"general", "announcements"} {
+		require.NoError(store.AddSearchDocument(ctx, &storage.SearchDocument{
+			StreamID:  channelID,
+			SpaceID:   &spaceID,
+			EventHash: common.Hash(channelID),
+			Creator:   []common.Address{alice, bob}[i],
+			Kind:      SearchKind_SEARCH_KIND_CHANNEL_PROPERTIES,
+			Text:      name,
+			CreatedAt: now.Add(time.Duration(i) * time.Second),
+		}))
+	}
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{Text: "general or announcements", Limit: 10})
+	require.NoError(err)
+	require.Equal([]string{"announcements"}, searchTexts(docs))
+
+	// all documents of a stream can be removed at once
+	require.NoError(store.DeleteStreamSearchDocuments(ctx, dmID))
+	docs, err = store.Search(ctx, alice, &storage.SearchQuery{Text: "hello or wonderland", Limit: 10})
+	require.NoError(err)
+	require.Empty(docs)
+}
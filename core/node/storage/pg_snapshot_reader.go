@@ -0,0 +1,287 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage/external"
+)
+
+// PostgresSnapshotReader reads snapshots and miniblocks of streams from the database of a stream or
+// archive node. Unlike PostgresStreamStore it doesn't run migrations and doesn't take the schema lock,
+// all queries run in read-only transactions. This makes it safe to use against the database of a
+// running node.
+type PostgresSnapshotReader struct {
+	pool          *pgxpool.Pool
+	numPartitions int
+}
+
+// NewPostgresSnapshotReader creates a snapshot reader for the stream node schema of the given pool.
+func NewPostgresSnapshotReader(ctx context.Context, poolInfo *PgxPoolInfo) (*PostgresSnapshotReader, error) {
+	r := &PostgresSnapshotReader{pool: poolInfo.Pool}
+
+	if err := r.readOnlyTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		return tx.QueryRow(
+			ctx,
+			`SELECT num_partitions FROM settings WHERE single_row_key=true`,
+		).Scan(&r.numPartitions)
+	}); err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Message("Unable to read partition settings").
+			Tag("schema", poolInfo.Schema).
+			Func("NewPostgresSnapshotReader")
+	}
+
+	return r, nil
+}
+
+// Close closes the connection pool.
+func (r *PostgresSnapshotReader) Close() {
+	r.pool.Close()
+}
+
+func (r *PostgresSnapshotReader) readOnlyTx(ctx context.Context, txFn func(context.Context, pgx.Tx) error) error {
+	return pgx.BeginTxFunc(
+		ctx,
+		r.pool,
+		pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadOnly},
+		func(tx pgx.Tx) error {
+			return txFn(ctx, tx)
+		},
+	)
+}
+
+// SampleStreams returns up to limit randomly selected streams of the given type.
+func (r *PostgresSnapshotReader) SampleStreams(
+	ctx context.Context,
+	streamType byte,
+	limit int,
+) ([]StreamId, error) {
+	var streams []StreamId
+	if err := r.readOnlyTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			`SELECT stream_id::TEXT FROM es WHERE stream_id LIKE $1 ORDER BY random() LIMIT $2`,
+			fmt.Sprintf("%02x%%", streamType),
+			limit,
+		)
+		if err != nil {
+			return err
+		}
+
+		var streamId StreamId
+		_, err = pgx.ForEachRow(rows, []any{&streamId}, func() error {
+			streams = append(streams, streamId)
+			return nil
+		})
+		return err
+	}); err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Tag("streamType", StreamTypeToString(streamType)).
+			Func("PostgresSnapshotReader.SampleStreams")
+	}
+	return streams, nil
+}
+
+// ReadLatestSnapshotMiniblock returns the miniblock that holds the latest snapshot of the given stream.
+// Streams with miniblocks in external storage are not supported and return Err_UNAVAILABLE.
+func (r *PostgresSnapshotReader) ReadLatestSnapshotMiniblock(
+	ctx context.Context,
+	streamId StreamId,
+) (*MiniblockDescriptor, error) {
+	var mb *MiniblockDescriptor
+	if err := r.readOnlyTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var (
+			snapshotMiniblock int64
+			location          external.MiniblockDataStorageLocation
+		)
+		if err := tx.QueryRow(
+			ctx,
+			"SELECT latest_snapshot_miniblock, blockdata_ext FROM es WHERE stream_id = $1",
+			streamId,
+		).Scan(&snapshotMiniblock, &location); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return RiverError(Err_NOT_FOUND, "Stream not found")
+			}
+			return err
+		}
+		if location != external.MiniblockDataStorageLocationDB {
+			return RiverError(Err_UNAVAILABLE, "Stream miniblocks are stored in external storage")
+		}
+		// There is data corruption in prod when lastSnapshotMiniblock is -1.
+		if snapshotMiniblock < 0 {
+			snapshotMiniblock = 0
+		}
+
+		mb = &MiniblockDescriptor{Number: snapshotMiniblock}
+		if err := tx.QueryRow(
+			ctx,
+			fmt.Sprintf(
+				"SELECT blockdata, snapshot FROM miniblocks_%s WHERE stream_id = $1 AND seq_num = $2",
+				CreatePartitionSuffix(streamId, r.numPartitions),
+			),
+			streamId,
+			snapshotMiniblock,
+		).Scan(&mb.Data, &mb.Snapshot); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return RiverError(Err_NOT_FOUND, "Snapshot miniblock not found").
+					Tag("miniblockNum", snapshotMiniblock)
+			}
+			return err
+		}
+		if len(mb.Snapshot) == 0 {
+			mb.Snapshot = nil
+		}
+		return nil
+	}); err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Tag("streamId", streamId).
+			Func("PostgresSnapshotReader.ReadLatestSnapshotMiniblock")
+	}
+	return mb, nil
+}
+
+// dbMiniblocksPartition returns the miniblocks partition table for the given stream. Streams with
+// miniblocks in external storage are not supported and return Err_UNAVAILABLE.
+func (r *PostgresSnapshotReader) dbMiniblocksPartition(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+) (string, error) {
+	var location external.MiniblockDataStorageLocation
+	if err := tx.QueryRow(
+		ctx,
+		"SELECT blockdata_ext FROM es WHERE stream_id = $1",
+		streamId,
+	).Scan(&location); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", RiverError(Err_NOT_FOUND, "Stream not found")
+		}
+		return "", err
+	}
+	if location != external.MiniblockDataStorageLocationDB {
+		return "", RiverError(Err_UNAVAILABLE, "Stream miniblocks are stored in external storage")
+	}
+	return "miniblocks_" + CreatePartitionSuffix(streamId, r.numPartitions), nil
+}
+
+// GetMiniblockNumberRanges returns the contiguous spans of stored miniblock numbers for the stream.
+// Unlike PostgresStreamStore.GetMiniblockNumberRanges snapshots in legacy miniblocks are not reported.
+func (r *PostgresSnapshotReader) GetMiniblockNumberRanges(
+	ctx context.Context,
+	streamId StreamId,
+) ([]MiniblockRange, error) {
+	var ranges []MiniblockRange
+	if err := r.readOnlyTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		table, err := r.dbMiniblocksPartition(ctx, tx, streamId)
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(`
+			SELECT
+				MIN(seq_num) AS start_range,
+				MAX(seq_num) AS end_range,
+				ARRAY_AGG(seq_num ORDER BY seq_num) FILTER (WHERE has_snapshot) AS snapshot_seq_nums
+			FROM (
+				SELECT
+					seq_num,
+					(snapshot IS NOT NULL) AS has_snapshot,
+					seq_num - ROW_NUMBER() OVER (ORDER BY seq_num) AS grp
+				FROM %s
+				WHERE stream_id = $1
+			) AS sub
+			GROUP BY grp
+			ORDER BY start_range`, table),
+			streamId,
+		)
+		if err != nil {
+			return err
+		}
+
+		ranges, err = pgx.CollectRows(rows, pgx.RowToStructByPos[MiniblockRange])
+		return err
+	}); err != nil {
+		return nil, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Tag("streamId", streamId).
+			Func("PostgresSnapshotReader.GetMiniblockNumberRanges")
+	}
+	return ranges, nil
+}
+
+// ReadMiniblocks returns the miniblocks in [fromInclusive, toExclusive) of the given stream.
+// The second return value indicates that fromInclusive is the first stored miniblock of the stream.
+// An error is returned if miniblocks in the range are missing.
+func (r *PostgresSnapshotReader) ReadMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	fromInclusive int64,
+	toExclusive int64,
+	omitSnapshot bool,
+) ([]*MiniblockDescriptor, bool, error) {
+	var (
+		miniblocks []*MiniblockDescriptor
+		terminus   bool
+	)
+	if err := r.readOnlyTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		table, err := r.dbMiniblocksPartition(ctx, tx, streamId)
+		if err != nil {
+			return err
+		}
+
+		snapshotColumn := "snapshot"
+		if omitSnapshot {
+			snapshotColumn = "NULL::BYTEA"
+		}
+
+		// include the preceding miniblock to determine if the range starts at the beginning of the stream
+		rows, err := tx.Query(
+			ctx,
+			fmt.Sprintf(
+				`SELECT seq_num, blockdata, %s FROM %s WHERE stream_id = $1 AND seq_num >= $2 AND seq_num < $3
+				ORDER BY seq_num`,
+				snapshotColumn,
+				table,
+			),
+			streamId,
+			fromInclusive-1,
+			toExclusive,
+		)
+		if err != nil {
+			return err
+		}
+
+		terminus = true
+		var mb MiniblockDescriptor
+		_, err = pgx.ForEachRow(rows, []any{&mb.Number, &mb.Data, &mb.Snapshot}, func() error {
+			if mb.Number < fromInclusive {
+				terminus = false
+				return nil
+			}
+			expected := fromInclusive + int64(len(miniblocks))
+			if mb.Number != expected {
+				return RiverError(Err_MINIBLOCKS_NOT_FOUND, "Miniblocks missing in range").
+					Tags("expected", expected, "got", mb.Number)
+			}
+			cpy := mb
+			if len(cpy.Snapshot) == 0 {
+				cpy.Snapshot = nil
+			}
+			miniblocks = append(miniblocks, &cpy)
+			return nil
+		})
+		return err
+	}); err != nil {
+		return nil, false, AsRiverError(err, Err_DB_OPERATION_FAILURE).
+			Tags("streamId", streamId, "fromInclusive", fromInclusive, "toExclusive", toExclusive).
+			Func("PostgresSnapshotReader.ReadMiniblocks")
+	}
+	return miniblocks, terminus, nil
+}
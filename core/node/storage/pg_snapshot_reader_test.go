@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+func TestPostgresSnapshotReader(t *testing.T) {
+	params := setupStreamStorageTest(t)
+	require := require.New(t)
+	ctx := params.ctx
+	store := params.pgStreamStore
+
+	data := newDataMaker()
+	channelId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	genesis := data.mb(0, true)
+	require.NoError(store.CreateStreamStorage(ctx, channelId, genesis))
+	snapshotMb := data.mb(2, true)
+	require.NoError(store.WriteMiniblocks(
+		ctx,
+		channelId,
+		[]*MiniblockDescriptor{data.mb(1, false), snapshotMb, data.mb(3, false)},
+		4,
+		[][]byte{},
+		1,
+		0,
+	))
+
+	userId := testutils.FakeStreamId(STREAM_USER_BIN)
+	require.NoError(store.CreateStreamStorage(ctx, userId, data.mb(0, true)))
+
+	pool, err := CreateAndValidatePgxPool(ctx, params.config, params.schema, nil)
+	require.NoError(err)
+	reader, err := NewPostgresSnapshotReader(ctx, pool)
+	require.NoError(err)
+	t.Cleanup(reader.Close)
+
+	streams, err := reader.SampleStreams(ctx, STREAM_CHANNEL_BIN, 10)
+	require.NoError(err)
+	require.Equal([]StreamId{channelId}, streams)
+
+	streams, err = reader.SampleStreams(ctx, STREAM_USER_BIN, 10)
+	require.NoError(err)
+	require.Equal([]StreamId{userId}, streams)
+
+	streams, err = reader.SampleStreams(ctx, STREAM_SPACE_BIN, 10)
+	require.NoError(err)
+	require.Empty(streams)
+
+	mb, err := reader.ReadLatestSnapshotMiniblock(ctx, channelId)
+	require.NoError(err)
+	require.EqualValues(2, mb.Number)
+	require.Equal(snapshotMb.Data, mb.Data)
+	require.Equal(snapshotMb.Snapshot, mb.Snapshot)
+
+	_, err = reader.ReadLatestSnapshotMiniblock(ctx, testutils.FakeStreamId(STREAM_CHANNEL_BIN))
+	require.True(IsRiverErrorCode(err, Err_NOT_FOUND))
+
+	ranges, err := reader.GetMiniblockNumberRanges(ctx, channelId)
+	require.NoError(err)
+	require.Equal([]MiniblockRange{{StartInclusive: 0, EndInclusive: 3, SnapshotSeqNums: []int64{0, 2}}}, ranges)
+
+	mbs, terminus, err := reader.ReadMiniblocks(ctx, channelId, 1, 3, false)
+	require.NoError(err)
+	require.False(terminus)
+	require.Len(mbs, 2)
+	require.EqualValues(1, mbs[0].Number)
+	require.Equal(snapshotMb.Data, mbs[1].Data)
+	require.Equal(snapshotMb.Snapshot, mbs[1].Snapshot)
+
+	mbs, terminus, err = reader.ReadMiniblocks(ctx, channelId, 0, 10, true)
+	require.NoError(err)
+	require.True(terminus)
+	require.Len(mbs, 4)
+	require.Nil(mbs[2].Snapshot)
+}
@@ -3,7 +3,9 @@ package storage
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	. "github.com/towns-protocol/towns/core/node/base"
@@ -104,3 +106,137 @@ func TestArchive(t *testing.T) {
 	require.NoError(err)
 	require.Equal(int64(5), bn)
 }
+
+func TestImportStreamArchiveStorage(t *testing.T) {
+	params := setupStreamStorageTest(t)
+	require := require.New(t)
+
+	ctx := params.ctx
+	pgStreamStore := params.pgStreamStore
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+	// trimmed streams are imported starting from their first miniblock
+	data := []*MiniblockDescriptor{
+		mbDataForNumb(5, true),
+		mbDataForNumb(6, false),
+	}
+	data[0].Number, data[1].Number = 5, 7
+	err := pgStreamStore.ImportStreamArchiveStorage(ctx, streamId, data)
+	require.Error(err)
+	_, err = pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId)
+	require.Equal(Err_NOT_FOUND, AsRiverError(err).Code)
+
+	data[1].Number = 6
+	require.NoError(pgStreamStore.ImportStreamArchiveStorage(ctx, streamId, data))
+
+	bn, err := pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId)
+	require.NoError(err)
+	require.Equal(int64(6), bn)
+
+	err = pgStreamStore.ImportStreamArchiveStorage(ctx, streamId, data)
+	require.Equal(Err_ALREADY_EXISTS, AsRiverError(err).Code)
+
+	data2 := []*MiniblockDescriptor{mbDataForNumb(7, false)}
+	require.NoError(pgStreamStore.WriteArchiveMiniblocks(ctx, streamId, 7, data2))
+
+	readMBs, terminus, err := pgStreamStore.ReadMiniblocks(ctx, streamId, 5, 8, false)
+	require.NoError(err)
+	require.True(terminus)
+	require.Equal([]*MiniblockDescriptor{
+		{Number: 5, Data: data[0].Data, Snapshot: data[0].Snapshot},
+		{Number: 6, Data: data[1].Data, Snapshot: data[1].Snapshot},
+		{Number: 7, Data: data2[0].Data, Snapshot: data2[0].Snapshot},
+	}, readMBs)
+}
+
+func TestReplaceArchiveMiniblocks(t *testing.T) {
+	params := setupStreamStorageTest(t)
+	require := require.New(t)
+
+	ctx := params.ctx
+	pgStreamStore := params.pgStreamStore
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	require.NoError(pgStreamStore.CreateStreamArchiveStorage(ctx, streamId))
+
+	data := []*MiniblockDescriptor{
+		mbDataForNumb(0, true),
+		mbDataForNumb(1, false),
+		mbDataForNumb(2, false),
+	}
+	require.NoError(pgStreamStore.WriteArchiveMiniblocks(ctx, streamId, 0, data))
+
+	// The miniblock before the replaced range must exist, storage is left unchanged otherwise.
+	replacement := []*MiniblockDescriptor{{Data: []byte("repaired-3")}}
+	err := pgStreamStore.ReplaceArchiveMiniblocks(ctx, streamId, 4, replacement)
+	require.Error(err)
+	bn, err := pgStreamStore.GetMaxArchivedMiniblockNumber(ctx, streamId)
+	require.NoError(err)
+	require.Equal(int64(2), bn)
+
+	replacement = []*MiniblockDescriptor{{Data: []byte("repaired-1")}, {Data: []byte("repaired-2")}}
+	require.NoError(pgStreamStore.ReplaceArchiveMiniblocks(ctx, streamId, 1, replacement))
+
+	readMBs, _, err := pgStreamStore.ReadMiniblocks(ctx, streamId, 0, 3, false)
+	require.NoError(err)
+	require.Equal([]*MiniblockDescriptor{
+		{Number: 0, Data: data[0].Data, Snapshot: data[0].Snapshot},
+		{Number: 1, Data: replacement[0].Data},
+		{Number: 2, Data: replacement[1].Data},
+	}, readMBs)
+}
+
+func TestStreamRepairRecords(t *testing.T) {
+	params := setupStreamStorageTest(t)
+	require := require.New(t)
+
+	ctx := params.ctx
+	pgStreamStore := params.pgStreamStore
+
+	records, err := pgStreamStore.ReadStreamRepairRecords(ctx, 10)
+	require.NoError(err)
+	require.Empty(records)
+
+	streamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+	startedAt := time.Now().UTC().Truncate(time.Millisecond)
+	for i := range 3 {
+		record := &StreamRepairRecord{
+			StreamId:          streamId,
+			FromInclusive:     int64(i),
+			ToExclusive:       int64(i + 1),
+			SourceNode:        common.HexToAddress("0x01"),
+			LastMiniblockHash: common.HexToHash("0x02"),
+			Status:            "rescrubbing",
+			StartedAt:         startedAt,
+			FinishedAt:        startedAt.Add(time.Second),
+		}
+		require.NoError(pgStreamStore.WriteStreamRepairRecord(ctx, record))
+		require.NotZero(record.Id)
+
+		if i == 2 {
+			record.Status = "rescrub_failed"
+			record.Error = "bad block"
+			require.NoError(pgStreamStore.WriteStreamRepairRecord(ctx, record))
+		}
+	}
+
+	// The most recent records are returned, oldest first.
+	records, err = pgStreamStore.ReadStreamRepairRecords(ctx, 2)
+	require.NoError(err)
+	require.Len(records, 2)
+	require.Equal(int64(1), records[0].FromInclusive)
+	require.Equal("rescrubbing", records[0].Status)
+	require.Equal(int64(2), records[1].FromInclusive)
+	require.Equal("rescrub_failed", records[1].Status)
+	require.Equal("bad block", records[1].Error)
+	require.Equal(streamId, records[1].StreamId)
+	require.Equal(common.HexToAddress("0x01"), records[1].SourceNode)
+	require.Equal(common.HexToHash("0x02"), records[1].LastMiniblockHash)
+	require.True(startedAt.Equal(records[1].StartedAt))
+
+	// Every stored record is a repair attempt.
+	attempts, err := pgStreamStore.ReadStreamRepairAttempts(ctx)
+	require.NoError(err)
+	require.Equal(map[StreamId]uint32{streamId: 3}, attempts)
+}
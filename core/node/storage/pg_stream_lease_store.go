@@ -0,0 +1,239 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// StreamLeaseStore keeps track of notification service instances and the stream partitions they own.
+// Leases expire when they are not renewed which allows other instances to take over partitions of
+// instances that stopped.
+type StreamLeaseStore interface {
+	// HeartbeatStreamLeaseInstance records that the given instance is alive, removes instances that
+	// haven't sent a heartbeat within leaseDuration and returns the number of live instances.
+	HeartbeatStreamLeaseInstance(
+		ctx context.Context,
+		instanceID string,
+		leaseDuration time.Duration,
+	) (int, error)
+
+	// RemoveStreamLeaseInstance removes the given instance and releases all its leases.
+	RemoveStreamLeaseInstance(
+		ctx context.Context,
+		instanceID string,
+	) error
+
+	// RenewStreamLeases extends the leases the given instance holds and returns the renewed
+	// partitions. Leases that already expired are not renewed.
+	RenewStreamLeases(
+		ctx context.Context,
+		instanceID string,
+		leaseDuration time.Duration,
+	) ([]int, error)
+
+	// AcquireStreamLeases acquires at most limit partitions from the numPartitions partitions that are
+	// released or have an expired lease and returns the acquired partitions.
+	AcquireStreamLeases(
+		ctx context.Context,
+		instanceID string,
+		numPartitions int,
+		limit int,
+		leaseDuration time.Duration,
+	) ([]int, error)
+
+	// ReleaseStreamLeases releases the given partitions when they are owned by the given instance.
+	ReleaseStreamLeases(
+		ctx context.Context,
+		instanceID string,
+		partitions []int,
+	) error
+}
+
+var _ StreamLeaseStore = (*PostgresNotificationStore)(nil)
+
+// Pool returns the underlying database connection pool.
+func (s *PostgresNotificationStore) Pool() *pgxpool.Pool {
+	return s.pool
+}
+
+func (s *PostgresNotificationStore) HeartbeatStreamLeaseInstance(
+	ctx context.Context,
+	instanceID string,
+	leaseDuration time.Duration,
+) (int, error) {
+	var live int
+
+	err := s.txRunner(
+		ctx,
+		"HeartbeatStreamLeaseInstance",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(
+				ctx,
+				`INSERT INTO stream_lease_instances (instance_id, heartbeat_at) VALUES ($1, NOW())
+				ON CONFLICT (instance_id) DO UPDATE SET heartbeat_at = NOW()`,
+				instanceID,
+			); err != nil {
+				return err
+			}
+
+			if _, err := tx.Exec(
+				ctx,
+				`DELETE FROM stream_lease_instances WHERE heartbeat_at < NOW() - $1 * INTERVAL '1 millisecond'`,
+				leaseDuration.Milliseconds(),
+			); err != nil {
+				return err
+			}
+
+			return tx.QueryRow(ctx, `SELECT COUNT(*) FROM stream_lease_instances`).Scan(&live)
+		},
+		nil,
+		"instanceID", instanceID,
+	)
+
+	return live, err
+}
+
+func (s *PostgresNotificationStore) RemoveStreamLeaseInstance(
+	ctx context.Context,
+	instanceID string,
+) error {
+	return s.txRunner(
+		ctx,
+		"RemoveStreamLeaseInstance",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(
+				ctx,
+				`UPDATE stream_partition_leases SET owner = NULL, expires_at = NOW() WHERE owner = $1`,
+				instanceID,
+			); err != nil {
+				return err
+			}
+
+			_, err := tx.Exec(ctx, `DELETE FROM stream_lease_instances WHERE instance_id = $1`, instanceID)
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+	)
+}
+
+func (s *PostgresNotificationStore) RenewStreamLeases(
+	ctx context.Context,
+	instanceID string,
+	leaseDuration time.Duration,
+) ([]int, error) {
+	var partitions []int
+
+	err := s.txRunner(
+		ctx,
+		"RenewStreamLeases",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			rows, err := tx.Query(
+				ctx,
+				`UPDATE stream_partition_leases SET expires_at = NOW() + $2 * INTERVAL '1 millisecond'
+				WHERE owner = $1 AND expires_at > NOW() RETURNING partition`,
+				instanceID,
+				leaseDuration.Milliseconds(),
+			)
+			if err != nil {
+				return err
+			}
+
+			partitions, err = pgx.CollectRows(rows, pgx.RowTo[int])
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+	)
+
+	return partitions, err
+}
+
+func (s *PostgresNotificationStore) AcquireStreamLeases(
+	ctx context.Context,
+	instanceID string,
+	numPartitions int,
+	limit int,
+	leaseDuration time.Duration,
+) ([]int, error) {
+	var partitions []int
+
+	err := s.txRunner(
+		ctx,
+		"AcquireStreamLeases",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if _, err := tx.Exec(
+				ctx,
+				`INSERT INTO stream_partition_leases (partition, owner, expires_at)
+				SELECT p, NULL, NOW() FROM generate_series(0, $1 - 1) AS p
+				ON CONFLICT (partition) DO NOTHING`,
+				numPartitions,
+			); err != nil {
+				return err
+			}
+
+			// acquire partitions in random order to prevent instances that start at the
+			// same time from competing for the same partitions
+			rows, err := tx.Query(
+				ctx,
+				`UPDATE stream_partition_leases SET owner = $1, expires_at = NOW() + $4 * INTERVAL '1 millisecond'
+				WHERE partition IN (
+					SELECT partition FROM stream_partition_leases
+					WHERE partition < $2 AND (owner IS NULL OR expires_at <= NOW())
+					ORDER BY random() LIMIT $3 FOR UPDATE SKIP LOCKED
+				) RETURNING partition`,
+				instanceID,
+				numPartitions,
+				limit,
+				leaseDuration.Milliseconds(),
+			)
+			if err != nil {
+				return err
+			}
+
+			partitions, err = pgx.CollectRows(rows, pgx.RowTo[int])
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+		"limit", limit,
+	)
+
+	return partitions, err
+}
+
+func (s *PostgresNotificationStore) ReleaseStreamLeases(
+	ctx context.Context,
+	instanceID string,
+	partitions []int,
+) error {
+	if len(partitions) == 0 {
+		return nil
+	}
+
+	return s.txRunner(
+		ctx,
+		"ReleaseStreamLeases",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			_, err := tx.Exec(
+				ctx,
+				`UPDATE stream_partition_leases SET owner = NULL, expires_at = NOW()
+				WHERE owner = $1 AND partition = ANY($2)`,
+				instanceID,
+				partitions,
+			)
+			return err
+		},
+		nil,
+		"instanceID", instanceID,
+		"partitions", partitions,
+	)
+}
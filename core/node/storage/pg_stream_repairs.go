@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jackc/pgx/v5"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+)
+
+func (s *PostgresStreamStore) ReplaceArchiveMiniblocks(
+	ctx context.Context,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks []*MiniblockDescriptor,
+) error {
+	return s.txRunner(
+		ctx,
+		"ReplaceArchiveMiniblocks",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			return s.replaceArchiveMiniblocksTx(ctx, tx, streamId, startMiniblockNum, miniblocks)
+		},
+		nil,
+		"streamId", streamId,
+		"startMiniblockNum", startMiniblockNum,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
+func (s *PostgresStreamStore) replaceArchiveMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks []*MiniblockDescriptor,
+) error {
+	lockStream, err := s.lockStream(ctx, tx, streamId, true)
+	if err != nil {
+		return err
+	}
+	if !lockStream.MiniblocksStoredInDB() {
+		return RiverError(Err_FAILED_PRECONDITION, "Miniblocks are not stored in the DB", "streamId", streamId)
+	}
+
+	if _, err := tx.Exec(
+		ctx,
+		s.sqlForStream("DELETE FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num >= $2", streamId),
+		streamId,
+		startMiniblockNum,
+	); err != nil {
+		return err
+	}
+
+	var lastKnownMiniblockNum int64
+	if err := s.getMaxArchivedMiniblockNumberTxNoLock(
+		ctx,
+		tx,
+		streamId,
+		lockStream,
+		&lastKnownMiniblockNum,
+	); err != nil {
+		return err
+	}
+
+	if lastKnownMiniblockNum+1 != startMiniblockNum {
+		return RiverError(
+			Err_DB_OPERATION_FAILURE,
+			"miniblock sequence number mismatch",
+			"lastKnownMiniblockNum", lastKnownMiniblockNum,
+			"startMiniblockNum", startMiniblockNum,
+			"streamId", streamId,
+		)
+	}
+
+	return s.insertArchiveMiniblocksTx(ctx, tx, streamId, startMiniblockNum, miniblocks)
+}
+
+func (s *PostgresStreamStore) WriteStreamRepairRecord(ctx context.Context, record *StreamRepairRecord) error {
+	return s.txRunner(
+		ctx,
+		"WriteStreamRepairRecord",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if record.Id != 0 {
+				_, err := tx.Exec(
+					ctx,
+					"UPDATE stream_repairs SET status = $2, error = $3 WHERE id = $1",
+					record.Id,
+					record.Status,
+					record.Error,
+				)
+				return err
+			}
+			return tx.QueryRow(
+				ctx,
+				`INSERT INTO stream_repairs (stream_id, from_inclusive, to_exclusive, source_node, last_miniblock_hash,
+					corruption_error, status, error, started_at, finished_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING id`,
+				record.StreamId,
+				record.FromInclusive,
+				record.ToExclusive,
+				record.SourceNode.Bytes(),
+				record.LastMiniblockHash.Bytes(),
+				record.CorruptionError,
+				record.Status,
+				record.Error,
+				record.StartedAt,
+				record.FinishedAt,
+			).Scan(&record.Id)
+		},
+		nil,
+		"streamId", record.StreamId,
+		"status", record.Status,
+	)
+}
+
+func (s *PostgresStreamStore) ReadStreamRepairRecords(ctx context.Context, limit int) ([]*StreamRepairRecord, error) {
+	var records []*StreamRepairRecord
+	if err := s.txRunner(
+		ctx,
+		"ReadStreamRepairRecords",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			records = records[:0]
+			rows, err := tx.Query(
+				ctx,
+				`SELECT id, stream_id, from_inclusive, to_exclusive, source_node, last_miniblock_hash,
+					corruption_error, status, error, started_at, finished_at
+				FROM (SELECT * FROM stream_repairs ORDER BY id DESC LIMIT $1) AS recent ORDER BY id`,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+
+			var (
+				record     StreamRepairRecord
+				sourceNode []byte
+				lastHash   []byte
+			)
+			_, err = pgx.ForEachRow(rows, []any{
+				&record.Id,
+				&record.StreamId,
+				&record.FromInclusive,
+				&record.ToExclusive,
+				&sourceNode,
+				&lastHash,
+				&record.CorruptionError,
+				&record.Status,
+				&record.Error,
+				&record.StartedAt,
+				&record.FinishedAt,
+			}, func() error {
+				record.SourceNode = common.BytesToAddress(sourceNode)
+				record.LastMiniblockHash = common.BytesToHash(lastHash)
+				r := record
+				records = append(records, &r)
+				return nil
+			})
+			return err
+		},
+		nil,
+		"limit", limit,
+	); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s *PostgresStreamStore) ReadStreamRepairAttempts(ctx context.Context) (map[StreamId]uint32, error) {
+	var attempts map[StreamId]uint32
+	if err := s.txRunner(
+		ctx,
+		"ReadStreamRepairAttempts",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			attempts = make(map[StreamId]uint32)
+			rows, err := tx.Query(ctx, "SELECT stream_id, COUNT(*) FROM stream_repairs GROUP BY stream_id")
+			if err != nil {
+				return err
+			}
+
+			var (
+				streamId StreamId
+				count    int64
+			)
+			_, err = pgx.ForEachRow(rows, []any{&streamId, &count}, func() error {
+				attempts[streamId] = uint32(count)
+				return nil
+			})
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return attempts, nil
+}
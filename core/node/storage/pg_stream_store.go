@@ -669,7 +669,33 @@ func (s *PostgresStreamStore) createStreamStorageTx(
 		}
 		return err
 	}
-	return nil
+	return s.trackExpiringStreamTx(ctx, tx, streamId, genesisMiniblock)
+}
+
+// trackExpiringStreamTx records DM and GDM streams of which messages expire as configured in the settings of
+// the snapshot in the given miniblock. Stream settings are immutable, only these streams are swept for
+// expired messages by the stream trimmer.
+func (s *PostgresStreamStore) trackExpiringStreamTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	snapshotMiniblock *MiniblockDescriptor,
+) error {
+	if !streamMessagesCanExpire(streamId) {
+		return nil
+	}
+	settings := parseStreamSettings(snapshotMiniblock.Data, snapshotMiniblock.Snapshot)
+	if settings.GetMessageExpirationSeconds() == 0 {
+		return nil
+	}
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO expiring_streams (stream_id, message_expiration_seconds) VALUES ($1, $2)
+			ON CONFLICT (stream_id) DO NOTHING`,
+		streamId,
+		int64(min(settings.GetMessageExpirationSeconds(), math.MaxInt64)),
+	)
+	return err
 }
 
 func (s *PostgresStreamStore) maybeOverwriteCorruptGenesisMiniblockTx(
@@ -745,6 +771,40 @@ func (s *PostgresStreamStore) createStreamArchiveStorageTx(
 	return nil
 }
 
+func (s *PostgresStreamStore) ImportStreamArchiveStorage(
+	ctx context.Context,
+	streamId StreamId,
+	miniblocks []*MiniblockDescriptor,
+) error {
+	if len(miniblocks) == 0 {
+		return RiverError(Err_INVALID_ARGUMENT, "No miniblocks to import").
+			Func("ImportStreamArchiveStorage")
+	}
+	for i, mb := range miniblocks {
+		if mb.Number != miniblocks[0].Number+int64(i) {
+			return RiverError(Err_INVALID_ARGUMENT, "Miniblock numbers are not continuous",
+				"expected", miniblocks[0].Number+int64(i), "got", mb.Number).
+				Func("ImportStreamArchiveStorage")
+		}
+	}
+
+	return s.txRunner(
+		ctx,
+		"ImportStreamArchiveStorage",
+		pgx.ReadWrite,
+		func(ctx context.Context, tx pgx.Tx) error {
+			if err := s.createStreamArchiveStorageTx(ctx, tx, streamId); err != nil {
+				return err
+			}
+			return s.insertArchiveMiniblocksTx(ctx, tx, streamId, miniblocks[0].Number, miniblocks)
+		},
+		nil,
+		"streamId", streamId,
+		"startMiniblockNum", miniblocks[0].Number,
+		"numMiniblocks", len(miniblocks),
+	)
+}
+
 func (s *PostgresStreamStore) GetMaxArchivedMiniblockNumber(
 	ctx context.Context,
 	streamId StreamId,
@@ -878,6 +938,16 @@ func (s *PostgresStreamStore) writeArchiveMiniblocksTx(
 		)
 	}
 
+	return s.insertArchiveMiniblocksTx(ctx, tx, streamId, startMiniblockNum, miniblocks)
+}
+
+func (s *PostgresStreamStore) insertArchiveMiniblocksTx(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	startMiniblockNum int64,
+	miniblocks []*MiniblockDescriptor,
+) error {
 	for i, miniblock := range miniblocks {
 		if _, err := tx.Exec(
 			ctx,
@@ -2225,6 +2295,7 @@ func (s *PostgresStreamStore) deleteStreamTx(ctx context.Context, tx pgx.Tx, str
 			fmt.Sprintf("DELETE from %s WHERE stream_id = $1;", table)+
 				`DELETE from {{minipools}} WHERE stream_id = $1;
 				DELETE from {{miniblock_candidates}} where stream_id = $1;
+				DELETE FROM expiring_streams WHERE stream_id = $1;
 				DELETE FROM es WHERE stream_id = $1`,
 			streamId,
 		),
@@ -2746,6 +2817,192 @@ func (s *PostgresStreamStore) trimStreamTxNoLock(
 	return nil
 }
 
+// readMiniblockTimestampTxNoLock returns the timestamp from the header of the given miniblock.
+func (s *PostgresStreamStore) readMiniblockTimestampTxNoLock(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	seqNum int64,
+) (time.Time, error) {
+	var blockdata []byte
+	if err := tx.QueryRow(
+		ctx,
+		s.sqlForStream("SELECT blockdata FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num = $2", streamId),
+		streamId,
+		seqNum,
+	).Scan(&blockdata); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, RiverError(Err_MINIBLOCKS_NOT_FOUND, "Miniblock not found").
+				Tags("streamId", streamId, "seqNum", seqNum)
+		}
+		return time.Time{}, err
+	}
+
+	ts, ok := parseMiniblockTimestamp(blockdata)
+	if !ok {
+		return time.Time{}, RiverError(Err_MINIBLOCKS_STORAGE_FAILURE, "Unable to parse miniblock timestamp").
+			Tags("streamId", streamId, "seqNum", seqNum)
+	}
+	return ts, nil
+}
+
+// readStreamSettingsTxNoLock returns the stream settings from the snapshot of the given miniblock.
+// Returns nil if the miniblock has no snapshot or the snapshot can't be parsed.
+func (s *PostgresStreamStore) readStreamSettingsTxNoLock(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	seqNum int64,
+) (*StreamSettings, error) {
+	var blockdata, snapshot []byte
+	if err := tx.QueryRow(
+		ctx,
+		s.sqlForStream(
+			"SELECT blockdata, snapshot FROM {{miniblocks}} WHERE stream_id = $1 AND seq_num = $2",
+			streamId,
+		),
+		streamId,
+		seqNum,
+	).Scan(&blockdata, &snapshot); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, RiverError(Err_MINIBLOCKS_NOT_FOUND, "Miniblock not found").
+				Tags("streamId", streamId, "seqNum", seqNum)
+		}
+		return nil, err
+	}
+
+	return parseStreamSettings(blockdata, snapshot), nil
+}
+
+// parseStreamSettings returns the stream settings from the given snapshot, or from the legacy snapshot in the
+// miniblock header when snapshot is empty. Returns nil if there is no snapshot or it can't be parsed.
+func parseStreamSettings(blockdata []byte, snapshot []byte) *StreamSettings {
+	var sn *Snapshot
+	if len(snapshot) > 0 {
+		var env Envelope
+		if err := proto.Unmarshal(snapshot, &env); err != nil {
+			return nil
+		}
+		sn = &Snapshot{}
+		if err := proto.Unmarshal(env.GetEvent(), sn); err != nil {
+			return nil
+		}
+	} else {
+		// Legacy snapshots are stored in the miniblock header.
+		mb := &Miniblock{}
+		if err := proto.Unmarshal(blockdata, mb); err != nil {
+			return nil
+		}
+		header := &StreamEvent{}
+		if err := proto.Unmarshal(mb.GetHeader().GetEvent(), header); err != nil {
+			return nil
+		}
+		sn = header.GetMiniblockHeader().GetSnapshot()
+	}
+
+	if sn == nil || sn.GetInceptionPayload() == nil {
+		return nil
+	}
+	return sn.GetInceptionPayload().GetSettings()
+}
+
+// listStreamsWithPrefix returns up to limit streams of which the id starts with the given prefix and is greater
+// than after, ordered by stream id.
+func (s *PostgresStreamStore) listStreamsWithPrefix(
+	ctx context.Context,
+	prefix string,
+	after string,
+	limit int,
+) ([]StreamId, error) {
+	var streams []StreamId
+	if err := s.txRunner(
+		ctx,
+		"listStreamsWithPrefix",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			streams = streams[:0]
+			rows, err := tx.Query(
+				ctx,
+				`SELECT stream_id FROM es WHERE ephemeral = false AND stream_id LIKE $1 AND stream_id > $2
+				ORDER BY stream_id LIMIT $3`,
+				prefix+"%",
+				after,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+
+			var streamId StreamId
+			_, err = pgx.ForEachRow(rows, []any{&streamId}, func() error {
+				streams = append(streams, streamId)
+				return nil
+			})
+			return err
+		},
+		nil,
+		"prefix", prefix,
+	); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// listExpiringStreams returns up to limit streams of which messages expire with an id greater than after,
+// ordered by stream id.
+func (s *PostgresStreamStore) listExpiringStreams(
+	ctx context.Context,
+	after string,
+	limit int,
+) ([]StreamId, error) {
+	var streams []StreamId
+	if err := s.txRunner(
+		ctx,
+		"listExpiringStreams",
+		pgx.ReadOnly,
+		func(ctx context.Context, tx pgx.Tx) error {
+			streams = streams[:0]
+			rows, err := tx.Query(
+				ctx,
+				`SELECT stream_id FROM expiring_streams WHERE stream_id > $1 ORDER BY stream_id LIMIT $2`,
+				after,
+				limit,
+			)
+			if err != nil {
+				return err
+			}
+
+			var streamId StreamId
+			_, err = pgx.ForEachRow(rows, []any{&streamId}, func() error {
+				streams = append(streams, streamId)
+				return nil
+			})
+			return err
+		},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	return streams, nil
+}
+
+// parseMiniblockTimestamp returns the timestamp from the header of the given encoded miniblock.
+func parseMiniblockTimestamp(data []byte) (time.Time, bool) {
+	mb := &Miniblock{}
+	if err := proto.Unmarshal(data, mb); err != nil {
+		return time.Time{}, false
+	}
+	header := &StreamEvent{}
+	if err := proto.Unmarshal(mb.GetHeader().GetEvent(), header); err != nil {
+		return time.Time{}, false
+	}
+	ts := header.GetMiniblockHeader().GetTimestamp()
+	if ts == nil {
+		return time.Time{}, false
+	}
+	return ts.AsTime(), true
+}
+
 func parseAndCheckHasLegacySnapshot(data []byte) bool {
 	mb := &Miniblock{}
 	if err := proto.Unmarshal(data, mb); err != nil {
@@ -2917,6 +3174,15 @@ func (s *PostgresStreamStore) reinitializeStreamStorageTx(
 		return err
 	}
 
+	if err := s.trackExpiringStreamTx(
+		ctx,
+		tx,
+		streamId,
+		miniblocks[lastSnapshotMiniblockNum-miniblocks[0].Number],
+	); err != nil {
+		return err
+	}
+
 	// Create new minipool with generation = last miniblock + 1
 	lastMiniblockNum := miniblocks[len(miniblocks)-1].Number
 	if lastMiniblockNum == math.MaxInt64 {
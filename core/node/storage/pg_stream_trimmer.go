@@ -3,8 +3,10 @@ package storage
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gammazero/workerpool"
@@ -27,11 +29,15 @@ type trimTask struct {
 	// targetMiniblock is the per-streamId trim target from on-chain config.
 	// -1 means no per-streamId target is configured.
 	targetMiniblock int64
+	// historyRetention is the maximum age of the miniblocks to keep.
+	// 0 means miniblocks are not trimmed by age.
+	historyRetention time.Duration
 }
 
 // streamTrimmer handles periodic trimming of streams.
-// It ensures that the number of miniblocks in a stream does not exceed a certain threshold and
-// that snapshots are retained according to the configured retention interval.
+// It ensures that the number of miniblocks in a stream does not exceed a certain threshold, that miniblocks
+// older than the configured retention are removed and that snapshots are retained according to the configured
+// retention interval.
 type streamTrimmer struct {
 	ctx               context.Context
 	log               *logging.Log
@@ -53,6 +59,9 @@ type streamTrimmer struct {
 	scheduledPerStreamTargetsLock sync.Mutex
 	scheduledPerStreamTargets     map[StreamId]int64
 
+	// sweeping is set while an age sweep runs, sweeps don't overlap.
+	sweeping atomic.Bool
+
 	stopOnce sync.Once
 	stop     chan struct{}
 
@@ -92,13 +101,22 @@ func newStreamTrimmer(
 	return st
 }
 
-// configCheckInterval is how often the trimmer checks for new per-streamId trim targets in the config.
-const configCheckInterval = time.Minute
+const (
+	// configCheckInterval is how often the trimmer checks for new per-streamId trim targets in the config.
+	configCheckInterval = time.Minute
+	// ageSweepInterval is how often the trimmer sweeps the streams that are trimmed by age.
+	ageSweepInterval = time.Hour
+	// ageSweepBatchSize is the number of streams that are read at once during an age sweep.
+	ageSweepBatchSize = 1000
+)
 
-// monitorWorkerPool monitors the worker pool, handles shutdown, and checks for config changes.
+// monitorWorkerPool monitors the worker pool, handles shutdown, checks for config changes and
+// periodically sweeps the streams that are trimmed by age.
 func (t *streamTrimmer) monitorWorkerPool(ctx context.Context) {
 	ticker := time.NewTicker(configCheckInterval)
 	defer ticker.Stop()
+	sweepTicker := time.NewTicker(ageSweepInterval)
+	defer sweepTicker.Stop()
 
 	for {
 		select {
@@ -111,6 +129,8 @@ func (t *streamTrimmer) monitorWorkerPool(ctx context.Context) {
 		case <-ticker.C:
 			// Periodically check for new per-streamId trim targets in the config
 			go t.scheduleNewPerStreamTargets()
+		case <-sweepTicker.C:
+			go t.sweepAgedStreams()
 		}
 	}
 }
@@ -175,13 +195,39 @@ func (t *streamTrimmer) tryScheduleTrimming(streamId StreamId) {
 }
 
 func (t *streamTrimmer) computeTrimTask(streamId StreamId) (trimTask, bool) {
-	cfg := t.config.Get()
+	if t.config.Get().StreamTrimActivationFactor == 0 {
+		return trimTask{}, false
+	}
 
-	var task trimTask
+	task, ok := t.newTrimTask(streamId)
+	if !ok {
+		return task, false
+	}
+
+	// Per-streamId targets should schedule immediately (bypass activation factor).
+	// This ensures dormant streams with configured targets are trimmed at startup.
+	if task.targetMiniblock >= 0 {
+		return task, true
+	}
 
-	if cfg.StreamTrimActivationFactor == 0 {
+	activationFactor := t.config.Get().StreamTrimActivationFactor
+	t.snapshotsPerStreamLock.Lock()
+	defer t.snapshotsPerStreamLock.Unlock()
+	count := t.snapshotsPerStream[streamId] + 1
+	if count%activationFactor != 0 {
+		t.snapshotsPerStream[streamId] = count % activationFactor
 		return task, false
 	}
+	delete(t.snapshotsPerStream, streamId)
+	return task, true
+}
+
+// newTrimTask returns the trim task for the given stream as configured in the on-chain config.
+// False is returned if the stream isn't trimmed.
+func (t *streamTrimmer) newTrimTask(streamId StreamId) (trimTask, bool) {
+	cfg := t.config.Get()
+
+	var task trimTask
 
 	if cfg.MinSnapshotEvents.ForType(streamId.Type()) == 0 {
 		return task, false
@@ -203,30 +249,13 @@ func (t *streamTrimmer) computeTrimTask(streamId StreamId) (trimTask, bool) {
 	// Get per-streamId trim target from on-chain config
 	targetMiniblock, hasTarget := t.getTrimTargetForStream(streamId)
 
-	// If no per-type trimming is configured and no per-streamId target, skip
-	if streamHistoryMbs <= 0 && retentionIntervalMbs <= 0 && !hasTarget {
-		return task, false
-	}
-
-	var shouldSchedule bool
-
-	// Per-streamId targets should schedule immediately (bypass activation factor).
-	// This ensures dormant streams with configured targets are trimmed at startup.
-	if hasTarget {
-		shouldSchedule = true
-	} else {
-		t.snapshotsPerStreamLock.Lock()
-		count := t.snapshotsPerStream[streamId] + 1
-		if count%cfg.StreamTrimActivationFactor != 0 {
-			t.snapshotsPerStream[streamId] = count % cfg.StreamTrimActivationFactor
-		} else {
-			delete(t.snapshotsPerStream, streamId)
-			shouldSchedule = true
-		}
-		t.snapshotsPerStreamLock.Unlock()
-	}
+	historyRetention := cfg.HistoryRetentionForStream(streamId)
 
-	if !shouldSchedule {
+	// If no per-type or per-space trimming is configured and no per-streamId target, skip.
+	// Messages in DM and GDM streams can expire, which is configured in the stream settings that are
+	// only known when the task is processed.
+	if streamHistoryMbs <= 0 && retentionIntervalMbs <= 0 && historyRetention <= 0 && !hasTarget &&
+		!streamMessagesCanExpire(streamId) {
 		return task, false
 	}
 
@@ -235,9 +264,131 @@ func (t *streamTrimmer) computeTrimTask(streamId StreamId) (trimTask, bool) {
 		streamHistoryMbs:     streamHistoryMbs,
 		retentionIntervalMbs: retentionIntervalMbs,
 		targetMiniblock:      targetMiniblock,
+		historyRetention:     historyRetention,
 	}, true
 }
 
+// sweepAgedStreams schedules a trim task for every stream that is trimmed by age: DM and GDM streams of which
+// messages expire and streams with a per-type or per-space history retention. Trimming on miniblock writes
+// depends on the activation factor and doesn't reach streams that aren't written to, the sweep ensures aged
+// miniblocks and expired messages are deleted from storage regardless. Like trimming on writes, the sweep is
+// disabled when the activation factor is 0.
+//
+// Streams are never trimmed beyond their last snapshot. Expired messages in the miniblocks after it stay in
+// storage until a later snapshot is created, they are removed from the miniblocks that are returned to clients.
+func (t *streamTrimmer) sweepAgedStreams() {
+	if t.config.Get().StreamTrimActivationFactor == 0 {
+		return
+	}
+	if !t.sweeping.CompareAndSwap(false, true) {
+		return
+	}
+	defer t.sweeping.Store(false)
+
+	for _, prefix := range ageSweepPrefixes(t.config.Get()) {
+		if err := t.sweepStreams(func(after string) ([]StreamId, error) {
+			return t.store.listStreamsWithPrefix(t.ctx, prefix, after, ageSweepBatchSize)
+		}); err != nil {
+			t.log.Errorw("Failed to sweep streams trimmed by age", "prefix", prefix, "error", err)
+		}
+	}
+
+	if err := t.sweepStreams(func(after string) ([]StreamId, error) {
+		return t.store.listExpiringStreams(t.ctx, after, ageSweepBatchSize)
+	}); err != nil {
+		t.log.Errorw("Failed to sweep streams with expiring messages", "error", err)
+	}
+}
+
+// ageSweepStreamTypes are the stream types that can be configured with a per-type history retention.
+var ageSweepStreamTypes = []byte{
+	STREAM_CHANNEL_BIN,
+	STREAM_DM_CHANNEL_BIN,
+	STREAM_GDM_CHANNEL_BIN,
+	STREAM_METADATA_BIN,
+	STREAM_SPACE_BIN,
+	STREAM_USER_BIN,
+	STREAM_USER_METADATA_KEY_BIN,
+	STREAM_USER_INBOX_BIN,
+	STREAM_USER_SETTINGS_BIN,
+}
+
+// ageSweepPrefixes returns the stream id prefixes of the streams that are trimmed by age with the given config.
+// Per-space retention policies add the space stream and the channels of the space if their stream type isn't
+// already swept. DM and GDM streams with expiring messages are swept separately.
+func ageSweepPrefixes(cfg *crypto.OnChainSettings) []string {
+	sweptTypes := make(map[byte]bool, len(ageSweepStreamTypes))
+	for _, streamType := range ageSweepStreamTypes {
+		if cfg.StreamHistoryRetention.ForType(streamType) > 0 {
+			sweptTypes[streamType] = true
+		}
+	}
+
+	var prefixes []string
+	for _, streamType := range ageSweepStreamTypes {
+		if sweptTypes[streamType] {
+			prefixes = append(prefixes, hex.EncodeToString([]byte{streamType}))
+		}
+	}
+
+	for _, entry := range cfg.StreamHistoryRetentionBySpace {
+		if entry.Age <= 0 {
+			continue
+		}
+		if !sweptTypes[STREAM_SPACE_BIN] {
+			prefixes = append(prefixes, StreamId(entry.StreamId).String())
+		}
+		if !sweptTypes[STREAM_CHANNEL_BIN] {
+			prefixes = append(prefixes, STREAM_CHANNEL_PREFIX+hex.EncodeToString(entry.StreamId[1:21]))
+		}
+	}
+
+	return prefixes
+}
+
+// sweepStreams schedules a trim task for every stream returned by list, which returns the next batch of at
+// most ageSweepBatchSize streams ordered by stream id after the given stream id.
+func (t *streamTrimmer) sweepStreams(list func(after string) ([]StreamId, error)) error {
+	after := ""
+	for {
+		streamIds, err := list(after)
+		if err != nil {
+			return err
+		}
+
+		for _, streamId := range streamIds {
+			if err := t.waitForWorkerPool(); err != nil {
+				return err
+			}
+			if task, ok := t.newTrimTask(streamId); ok {
+				t.scheduleTrimTask(task)
+			}
+		}
+
+		if len(streamIds) < ageSweepBatchSize {
+			return nil
+		}
+		after = streamIds[len(streamIds)-1].String()
+	}
+}
+
+// waitForWorkerPool blocks until the worker pool accepts new tasks.
+func (t *streamTrimmer) waitForWorkerPool() error {
+	for t.workerPool.WaitingQueueSize() >= maxWorkerPoolPendingTasks {
+		select {
+		case <-t.ctx.Done():
+			return t.ctx.Err()
+		case <-t.stop:
+			return RiverError(Err_CANCELED, "Stream trimmer stopped")
+		case <-time.After(time.Second):
+		}
+	}
+	if t.workerPool.Stopped() {
+		return RiverError(Err_CANCELED, "Worker pool stopped")
+	}
+	return nil
+}
+
 // scheduleTrimTask schedules a new trim task for the given stream
 func (t *streamTrimmer) scheduleTrimTask(task trimTask) {
 	t.pendingTasksLock.Lock()
@@ -258,6 +409,7 @@ func (t *streamTrimmer) scheduleTrimTask(task trimTask) {
 				"stream", task.streamId,
 				"streamHistoryMbs", task.streamHistoryMbs,
 				"retentionIntervalMbs", task.retentionIntervalMbs,
+				"historyRetention", task.historyRetention,
 				"error", err,
 			)
 		}
@@ -284,6 +436,7 @@ func (t *streamTrimmer) processTrimTask(task trimTask) error {
 		"streamId", task.streamId,
 		"streamHistoryMbs", task.streamHistoryMbs,
 		"retentionIntervalMbs", task.retentionIntervalMbs,
+		"historyRetention", task.historyRetention,
 	)
 }
 
@@ -349,7 +502,73 @@ func (t *streamTrimmer) processTrimTaskTx(
 		lastMbToKeep = task.targetMiniblock
 	}
 
+	// Messages in DM and GDM streams expire as configured in the stream settings.
+	// Miniblocks of which all messages are expired are trimmed like miniblocks older than the history retention.
+	historyRetention := task.historyRetention
+	if streamMessagesCanExpire(task.streamId) && lockStream.MiniblocksStoredInDB() {
+		settings, err := t.store.readStreamSettingsTxNoLock(ctx, tx, task.streamId, lastSnapshotMiniblock)
+		if err != nil {
+			return err
+		}
+		expiration := time.Duration(settings.GetMessageExpirationSeconds()) * time.Second
+		if expiration > 0 && (historyRetention <= 0 || expiration < historyRetention) {
+			historyRetention = expiration
+		}
+	}
+
+	// Apply the age based retention if configured.
+	// Miniblocks older than the retention are trimmed, but never beyond the last snapshot.
+	if historyRetention > 0 && lockStream.MiniblocksStoredInDB() &&
+		latestRange.StartInclusive < lastSnapshotMiniblock {
+		firstMbToKeep, err := t.findFirstMiniblockNewerThan(
+			ctx,
+			tx,
+			task.streamId,
+			latestRange.StartInclusive,
+			lastSnapshotMiniblock,
+			time.Now().Add(-historyRetention),
+		)
+		if err != nil {
+			return err
+		}
+		if firstMbToKeep > lastMbToKeep {
+			lastMbToKeep = firstMbToKeep
+		}
+	}
+
 	// Deleting all miniblocks below the calculated miniblock with a snapshot which is the closest to the lastMbToKeep.
 	localStartMbInclusive := FindClosestSnapshotMiniblock(ranges, lastMbToKeep)
 	return t.store.trimStreamTxNoLock(ctx, tx, task.streamId, localStartMbInclusive, nullifySnapshotMbs)
 }
+
+// findFirstMiniblockNewerThan returns the first miniblock in [fromInclusive, toInclusive] with a timestamp
+// at or after the given cutoff. Returns toInclusive when all miniblocks in the range are older.
+// Miniblock timestamps are increasing, so the range is binary searched.
+func (t *streamTrimmer) findFirstMiniblockNewerThan(
+	ctx context.Context,
+	tx pgx.Tx,
+	streamId StreamId,
+	fromInclusive int64,
+	toInclusive int64,
+	cutoff time.Time,
+) (int64, error) {
+	lo, hi := fromInclusive, toInclusive
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		ts, err := t.store.readMiniblockTimestampTxNoLock(ctx, tx, streamId, mid)
+		if err != nil {
+			return 0, err
+		}
+		if ts.Before(cutoff) {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, nil
+}
+
+// streamMessagesCanExpire returns true if messages in the given stream can expire through the stream settings.
+func streamMessagesCanExpire(streamId StreamId) bool {
+	return streamId.Type() == STREAM_DM_CHANNEL_BIN || streamId.Type() == STREAM_GDM_CHANNEL_BIN
+}
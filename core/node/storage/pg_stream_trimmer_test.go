@@ -13,6 +13,8 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/towns-protocol/towns/core/node/base"
 	"github.com/towns-protocol/towns/core/node/crypto"
@@ -447,6 +449,324 @@ func TestStreamTrimmer(t *testing.T) {
 		}, time.Second*5, 100*time.Millisecond)
 	})
 
+	t.Run("miniblocks_older_than_history_retention_are_trimmed", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		ctx := params.ctx
+		pgStreamStore := params.pgStreamStore
+		require := require.New(t)
+
+		// Only the age based retention is enabled: keep miniblocks from the last 24 hours.
+		cfg := pgStreamStore.streamTrimmer.config.Get()
+		cfg.StreamHistoryMiniblocks = crypto.StreamHistoryMiniblocks{}
+		cfg.StreamSnapshotIntervalInMiniblocks = 0
+		cfg.StreamHistoryRetention = crypto.StreamHistoryRetention{Space: 24 * time.Hour}
+
+		streamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+
+		// Miniblock i is created 60-i hours ago.
+		now := time.Now()
+		mbTime := func(i int) time.Time { return now.Add(-time.Duration(60-i) * time.Hour) }
+
+		genesisMb := &MiniblockDescriptor{
+			Data:     makeTimestampedMiniblockData(t, 0, mbTime(0)),
+			Snapshot: []byte("genesisSnapshot"),
+		}
+		require.NoError(pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMb))
+
+		var envelopes [][]byte
+		envelopes = append(envelopes, []byte("event"))
+
+		// Generate 60 miniblocks with snapshot every 10th miniblock
+		mbs := make([]*MiniblockDescriptor, 60)
+		for i := 1; i <= 60; i++ {
+			mb := &MiniblockDescriptor{
+				Number: int64(i),
+				Hash:   common.BytesToHash([]byte("block_hash" + strconv.Itoa(i))),
+				Data:   makeTimestampedMiniblockData(t, int64(i), mbTime(i)),
+			}
+			if i%10 == 0 {
+				mb.Snapshot = []byte("snapshot" + strconv.Itoa(i))
+			}
+			mbs[i-1] = mb
+		}
+
+		require.NoError(pgStreamStore.WriteMiniblocks(
+			ctx,
+			streamId,
+			mbs,
+			mbs[len(mbs)-1].Number+1,
+			envelopes,
+			mbs[0].Number,
+			-1,
+		))
+
+		// Miniblocks 37..60 are within the retention, the stream is trimmed to the closest snapshot before it.
+		expectedSeqs := make([]int64, 0, 31)
+		for i := int64(30); i <= 60; i++ {
+			expectedSeqs = append(expectedSeqs, i)
+		}
+		expectedSnapshots := []int64{30, 40, 50, 60}
+		require.Eventually(func() bool {
+			seqs, snapshots := collectStreamState(t, pgStreamStore, ctx, streamId)
+			return slices.Equal(expectedSeqs, seqs) && slices.Equal(expectedSnapshots, snapshots)
+		}, time.Second*5, 100*time.Millisecond)
+	})
+
+	t.Run("history_retention_never_trims_beyond_last_snapshot", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		ctx := params.ctx
+		pgStreamStore := params.pgStreamStore
+		require := require.New(t)
+
+		cfg := pgStreamStore.streamTrimmer.config.Get()
+		cfg.StreamHistoryMiniblocks = crypto.StreamHistoryMiniblocks{}
+		cfg.StreamSnapshotIntervalInMiniblocks = 0
+		cfg.StreamHistoryRetention = crypto.StreamHistoryRetention{Space: time.Hour}
+
+		streamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+
+		// All miniblocks are older than the retention.
+		old := time.Now().Add(-48 * time.Hour)
+
+		genesisMb := &MiniblockDescriptor{
+			Data:     makeTimestampedMiniblockData(t, 0, old),
+			Snapshot: []byte("genesisSnapshot"),
+		}
+		require.NoError(pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMb))
+
+		var envelopes [][]byte
+		envelopes = append(envelopes, []byte("event"))
+
+		mbs := make([]*MiniblockDescriptor, 25)
+		for i := 1; i <= 25; i++ {
+			mb := &MiniblockDescriptor{
+				Number: int64(i),
+				Hash:   common.BytesToHash([]byte("block_hash" + strconv.Itoa(i))),
+				Data:   makeTimestampedMiniblockData(t, int64(i), old.Add(time.Duration(i)*time.Minute)),
+			}
+			if i%10 == 0 {
+				mb.Snapshot = []byte("snapshot" + strconv.Itoa(i))
+			}
+			mbs[i-1] = mb
+		}
+
+		require.NoError(pgStreamStore.WriteMiniblocks(
+			ctx,
+			streamId,
+			mbs,
+			mbs[len(mbs)-1].Number+1,
+			envelopes,
+			mbs[0].Number,
+			-1,
+		))
+
+		// The last snapshot and the miniblocks after it are kept.
+		expectedSeqs := []int64{20, 21, 22, 23, 24, 25}
+		expectedSnapshots := []int64{20}
+		require.Eventually(func() bool {
+			seqs, snapshots := collectStreamState(t, pgStreamStore, ctx, streamId)
+			return slices.Equal(expectedSeqs, seqs) && slices.Equal(expectedSnapshots, snapshots)
+		}, time.Second*5, 100*time.Millisecond)
+	})
+
+	t.Run("expired_messages_are_swept_without_trim_activation", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		ctx := params.ctx
+		pgStreamStore := params.pgStreamStore
+		require := require.New(t)
+
+		// Trimming on miniblock writes is never activated, only the sweep trims the stream.
+		cfg := pgStreamStore.streamTrimmer.config.Get()
+		cfg.StreamTrimActivationFactor = math.MaxUint64
+		cfg.StreamHistoryMiniblocks = crypto.StreamHistoryMiniblocks{}
+		cfg.StreamSnapshotIntervalInMiniblocks = 0
+
+		streamId := testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN)
+
+		// Messages expire after 24 hours, miniblock i is created 60-i hours ago.
+		snapshot := makeDmSnapshot(t, streamId, 24*time.Hour)
+		now := time.Now()
+		mbTime := func(i int) time.Time { return now.Add(-time.Duration(60-i) * time.Hour) }
+
+		genesisMb := &MiniblockDescriptor{
+			Data:     makeTimestampedMiniblockData(t, 0, mbTime(0)),
+			Snapshot: snapshot,
+		}
+		require.NoError(pgStreamStore.CreateStreamStorage(ctx, streamId, genesisMb))
+
+		mbs := make([]*MiniblockDescriptor, 60)
+		for i := 1; i <= 60; i++ {
+			mb := &MiniblockDescriptor{
+				Number: int64(i),
+				Hash:   common.BytesToHash([]byte("block_hash" + strconv.Itoa(i))),
+				Data:   makeTimestampedMiniblockData(t, int64(i), mbTime(i)),
+			}
+			if i%10 == 0 {
+				mb.Snapshot = snapshot
+			}
+			mbs[i-1] = mb
+		}
+
+		require.NoError(pgStreamStore.WriteMiniblocks(
+			ctx,
+			streamId,
+			mbs,
+			mbs[len(mbs)-1].Number+1,
+			[][]byte{[]byte("event")},
+			mbs[0].Number,
+			-1,
+		))
+
+		seqs, _ := collectStreamState(t, pgStreamStore, ctx, streamId)
+		require.Len(seqs, 61)
+
+		// Only DM and GDM streams with expiring messages are swept.
+		otherStreamId := testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN)
+		require.NoError(pgStreamStore.CreateStreamStorage(ctx, otherStreamId, &MiniblockDescriptor{
+			Data:     makeTimestampedMiniblockData(t, 0, mbTime(0)),
+			Snapshot: makeDmSnapshot(t, otherStreamId, 0),
+		}))
+		expiring, err := pgStreamStore.listExpiringStreams(ctx, "", ageSweepBatchSize)
+		require.NoError(err)
+		require.Equal([]StreamId{streamId}, expiring)
+
+		pgStreamStore.streamTrimmer.sweepAgedStreams()
+
+		// Miniblocks 37..60 hold messages that are not expired, the stream is trimmed to the closest snapshot.
+		expectedSeqs := make([]int64, 0, 31)
+		for i := int64(30); i <= 60; i++ {
+			expectedSeqs = append(expectedSeqs, i)
+		}
+		require.Eventually(func() bool {
+			seqs, _ := collectStreamState(t, pgStreamStore, ctx, streamId)
+			return slices.Equal(expectedSeqs, seqs)
+		}, time.Second*5, 100*time.Millisecond)
+	})
+
+	t.Run("sweep_is_disabled_with_activation_factor_0", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		ctx := params.ctx
+		pgStreamStore := params.pgStreamStore
+		require := require.New(t)
+
+		cfg := pgStreamStore.streamTrimmer.config.Get()
+		cfg.StreamTrimActivationFactor = 0
+		cfg.StreamHistoryMiniblocks = crypto.StreamHistoryMiniblocks{}
+		cfg.StreamSnapshotIntervalInMiniblocks = 0
+
+		streamId := testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN)
+
+		// Messages expire after 1 hour, miniblock i is created 20-i hours ago.
+		snapshot := makeDmSnapshot(t, streamId, time.Hour)
+		now := time.Now()
+		mbTime := func(i int) time.Time { return now.Add(-time.Duration(20-i) * time.Hour) }
+
+		require.NoError(pgStreamStore.CreateStreamStorage(ctx, streamId, &MiniblockDescriptor{
+			Data:     makeTimestampedMiniblockData(t, 0, mbTime(0)),
+			Snapshot: snapshot,
+		}))
+
+		mbs := make([]*MiniblockDescriptor, 20)
+		for i := 1; i <= 20; i++ {
+			mb := &MiniblockDescriptor{
+				Number: int64(i),
+				Hash:   common.BytesToHash([]byte("block_hash" + strconv.Itoa(i))),
+				Data:   makeTimestampedMiniblockData(t, int64(i), mbTime(i)),
+			}
+			if i%10 == 0 {
+				mb.Snapshot = snapshot
+			}
+			mbs[i-1] = mb
+		}
+		require.NoError(pgStreamStore.WriteMiniblocks(
+			ctx,
+			streamId,
+			mbs,
+			mbs[len(mbs)-1].Number+1,
+			[][]byte{[]byte("event")},
+			mbs[0].Number,
+			-1,
+		))
+
+		pgStreamStore.streamTrimmer.sweepAgedStreams()
+
+		require.Never(func() bool {
+			seqs, _ := collectStreamState(t, pgStreamStore, ctx, streamId)
+			return len(seqs) != 21
+		}, time.Second, 100*time.Millisecond)
+	})
+
+	t.Run("per-space_history_retention_is_swept_without_trim_activation", func(t *testing.T) {
+		params := setupStreamStorageTest(t)
+		ctx := params.ctx
+		pgStreamStore := params.pgStreamStore
+		require := require.New(t)
+
+		spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+		streamId := testutils.MakeChannelId(spaceId)
+		otherStreamId := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+		// Trimming on miniblock writes is never activated, only the space of streamId keeps miniblocks from
+		// the last 24 hours.
+		cfg := pgStreamStore.streamTrimmer.config.Get()
+		cfg.StreamTrimActivationFactor = math.MaxUint64
+		cfg.StreamHistoryMiniblocks = crypto.StreamHistoryMiniblocks{}
+		cfg.StreamSnapshotIntervalInMiniblocks = 0
+		cfg.StreamHistoryRetention = crypto.StreamHistoryRetention{}
+		cfg.StreamHistoryRetentionBySpace = []crypto.StreamIdRetention{{StreamId: spaceId, Age: 24 * time.Hour}}
+
+		// Miniblock i is created 60-i hours ago.
+		now := time.Now()
+		mbTime := func(i int) time.Time { return now.Add(-time.Duration(60-i) * time.Hour) }
+
+		for _, id := range []StreamId{streamId, otherStreamId} {
+			genesisMb := &MiniblockDescriptor{
+				Data:     makeTimestampedMiniblockData(t, 0, mbTime(0)),
+				Snapshot: []byte("genesisSnapshot"),
+			}
+			require.NoError(pgStreamStore.CreateStreamStorage(ctx, id, genesisMb))
+
+			mbs := make([]*MiniblockDescriptor, 60)
+			for i := 1; i <= 60; i++ {
+				mb := &MiniblockDescriptor{
+					Number: int64(i),
+					Hash:   common.BytesToHash([]byte("block_hash" + strconv.Itoa(i))),
+					Data:   makeTimestampedMiniblockData(t, int64(i), mbTime(i)),
+				}
+				if i%10 == 0 {
+					mb.Snapshot = []byte("snapshot" + strconv.Itoa(i))
+				}
+				mbs[i-1] = mb
+			}
+
+			require.NoError(pgStreamStore.WriteMiniblocks(
+				ctx,
+				id,
+				mbs,
+				mbs[len(mbs)-1].Number+1,
+				[][]byte{[]byte("event")},
+				mbs[0].Number,
+				-1,
+			))
+		}
+
+		pgStreamStore.streamTrimmer.sweepAgedStreams()
+
+		// Miniblocks 37..60 are within the retention, the stream is trimmed to the closest snapshot before it.
+		expectedSeqs := make([]int64, 0, 31)
+		for i := int64(30); i <= 60; i++ {
+			expectedSeqs = append(expectedSeqs, i)
+		}
+		require.Eventually(func() bool {
+			seqs, _ := collectStreamState(t, pgStreamStore, ctx, streamId)
+			return slices.Equal(expectedSeqs, seqs)
+		}, time.Second*5, 100*time.Millisecond)
+
+		// Channels of other spaces are not trimmed.
+		seqs, _ := collectStreamState(t, pgStreamStore, ctx, otherStreamId)
+		require.Len(seqs, 61)
+	})
+
 	t.Run("pending deduplication keeps only one task per stream", func(t *testing.T) {
 		params := setupStreamStorageTest(t)
 		ctx := params.ctx
@@ -529,6 +849,43 @@ func TestStreamTrimmer(t *testing.T) {
 	})
 }
 
+// makeTimestampedMiniblockData returns an encoded miniblock of which the header has the given timestamp.
+func makeTimestampedMiniblockData(t *testing.T, num int64, ts time.Time) []byte {
+	t.Helper()
+
+	header, err := proto.Marshal(&StreamEvent{
+		Payload: &StreamEvent_MiniblockHeader{
+			MiniblockHeader: &MiniblockHeader{MiniblockNum: num, Timestamp: timestamppb.New(ts)},
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := proto.Marshal(&Miniblock{Header: &Envelope{Event: header}})
+	require.NoError(t, err)
+	return data
+}
+
+// makeDmSnapshot returns a serialized snapshot envelope of a DM stream with the given message expiration.
+func makeDmSnapshot(t *testing.T, streamId StreamId, expiration time.Duration) []byte {
+	t.Helper()
+
+	snapshot, err := proto.Marshal(&Snapshot{
+		Content: &Snapshot_DmChannelContent{
+			DmChannelContent: &DmChannelPayload_Snapshot{
+				Inception: &DmChannelPayload_Inception{
+					StreamId: streamId[:],
+					Settings: &StreamSettings{MessageExpirationSeconds: uint64(expiration.Seconds())},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := proto.Marshal(&Envelope{Event: snapshot})
+	require.NoError(t, err)
+	return data
+}
+
 func collectStreamState(
 	t *testing.T,
 	store *PostgresStreamStore,
@@ -688,6 +1045,64 @@ func TestStreamTrimmerComputeTrimTask(t *testing.T) {
 		assert.Equal(t, int64(10), task.streamHistoryMbs)
 	})
 
+	t.Run("history_retention_allows_scheduling_without_history_config", func(t *testing.T) {
+		cfg := &crypto.OnChainSettings{
+			StreamTrimActivationFactor: 1,
+			MinSnapshotEvents:          crypto.MinSnapshotEventsSettings{Default: 1},
+			StreamHistoryRetention:     crypto.StreamHistoryRetention{Space: 30 * 24 * time.Hour},
+		}
+		tr := makeTrimmer(cfg)
+
+		task, ok := tr.computeTrimTask(spaceStream)
+		assert.True(t, ok)
+		assert.Equal(t, trimTask{
+			streamId:         spaceStream,
+			targetMiniblock:  -1,
+			historyRetention: 30 * 24 * time.Hour,
+		}, task)
+	})
+
+	t.Run("dm_streams_are_scheduled_for_message_expiration", func(t *testing.T) {
+		cfg := &crypto.OnChainSettings{
+			StreamTrimActivationFactor: 1,
+			MinSnapshotEvents:          crypto.MinSnapshotEventsSettings{Default: 1},
+		}
+		tr := makeTrimmer(cfg)
+
+		// message expiration is read from the stream settings when the task is processed
+		dmStream := testutils.FakeStreamId(STREAM_DM_CHANNEL_BIN)
+		task, ok := tr.computeTrimTask(dmStream)
+		assert.True(t, ok)
+		assert.Equal(t, trimTask{streamId: dmStream, targetMiniblock: -1}, task)
+
+		_, ok = tr.computeTrimTask(spaceStream)
+		assert.False(t, ok)
+	})
+
+	t.Run("per-space_history_retention_applies_to_channels", func(t *testing.T) {
+		spaceStream := testutils.FakeStreamId(STREAM_SPACE_BIN)
+		channelStream := testutils.MakeChannelId(spaceStream)
+		otherChannelStream := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
+
+		cfg := &crypto.OnChainSettings{
+			StreamTrimActivationFactor: 1,
+			MinSnapshotEvents:          crypto.MinSnapshotEventsSettings{Default: 1},
+			StreamHistoryRetention:     crypto.StreamHistoryRetention{Channel: 90 * 24 * time.Hour},
+			StreamHistoryRetentionBySpace: []crypto.StreamIdRetention{
+				{StreamId: spaceStream, Age: 7 * 24 * time.Hour},
+			},
+		}
+		tr := makeTrimmer(cfg)
+
+		task, ok := tr.computeTrimTask(channelStream)
+		assert.True(t, ok)
+		assert.Equal(t, 7*24*time.Hour, task.historyRetention)
+
+		task, ok = tr.computeTrimTask(otherChannelStream)
+		assert.True(t, ok)
+		assert.Equal(t, 90*24*time.Hour, task.historyRetention)
+	})
+
 	t.Run("per-streamId_target_allows_scheduling_even_without_history_config", func(t *testing.T) {
 		spaceStream := testutils.FakeStreamId(STREAM_SPACE_BIN)
 
@@ -708,6 +1123,39 @@ func TestStreamTrimmerComputeTrimTask(t *testing.T) {
 	})
 }
 
+func TestAgeSweepPrefixes(t *testing.T) {
+	spaceId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	channelPrefix := testutils.MakeChannelId(spaceId).String()[:42]
+
+	t.Run("no_history_retention_sweeps_nothing", func(t *testing.T) {
+		prefixes := ageSweepPrefixes(&crypto.OnChainSettings{})
+		assert.Empty(t, prefixes)
+	})
+
+	t.Run("per-type_history_retention_sweeps_stream_type", func(t *testing.T) {
+		prefixes := ageSweepPrefixes(&crypto.OnChainSettings{
+			StreamHistoryRetention: crypto.StreamHistoryRetention{Channel: time.Hour},
+		})
+		assert.Equal(t, []string{STREAM_CHANNEL_PREFIX}, prefixes)
+	})
+
+	t.Run("per-space_history_retention_sweeps_space_and_channels", func(t *testing.T) {
+		prefixes := ageSweepPrefixes(&crypto.OnChainSettings{
+			StreamHistoryRetentionBySpace: []crypto.StreamIdRetention{{StreamId: spaceId, Age: time.Hour}},
+		})
+		assert.Equal(t, []string{spaceId.String(), channelPrefix}, prefixes)
+	})
+
+	t.Run("per-space_history_retention_skips_swept_types", func(t *testing.T) {
+		prefixes := ageSweepPrefixes(&crypto.OnChainSettings{
+			StreamHistoryRetention:        crypto.StreamHistoryRetention{Default: time.Hour},
+			StreamHistoryRetentionBySpace: []crypto.StreamIdRetention{{StreamId: spaceId, Age: time.Minute}},
+		})
+		assert.Len(t, prefixes, len(ageSweepStreamTypes))
+		assert.NotContains(t, prefixes, channelPrefix)
+	})
+}
+
 func TestStreamTrimmerGetTrimTargetForStream(t *testing.T) {
 	spaceStream := testutils.FakeStreamId(STREAM_SPACE_BIN)
 	channelStream := testutils.FakeStreamId(STREAM_CHANNEL_BIN)
@@ -890,3 +1338,17 @@ func TestStreamTrimmerScheduleNewPerStreamTargets(t *testing.T) {
 		assert.Equal(t, int64(200), tr.scheduledPerStreamTargets[stream1])
 	})
 }
+
+func TestParseMiniblockTimestamp(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	got, ok := parseMiniblockTimestamp(makeTimestampedMiniblockData(t, 5, ts))
+	require.True(t, ok)
+	require.True(t, ts.Equal(got))
+
+	_, ok = parseMiniblockTimestamp([]byte("block5"))
+	require.False(t, ok)
+
+	_, ok = parseMiniblockTimestamp(nil)
+	require.False(t, ok)
+}
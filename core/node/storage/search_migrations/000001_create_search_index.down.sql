@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS auth_sessions;
+DROP TABLE IF EXISTS auth_challenges;
+DROP TABLE IF EXISTS stream_sync_cookies;
+DROP TABLE IF EXISTS search_stream_members;
+DROP TABLE IF EXISTS search_documents;
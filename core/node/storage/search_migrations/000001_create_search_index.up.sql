@@ -0,0 +1,59 @@
+-- cleartext fields indexed from stream events
+CREATE TABLE IF NOT EXISTS search_documents (
+    event_hash  BYTEA PRIMARY KEY NOT NULL,
+    stream_id   CHAR(64) NOT NULL,
+    space_id    CHAR(64),
+    creator     BYTEA NOT NULL,
+    kind        SMALLINT NOT NULL,
+    text        TEXT NOT NULL,
+    created_at  TIMESTAMP NOT NULL,
+    tsv         TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED
+);
+
+CREATE INDEX IF NOT EXISTS SEARCH_DOCUMENTS_TSV_IDX ON search_documents USING GIN (tsv);
+CREATE INDEX IF NOT EXISTS SEARCH_DOCUMENTS_STREAM_ID_IDX ON search_documents (stream_id, creator, kind);
+CREATE INDEX IF NOT EXISTS SEARCH_DOCUMENTS_SPACE_ID_IDX ON search_documents (space_id);
+
+-- members of the indexed streams, search results are restricted to streams the user is a member of
+CREATE TABLE IF NOT EXISTS search_stream_members (
+    stream_id   CHAR(64) NOT NULL,
+    member      BYTEA NOT NULL,
+    PRIMARY KEY (member, stream_id)
+);
+
+CREATE INDEX IF NOT EXISTS SEARCH_STREAM_MEMBERS_STREAM_ID_IDX ON search_stream_members (stream_id);
+
+-- sync cookies allow the indexer to resume tracked streams after a restart without missing events
+CREATE TABLE IF NOT EXISTS stream_sync_cookies (
+    stream_id            CHAR(64) PRIMARY KEY NOT NULL,
+    minipool_gen         BIGINT NOT NULL,
+    prev_miniblock_hash  BYTEA NOT NULL,
+    updated_at           TIMESTAMP DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_stream_sync_cookies_updated_at ON stream_sync_cookies(updated_at);
+
+-- pending authentication challenges, shared between service instances so a user
+-- can finish authentication on another instance than where it was started
+CREATE TABLE IF NOT EXISTS auth_challenges (
+    challenge   BYTEA PRIMARY KEY NOT NULL,
+    user_id     BYTEA NOT NULL,
+    expires_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS AUTH_CHALLENGES_EXPIRES_AT_IDX ON auth_challenges (expires_at);
+
+-- authenticated sessions, session tokens are only accepted while their session exists
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    session_id          BYTEA PRIMARY KEY NOT NULL,
+    user_id             BYTEA NOT NULL,
+    refresh_token_hash  BYTEA NOT NULL,
+    -- hash of the refresh token that was rotated last, presenting it again revokes the session
+    prev_refresh_token_hash BYTEA,
+    created_at          TIMESTAMP NOT NULL,
+    last_refreshed_at   TIMESTAMP NOT NULL,
+    expires_at          TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS AUTH_SESSIONS_USER_ID_IDX ON auth_sessions (user_id);
+CREATE INDEX IF NOT EXISTS AUTH_SESSIONS_EXPIRES_AT_IDX ON auth_sessions (expires_at);
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -14,6 +15,7 @@ const (
 	StreamStorageTypePostgres       = postgres
 	NotificationStorageTypePostgres = postgres
 	AppRegistryStorageTypePostgres  = postgres
+	SearchStorageTypePostgres       = postgres
 )
 
 type (
@@ -84,6 +86,25 @@ type (
 		SnapshotSeqNums []int64
 	}
 
+	// StreamRepairRecord is the audit trail entry of an attempt to repair a corrupt archive stream.
+	StreamRepairRecord struct {
+		// Id identifies the record in the audit log, 0 if the record isn't stored yet.
+		Id       int64
+		StreamId StreamId
+		// FromInclusive and ToExclusive is the range of miniblocks that was replaced.
+		FromInclusive int64
+		ToExclusive   int64
+		// SourceNode is the replica the miniblocks were fetched from, zero if the repair failed.
+		SourceNode common.Address
+		// LastMiniblockHash is the hash of the last repaired miniblock.
+		LastMiniblockHash common.Hash
+		CorruptionError   string
+		Status            string
+		Error             string
+		StartedAt         time.Time
+		FinishedAt        time.Time
+	}
+
 	StreamStorage interface {
 		// CreateStreamStorage creates a new stream with the given genesis miniblock at index 0.
 		// Last snapshot minblock index is set to 0.
@@ -132,6 +153,11 @@ type (
 		// Unlike regular CreateStreamStorage, only entry in es table and partition table for miniblocks are created.
 		CreateStreamArchiveStorage(ctx context.Context, streamId StreamId) error
 
+		// ImportStreamArchiveStorage creates archive storage for the given stream and writes the given miniblocks
+		// in a single transaction. Unlike WriteArchiveMiniblocks the first miniblock doesn't need to be the
+		// genesis miniblock which allows importing trimmed streams. Miniblock numbers must be continuous.
+		ImportStreamArchiveStorage(ctx context.Context, streamId StreamId, miniblocks []*MiniblockDescriptor) error
+
 		// ReadStreamFromLastSnapshot reads last stream miniblocks and guarantees that last snapshot miniblock is
 		// included. It attempts to read at least numPrecedingMiniblocks miniblocks before the snapshot, but may return
 		// less if there are not enough miniblocks in storage,
@@ -225,6 +251,27 @@ type (
 			miniblocks []*MiniblockDescriptor,
 		) error
 
+		// ReplaceArchiveMiniblocks replaces the archived miniblocks of the stream from startMiniblockNum onwards
+		// with the given miniblocks in a single transaction.
+		// It checks that startMiniblockNum - 1 miniblock exists in storage.
+		ReplaceArchiveMiniblocks(
+			ctx context.Context,
+			streamId StreamId,
+			startMiniblockNum int64,
+			miniblocks []*MiniblockDescriptor,
+		) error
+
+		// WriteStreamRepairRecord adds the record to the stream repair audit log and assigns its Id.
+		// If the record is already stored its status and error are updated.
+		WriteStreamRepairRecord(ctx context.Context, record *StreamRepairRecord) error
+
+		// ReadStreamRepairRecords returns the most recent limit records of the stream repair audit log,
+		// oldest first.
+		ReadStreamRepairRecords(ctx context.Context, limit int) ([]*StreamRepairRecord, error)
+
+		// ReadStreamRepairAttempts returns the number of stored repair attempts per stream.
+		ReadStreamRepairAttempts(ctx context.Context) (map[StreamId]uint32, error)
+
 		// GetLastMiniblockNumber returns the last miniblock number for the given stream from storage.
 		GetLastMiniblockNumber(ctx context.Context, streamID StreamId) (int64, error)
 
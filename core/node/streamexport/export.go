@@ -0,0 +1,249 @@
+package streamexport
+
+import (
+	"context"
+	"io"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+// StreamReader reads stored miniblocks of streams. It is implemented by storage.StreamStorage and
+// by the read-only storage.PostgresSnapshotReader which can export from the database of a running node.
+type StreamReader interface {
+	GetMiniblockNumberRanges(ctx context.Context, streamId StreamId) ([]storage.MiniblockRange, error)
+	ReadMiniblocks(
+		ctx context.Context,
+		streamId StreamId,
+		fromInclusive int64,
+		toExclusive int64,
+		omitSnapshot bool,
+	) ([]*storage.MiniblockDescriptor, bool, error)
+}
+
+// ExportStream writes all miniblocks that are stored for the given stream as a stream section to w.
+// Miniblocks are read from store in pages of pageSize miniblocks. Trimmed streams are exported
+// starting from the first stored miniblock. An error is returned if the stored miniblocks have a gap.
+func ExportStream(
+	ctx context.Context,
+	store StreamReader,
+	w *Writer,
+	streamId StreamId,
+	pageSize int64,
+) error {
+	ranges, err := store.GetMiniblockNumberRanges(ctx, streamId)
+	if err != nil {
+		return AsRiverError(err).Func("ExportStream")
+	}
+	if len(ranges) == 0 {
+		return RiverError(Err_NOT_FOUND, "Stream has no miniblocks", "streamId", streamId).Func("ExportStream")
+	}
+	if len(ranges) > 1 {
+		return RiverError(Err_MINIBLOCKS_NOT_FOUND, "Stream has gaps in stored miniblocks",
+			"streamId", streamId, "ranges", ranges).Func("ExportStream")
+	}
+
+	fromInclusive, toExclusive := ranges[0].StartInclusive, ranges[0].EndInclusive+1
+	if err := w.BeginStream(streamId, fromInclusive, toExclusive); err != nil {
+		return err
+	}
+
+	for from := fromInclusive; from < toExclusive; from += pageSize {
+		mbs, _, err := store.ReadMiniblocks(ctx, streamId, from, min(from+pageSize, toExclusive), false)
+		if err != nil {
+			return AsRiverError(err).Tag("streamId", streamId).Func("ExportStream")
+		}
+		for _, mb := range mbs {
+			if err := w.WriteMiniblock(mb); err != nil {
+				return err
+			}
+		}
+	}
+
+	return w.EndStream()
+}
+
+// ImportStream writes the miniblocks from the given stream section into archive storage. The section
+// is read in batches of batchSize miniblocks, each batch is verified before it is written.
+//
+// Streams that are not archived yet are created with the first batch, the export can start at any
+// miniblock which allows importing trimmed streams. For archived streams the export must continue the
+// stored miniblocks. Miniblocks that are already stored are skipped after verifying that the last of
+// them has the same hash as the stored miniblock. It returns the number of imported miniblocks.
+func ImportStream(
+	ctx context.Context,
+	store storage.StreamStorage,
+	stream *ExportedStream,
+	batchSize int,
+) (int, error) {
+	archived := true
+	lastStored, err := store.GetMaxArchivedMiniblockNumber(ctx, stream.StreamId)
+	if IsRiverErrorCode(err, Err_NOT_FOUND) {
+		archived = false
+		lastStored = -1
+	} else if err != nil {
+		return 0, AsRiverError(err).Func("ImportStream")
+	}
+
+	if archived && stream.FromInclusive > lastStored+1 {
+		return 0, RiverError(Err_MINIBLOCKS_NOT_FOUND, "Export doesn't continue stored miniblocks",
+			"streamId", stream.StreamId, "lastStored", lastStored, "firstInExport", stream.FromInclusive).
+			Func("ImportStream")
+	}
+
+	var (
+		imported int
+		// lastSkipped is the last exported miniblock that is already stored, it must match the stored miniblock
+		lastSkipped *MiniblockInfo
+		verified    bool
+	)
+
+	for {
+		mbs, infos, err := stream.ReadMiniblocks(batchSize)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return imported, err
+		}
+
+		for len(mbs) > 0 && mbs[0].Number <= lastStored {
+			lastSkipped = infos[0]
+			mbs, infos = mbs[1:], infos[1:]
+		}
+
+		if len(mbs) == 0 {
+			continue
+		}
+
+		if lastSkipped != nil && !verified {
+			if err := verifyStoredMiniblock(ctx, store, stream.StreamId, lastSkipped); err != nil {
+				return imported, err
+			}
+			verified = true
+		}
+
+		if archived {
+			err = store.WriteArchiveMiniblocks(ctx, stream.StreamId, mbs[0].Number, mbs)
+		} else {
+			err = store.ImportStreamArchiveStorage(ctx, stream.StreamId, mbs)
+			archived = true
+		}
+		if err != nil {
+			return imported, AsRiverError(err).Func("ImportStream")
+		}
+		imported += len(mbs)
+	}
+
+	// the export doesn't extend the stored miniblocks, make sure it belongs to the same stream
+	if lastSkipped != nil && !verified {
+		if err := verifyStoredMiniblock(ctx, store, stream.StreamId, lastSkipped); err != nil {
+			return imported, err
+		}
+	}
+
+	return imported, nil
+}
+
+// verifyStoredMiniblock returns an error if the stored miniblock with the same number as the given
+// exported miniblock has a different hash.
+func verifyStoredMiniblock(
+	ctx context.Context,
+	store storage.StreamStorage,
+	streamId StreamId,
+	exported *MiniblockInfo,
+) error {
+	stored, _, err := store.ReadMiniblocks(ctx, streamId, exported.Ref.Num, exported.Ref.Num+1, true)
+	if err != nil {
+		return AsRiverError(err).Func("ImportStream")
+	}
+	if len(stored) != 1 {
+		return RiverError(Err_MINIBLOCKS_NOT_FOUND, "Stored miniblock not found",
+			"streamId", streamId, "num", exported.Ref.Num).Func("ImportStream")
+	}
+
+	storedInfo, err := NewMiniblockInfoFromDescriptorWithOpts(
+		stored[0], NewParsedMiniblockInfoOpts().WithDoNotParseEvents(true))
+	if err != nil {
+		return AsRiverError(err).Func("ImportStream")
+	}
+	if storedInfo.Ref.Hash != exported.Ref.Hash {
+		return RiverError(Err_BAD_BLOCK, "Exported miniblock doesn't match stored miniblock",
+			"streamId", streamId, "num", exported.Ref.Num,
+			"storedHash", storedInfo.Ref.Hash, "exportedHash", exported.Ref.Hash).Func("ImportStream")
+	}
+	return nil
+}
+
+// SpaceChannels returns the ids of all channels that were ever created in the given space,
+// including channels that were deleted later on. Space miniblocks are read from store in pages
+// of pageSize miniblocks.
+func SpaceChannels(
+	ctx context.Context,
+	store StreamReader,
+	spaceId StreamId,
+	pageSize int64,
+) ([]StreamId, error) {
+	if !ValidSpaceStreamId(&spaceId) {
+		return nil, RiverError(Err_INVALID_ARGUMENT, "Not a space stream", "streamId", spaceId).
+			Func("SpaceChannels")
+	}
+
+	ranges, err := store.GetMiniblockNumberRanges(ctx, spaceId)
+	if err != nil {
+		return nil, AsRiverError(err).Func("SpaceChannels")
+	}
+
+	var (
+		seen     = make(map[StreamId]struct{})
+		channels []StreamId
+	)
+
+	addChannel := func(channelId []byte) error {
+		id, err := StreamIdFromBytes(channelId)
+		if err != nil {
+			return AsRiverError(err).Func("SpaceChannels")
+		}
+		if _, found := seen[id]; !found {
+			seen[id] = struct{}{}
+			channels = append(channels, id)
+		}
+		return nil
+	}
+
+	// for trimmed space streams channels created before the first stored miniblock are in its snapshot
+	for _, r := range ranges {
+		for from := r.StartInclusive; from <= r.EndInclusive; from += pageSize {
+			mbs, _, err := store.ReadMiniblocks(ctx, spaceId, from, min(from+pageSize, r.EndInclusive+1), false)
+			if err != nil {
+				return nil, AsRiverError(err).Func("SpaceChannels")
+			}
+
+			for _, mb := range mbs {
+				info, err := NewMiniblockInfoFromDescriptor(mb)
+				if err != nil {
+					return nil, AsRiverError(err).Func("SpaceChannels")
+				}
+
+				for _, channel := range info.Snapshot.GetSpaceContent().GetChannels() {
+					if err := addChannel(channel.GetChannelId()); err != nil {
+						return nil, err
+					}
+				}
+
+				for _, event := range info.Events() {
+					if update := event.Event.GetSpacePayload().GetChannel(); update != nil {
+						if err := addChannel(update.GetChannelId()); err != nil {
+							return nil, err
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return channels, nil
+}
@@ -0,0 +1,419 @@
+// Package streamexport implements a portable, self-describing file format for stream miniblocks.
+//
+// An export file is a JSON lines file. It starts with a file header record, followed by one or
+// more stream sections. Each stream section starts with a stream record, contains one miniblock
+// record per exported miniblock and ends with a stream end record that summarizes the section:
+//
+//	{"type":"header","format":"river-stream-export","version":1,"createdAt":"..."}
+//	{"type":"stream","streamId":"20...","fromInclusive":0,"toExclusive":12}
+//	{"type":"miniblock","num":0,"hash":"0x..","prevHash":"0x..","snapshot":true,"data":"..."}
+//	...
+//	{"type":"streamEnd","streamId":"20...","miniblocks":12,"lastHash":"0x..."}
+//
+// Miniblock and snapshot data are the protobuf encoded Miniblock and snapshot Envelope as stored
+// in the database. Hashes and snapshot markers are duplicated outside the protobuf data so the
+// file can be inspected without decoding and verified offline.
+package streamexport
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+)
+
+const (
+	// FormatName identifies stream export files.
+	FormatName = "river-stream-export"
+	// FormatVersion is the version of the export file format written by this package.
+	FormatVersion = 1
+
+	RecordTypeHeader    = "header"
+	RecordTypeStream    = "stream"
+	RecordTypeMiniblock = "miniblock"
+	RecordTypeStreamEnd = "streamEnd"
+
+	// maxRecordSize is the maximum size of a single record in an export file.
+	maxRecordSize = 256 * 1024 * 1024
+	// skipPageSize is the number of miniblocks that are read at once when skipping a stream section.
+	skipPageSize = 100
+)
+
+// Record is a single line in an export file. Type determines which fields are set.
+type Record struct {
+	Type string `json:"type"`
+
+	// Header fields.
+	Format    string    `json:"format,omitempty"`
+	Version   int       `json:"version,omitempty"`
+	CreatedAt time.Time `json:"createdAt,omitzero"`
+
+	// Stream and stream end fields.
+	StreamId      *StreamId `json:"streamId,omitempty"`
+	FromInclusive int64     `json:"fromInclusive,omitempty"`
+	ToExclusive   int64     `json:"toExclusive,omitempty"`
+	Miniblocks    int64     `json:"miniblocks,omitempty"`
+	LastHash      string    `json:"lastHash,omitempty"`
+
+	// Miniblock fields.
+	Num          int64  `json:"num,omitempty"`
+	Hash         string `json:"hash,omitempty"`
+	PrevHash     string `json:"prevHash,omitempty"`
+	Snapshot     bool   `json:"snapshot,omitempty"`
+	Data         []byte `json:"data,omitempty"`
+	SnapshotData []byte `json:"snapshotData,omitempty"`
+}
+
+// Writer writes streams to an export file.
+type Writer struct {
+	out           *bufio.Writer
+	enc           *json.Encoder
+	headerWritten bool
+
+	// state of the stream section that is currently written
+	streamId   *StreamId
+	nextNum    int64
+	lastHash   common.Hash
+	mbsWritten int64
+}
+
+// NewWriter creates a writer that writes an export file to out.
+// Close must be called to flush buffered data.
+func NewWriter(out io.Writer) *Writer {
+	w := &Writer{out: bufio.NewWriter(out)}
+	w.enc = json.NewEncoder(w.out)
+	return w
+}
+
+// writeHeader writes the file header if it wasn't written yet.
+func (w *Writer) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	w.headerWritten = true
+	return w.writeRecord(&Record{
+		Type:      RecordTypeHeader,
+		Format:    FormatName,
+		Version:   FormatVersion,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (w *Writer) writeRecord(r *Record) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if err := w.enc.Encode(r); err != nil {
+		return AsRiverError(err, Err_INTERNAL).Message("Unable to write export record").Func("Writer.writeRecord")
+	}
+	return nil
+}
+
+// BeginStream starts a new stream section for miniblocks [fromInclusive, toExclusive).
+func (w *Writer) BeginStream(streamId StreamId, fromInclusive int64, toExclusive int64) error {
+	if w.streamId != nil {
+		return RiverError(Err_FAILED_PRECONDITION, "Previous stream section not ended",
+			"streamId", w.streamId).Func("Writer.BeginStream")
+	}
+
+	w.streamId = &streamId
+	w.nextNum = fromInclusive
+	w.lastHash = common.Hash{}
+	w.mbsWritten = 0
+
+	return w.writeRecord(&Record{
+		Type:          RecordTypeStream,
+		StreamId:      &streamId,
+		FromInclusive: fromInclusive,
+		ToExclusive:   toExclusive,
+	})
+}
+
+// WriteMiniblock writes the given miniblock to the current stream section.
+// Miniblocks must be written in order without gaps.
+func (w *Writer) WriteMiniblock(mb *storage.MiniblockDescriptor) error {
+	if w.streamId == nil {
+		return RiverError(Err_FAILED_PRECONDITION, "No stream section started").Func("Writer.WriteMiniblock")
+	}
+
+	info, err := NewMiniblockInfoFromDescriptorWithOpts(mb, NewParsedMiniblockInfoOpts().
+		WithExpectedBlockNumber(w.nextNum).
+		WithDoNotParseEvents(true))
+	if err != nil {
+		return AsRiverError(err).Tag("streamId", w.streamId).Func("Writer.WriteMiniblock")
+	}
+
+	if w.mbsWritten > 0 && common.BytesToHash(info.Header().GetPrevMiniblockHash()) != w.lastHash {
+		return RiverError(Err_BAD_BLOCK, "Miniblock doesn't extend previous miniblock",
+			"streamId", w.streamId, "num", info.Ref.Num).Func("Writer.WriteMiniblock")
+	}
+
+	if err := w.writeRecord(&Record{
+		Type:         RecordTypeMiniblock,
+		Num:          info.Ref.Num,
+		Hash:         info.Ref.Hash.Hex(),
+		PrevHash:     common.BytesToHash(info.Header().GetPrevMiniblockHash()).Hex(),
+		Snapshot:     info.Snapshot != nil || len(info.Header().GetSnapshotHash()) > 0,
+		Data:         mb.Data,
+		SnapshotData: mb.Snapshot,
+	}); err != nil {
+		return err
+	}
+
+	w.nextNum++
+	w.mbsWritten++
+	w.lastHash = info.Ref.Hash
+	return nil
+}
+
+// EndStream closes the current stream section.
+func (w *Writer) EndStream() error {
+	if w.streamId == nil {
+		return RiverError(Err_FAILED_PRECONDITION, "No stream section started").Func("Writer.EndStream")
+	}
+
+	err := w.writeRecord(&Record{
+		Type:       RecordTypeStreamEnd,
+		StreamId:   w.streamId,
+		Miniblocks: w.mbsWritten,
+		LastHash:   w.lastHash.Hex(),
+	})
+	w.streamId = nil
+	return err
+}
+
+// Close flushes buffered data to the underlying writer.
+func (w *Writer) Close() error {
+	if w.streamId != nil {
+		return RiverError(Err_FAILED_PRECONDITION, "Stream section not ended",
+			"streamId", w.streamId).Func("Writer.Close")
+	}
+	// write header for empty exports
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	if err := w.out.Flush(); err != nil {
+		return AsRiverError(err, Err_INTERNAL).Message("Unable to flush export").Func("Writer.Close")
+	}
+	return nil
+}
+
+// ExportedStream is a stream section of an export file. Its miniblocks are read and verified page by
+// page with ReadMiniblocks. The section can only be read until the next call to Reader.Next.
+type ExportedStream struct {
+	StreamId StreamId
+	// FromInclusive and ToExclusive are the range of miniblocks in the section.
+	FromInclusive int64
+	ToExclusive   int64
+	// SnapshotNums holds the numbers of the miniblocks read so far that contain a snapshot.
+	SnapshotNums []int64
+
+	r        *Reader
+	expected int64
+	prevHash common.Hash
+	read     int64
+	ended    bool
+}
+
+// Reader reads streams from an export file.
+type Reader struct {
+	scanner    *bufio.Scanner
+	line       int
+	headerRead bool
+	current    *ExportedStream
+}
+
+// NewReader creates a reader for the export file in r.
+func NewReader(r io.Reader) *Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 1024*1024), maxRecordSize)
+	return &Reader{scanner: scanner}
+}
+
+func (r *Reader) next() (*Record, error) {
+	if !r.scanner.Scan() {
+		if err := r.scanner.Err(); err != nil {
+			return nil, AsRiverError(err, Err_INTERNAL).Message("Unable to read export").Func("Reader.next")
+		}
+		return nil, io.EOF
+	}
+	r.line++
+
+	var rec Record
+	if err := json.Unmarshal(r.scanner.Bytes(), &rec); err != nil {
+		return nil, AsRiverError(err, Err_BAD_BLOCK).
+			Message("Malformed export record").
+			Tag("line", r.line).
+			Func("Reader.next")
+	}
+	return &rec, nil
+}
+
+// Next reads the start of the next stream section from the export file. Miniblocks of the previous
+// section that were not read yet are verified and skipped. It returns io.EOF when there are no more
+// streams.
+func (r *Reader) Next() (*ExportedStream, error) {
+	if r.current != nil {
+		for {
+			if _, _, err := r.current.ReadMiniblocks(skipPageSize); err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		r.current = nil
+	}
+
+	if !r.headerRead {
+		rec, err := r.next()
+		if err == io.EOF {
+			return nil, RiverError(Err_BAD_BLOCK, "Export file is empty").Func("Reader.Next")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if rec.Type != RecordTypeHeader || rec.Format != FormatName {
+			return nil, RiverError(Err_BAD_BLOCK, "Not a stream export file").Func("Reader.Next")
+		}
+		if rec.Version != FormatVersion {
+			return nil, RiverError(Err_BAD_BLOCK, "Unsupported stream export version",
+				"version", rec.Version, "supported", FormatVersion).Func("Reader.Next")
+		}
+		r.headerRead = true
+	}
+
+	rec, err := r.next()
+	if err != nil {
+		return nil, err
+	}
+	if rec.Type != RecordTypeStream || rec.StreamId == nil {
+		return nil, RiverError(Err_BAD_BLOCK, "Expected stream record", "line", r.line, "type", rec.Type).
+			Func("Reader.Next")
+	}
+
+	r.current = &ExportedStream{
+		StreamId:      *rec.StreamId,
+		FromInclusive: rec.FromInclusive,
+		ToExclusive:   rec.ToExclusive,
+		r:             r,
+		expected:      rec.FromInclusive,
+	}
+	return r.current, nil
+}
+
+// ReadMiniblocks reads and verifies up to maxMiniblocks miniblocks from the stream section. The
+// returned miniblocks are as stored in the database, infos[i] is the parsed miniblocks[i]. It returns
+// io.EOF after all miniblocks of the section are read.
+//
+// Verification checks that miniblock numbers are continuous, that every miniblock hash and
+// snapshot marker matches the decoded miniblock, that each miniblock references the hash of its
+// predecessor and that the stream end record matches the stream section.
+func (s *ExportedStream) ReadMiniblocks(
+	maxMiniblocks int,
+) (miniblocks []*storage.MiniblockDescriptor, infos []*MiniblockInfo, err error) {
+	if s.ended {
+		return nil, nil, io.EOF
+	}
+	if s.r.current != s {
+		return nil, nil, RiverError(Err_FAILED_PRECONDITION, "Stream section is no longer current",
+			"streamId", s.StreamId).Func("ExportedStream.ReadMiniblocks")
+	}
+
+	for len(miniblocks) < max(maxMiniblocks, 1) {
+		rec, err := s.r.next()
+		if err == io.EOF {
+			return nil, nil, RiverError(Err_BAD_BLOCK, "Unexpected end of export file",
+				"streamId", s.StreamId).Func("ExportedStream.ReadMiniblocks")
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch rec.Type {
+		case RecordTypeMiniblock:
+			mb, info, err := s.verifyMiniblock(rec)
+			if err != nil {
+				return nil, nil, err
+			}
+			miniblocks = append(miniblocks, mb)
+			infos = append(infos, info)
+
+		case RecordTypeStreamEnd:
+			if rec.StreamId == nil || *rec.StreamId != s.StreamId ||
+				rec.Miniblocks != s.read ||
+				common.HexToHash(rec.LastHash) != s.prevHash {
+				return nil, nil, RiverError(Err_BAD_BLOCK, "Stream end record doesn't match stream section",
+					"streamId", s.StreamId, "line", s.r.line).Func("ExportedStream.ReadMiniblocks")
+			}
+			if s.ToExclusive > 0 && s.expected != s.ToExclusive {
+				return nil, nil, RiverError(Err_BAD_BLOCK, "Stream section is incomplete",
+					"streamId", s.StreamId, "expected", s.ToExclusive, "got", s.expected).
+					Func("ExportedStream.ReadMiniblocks")
+			}
+			s.ended = true
+			if len(miniblocks) == 0 {
+				return nil, nil, io.EOF
+			}
+			return miniblocks, infos, nil
+
+		default:
+			return nil, nil, RiverError(Err_BAD_BLOCK, "Unexpected record in stream section",
+				"streamId", s.StreamId, "line", s.r.line, "type", rec.Type).Func("ExportedStream.ReadMiniblocks")
+		}
+	}
+
+	return miniblocks, infos, nil
+}
+
+// verifyMiniblock verifies that the given miniblock record extends the stream section.
+func (s *ExportedStream) verifyMiniblock(rec *Record) (*storage.MiniblockDescriptor, *MiniblockInfo, error) {
+	if rec.Num != s.expected {
+		return nil, nil, RiverError(Err_BAD_BLOCK_NUMBER, "Unexpected miniblock number",
+			"streamId", s.StreamId, "expected", s.expected, "got", rec.Num).Func("ExportedStream.verifyMiniblock")
+	}
+
+	opts := NewParsedMiniblockInfoOpts()
+	if s.read > 0 {
+		opts = opts.WithExpectedPrevMiniblockHash(s.prevHash)
+	}
+	mb := &storage.MiniblockDescriptor{Number: rec.Num, Data: rec.Data, Snapshot: rec.SnapshotData}
+	info, err := NewMiniblockInfoFromDescriptorWithOpts(mb, opts)
+	if err != nil {
+		return nil, nil, AsRiverError(err).Tag("streamId", s.StreamId).Tag("line", s.r.line).
+			Func("ExportedStream.verifyMiniblock")
+	}
+
+	hasSnapshot := info.Snapshot != nil || len(info.Header().GetSnapshotHash()) > 0
+	if info.Ref.Hash != common.HexToHash(rec.Hash) ||
+		common.BytesToHash(info.Header().GetPrevMiniblockHash()) != common.HexToHash(rec.PrevHash) ||
+		hasSnapshot != rec.Snapshot {
+		return nil, nil, RiverError(Err_BAD_BLOCK, "Miniblock record doesn't match miniblock data",
+			"streamId", s.StreamId, "num", rec.Num).Func("ExportedStream.verifyMiniblock")
+	}
+	if len(info.Header().GetSnapshotHash()) > 0 && info.SnapshotEnvelope == nil {
+		return nil, nil, RiverError(Err_BAD_BLOCK, "Snapshot missing for snapshot miniblock",
+			"streamId", s.StreamId, "num", rec.Num).Func("ExportedStream.verifyMiniblock")
+	}
+
+	// rebuild the descriptor from the parsed miniblock to restore storage metadata
+	mb, err = info.AsStorageMbWithBytes(rec.Data, rec.SnapshotData)
+	if err != nil {
+		return nil, nil, AsRiverError(err).Tag("streamId", s.StreamId).Func("ExportedStream.verifyMiniblock")
+	}
+
+	if hasSnapshot {
+		s.SnapshotNums = append(s.SnapshotNums, rec.Num)
+	}
+	s.prevHash = info.Ref.Hash
+	s.expected++
+	s.read++
+	return mb, info, nil
+}
@@ -0,0 +1,189 @@
+package streamexport
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	. "github.com/towns-protocol/towns/core/node/base"
+	"github.com/towns-protocol/towns/core/node/crypto"
+	. "github.com/towns-protocol/towns/core/node/events"
+	. "github.com/towns-protocol/towns/core/node/protocol"
+	. "github.com/towns-protocol/towns/core/node/shared"
+	"github.com/towns-protocol/towns/core/node/storage"
+	"github.com/towns-protocol/towns/core/node/testutils"
+)
+
+// makeTestMiniblocks creates a chain of count miniblocks for a new space stream.
+func makeTestMiniblocks(t *testing.T, count int) (StreamId, []*storage.MiniblockDescriptor) {
+	require := require.New(t)
+
+	wallet, err := crypto.NewWallet(t.Context())
+	require.NoError(err)
+
+	streamId := testutils.FakeStreamId(STREAM_SPACE_BIN)
+	inception, err := MakeParsedEventWithPayload(
+		wallet, Make_SpacePayload_Inception(streamId, nil), &MiniblockRef{})
+	require.NoError(err)
+
+	genesis, err := MakeGenesisMiniblock(wallet, []*ParsedEvent{inception})
+	require.NoError(err)
+	info, err := NewMiniblockInfoFromProto(genesis, nil, NewParsedMiniblockInfoOpts())
+	require.NoError(err)
+
+	var mbs []*storage.MiniblockDescriptor
+	for {
+		mb, err := info.AsStorageMb()
+		require.NoError(err)
+		mbs = append(mbs, mb)
+		if len(mbs) == count {
+			return streamId, mbs
+		}
+
+		info, err = NewMiniblockInfoFromHeaderAndParsed(wallet, &MiniblockHeader{
+			MiniblockNum:      info.Ref.Num + 1,
+			PrevMiniblockHash: info.Ref.Hash[:],
+			Timestamp:         NextMiniblockTimestamp(info.Header().GetTimestamp()),
+			Content:           &MiniblockHeader_None{None: &emptypb.Empty{}},
+		}, nil, nil)
+		require.NoError(err)
+	}
+}
+
+// readAll reads all miniblocks of the given stream section in pages of pageSize miniblocks.
+func readAll(stream *ExportedStream, pageSize int) ([]*storage.MiniblockDescriptor, error) {
+	var all []*storage.MiniblockDescriptor
+	for {
+		mbs, infos, err := stream.ReadMiniblocks(pageSize)
+		if err == io.EOF {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(mbs) > pageSize || len(mbs) != len(infos) {
+			return nil, RiverError(Err_INTERNAL, "Unexpected page size", "pageSize", pageSize, "got", len(mbs))
+		}
+		all = append(all, mbs...)
+	}
+}
+
+func writeExport(t *testing.T, streams map[StreamId][]*storage.MiniblockDescriptor, order ...StreamId) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	for _, streamId := range order {
+		mbs := streams[streamId]
+		require.NoError(t, w.BeginStream(streamId, mbs[0].Number, mbs[len(mbs)-1].Number+1))
+		for _, mb := range mbs {
+			require.NoError(t, w.WriteMiniblock(mb))
+		}
+		require.NoError(t, w.EndStream())
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	streamId, mbs := makeTestMiniblocks(t, 5)
+	data := writeExport(t, map[StreamId][]*storage.MiniblockDescriptor{streamId: mbs}, streamId)
+
+	r := NewReader(bytes.NewReader(data))
+	stream, err := r.Next()
+	require.NoError(err)
+	require.Equal(streamId, stream.StreamId)
+	require.EqualValues(0, stream.FromInclusive)
+	require.EqualValues(len(mbs), stream.ToExclusive)
+
+	read, err := readAll(stream, 2)
+	require.NoError(err)
+	require.Len(read, len(mbs))
+	require.Equal([]int64{0}, stream.SnapshotNums)
+	for i, mb := range read {
+		require.Equal(mbs[i].Number, mb.Number)
+		require.Equal(mbs[i].Data, mb.Data)
+	}
+
+	_, err = r.Next()
+	require.ErrorIs(err, io.EOF)
+}
+
+func TestExportTrimmedAndSkippedSections(t *testing.T) {
+	require := require.New(t)
+
+	streamId1, mbs1 := makeTestMiniblocks(t, 6)
+	streamId2, mbs2 := makeTestMiniblocks(t, 3)
+
+	// the first stream is trimmed and starts at miniblock 2
+	data := writeExport(t, map[StreamId][]*storage.MiniblockDescriptor{
+		streamId1: mbs1[2:],
+		streamId2: mbs2,
+	}, streamId1, streamId2)
+
+	r := NewReader(bytes.NewReader(data))
+	stream, err := r.Next()
+	require.NoError(err)
+	require.Equal(streamId1, stream.StreamId)
+	require.EqualValues(2, stream.FromInclusive)
+
+	page, _, err := stream.ReadMiniblocks(1)
+	require.NoError(err)
+	require.Len(page, 1)
+	require.EqualValues(2, page[0].Number)
+
+	// the rest of the first section is skipped
+	stream2, err := r.Next()
+	require.NoError(err)
+	require.Equal(streamId2, stream2.StreamId)
+
+	_, _, err = stream.ReadMiniblocks(1)
+	require.Error(err)
+
+	read, err := readAll(stream2, 10)
+	require.NoError(err)
+	require.Len(read, len(mbs2))
+
+	_, err = r.Next()
+	require.ErrorIs(err, io.EOF)
+}
+
+func TestExportDetectsTampering(t *testing.T) {
+	require := require.New(t)
+
+	streamId, mbs := makeTestMiniblocks(t, 3)
+
+	// writer refuses miniblocks that don't extend the chain
+	w := NewWriter(io.Discard)
+	require.NoError(w.BeginStream(streamId, 0, 3))
+	require.NoError(w.WriteMiniblock(mbs[0]))
+	require.Error(w.WriteMiniblock(mbs[2]))
+
+	data := writeExport(t, map[StreamId][]*storage.MiniblockDescriptor{streamId: mbs}, streamId)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(lines, 6) // header, stream, 3 miniblocks, stream end
+
+	readSection := func(export string) error {
+		stream, err := NewReader(strings.NewReader(export)).Next()
+		if err != nil {
+			return err
+		}
+		_, err = readAll(stream, 10)
+		return err
+	}
+
+	// dropping a miniblock breaks the chain
+	dropped := strings.Join(append(append([]string{}, lines[:3]...), lines[4:]...), "\n")
+	require.Error(readSection(dropped))
+
+	// a truncated file misses the stream end record
+	truncated := strings.Join(lines[:5], "\n")
+	require.Error(readSection(truncated))
+
+	// not an export file
+	require.Error(readSection(`{"type":"stream"}`))
+}
@@ -122,6 +122,10 @@ type syncSessionRunner struct {
 
 	// cookieStore is an optional store for persisting sync cookies for stream resumption.
 	cookieStore SyncCookieStore
+
+	// removedStreams is shared with the MultiSyncRunner and keeps streams that must be removed
+	// from the sync session when the next update for the stream is received.
+	removedStreams *xsync.Map[shared.StreamId, struct{}]
 }
 
 func (ssr *syncSessionRunner) AddStream(
@@ -423,6 +427,11 @@ func (ssr *syncSessionRunner) processSyncUpdate(update *protocol.SyncStreamsResp
 			return
 		}
 
+		if _, removed := ssr.removedStreams.LoadAndDelete(streamID); removed {
+			ssr.removeStream(record)
+			return
+		}
+
 		ssr.applyUpdateToStream(update.GetStream(), record)
 	case protocol.SyncOp_SYNC_DOWN:
 		// Stream relocation is invoked by the remote syncer whenever a SYNC_DOWN is received, via a callback.
@@ -455,6 +464,32 @@ func (ssr *syncSessionRunner) processSyncUpdate(update *protocol.SyncStreamsResp
 	}
 }
 
+// removeStream stops syncing the given stream in this session. The update that triggered the removal
+// is dropped and the sync cookie isn't persisted to prevent overwriting the cookie from a new owner.
+func (ssr *syncSessionRunner) removeStream(record *streamSyncInitRecord) {
+	ssr.streamRecords.Delete(record.streamId)
+	if record.trackedView != nil {
+		ssr.metrics.TrackedStreams.With(
+			prometheus.Labels{"type": shared.StreamTypeToString(record.streamId.Type())},
+		).Dec()
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(ssr.syncCtx, modifySyncRequestTimeout)
+		defer cancel()
+
+		if _, _, err := ssr.syncer.Modify(ctx, &protocol.ModifySyncRequest{
+			RemoveStreams: [][]byte{record.streamId[:]},
+		}); err != nil {
+			logging.FromCtx(ssr.syncCtx).Infow("Unable to remove stream from sync",
+				"streamId", record.streamId,
+				"syncId", ssr.GetSyncId(),
+				"error", err,
+			)
+		}
+	}()
+}
+
 func (ssr *syncSessionRunner) WaitUntilStarted() {
 	ssr.syncStarted.Wait()
 }
@@ -671,6 +706,7 @@ func newSyncSessionRunner(
 	metrics *TrackStreamsSyncMetrics,
 	otelTracer trace.Tracer,
 	cookieStore SyncCookieStore,
+	removedStreams *xsync.Map[shared.StreamId, struct{}],
 ) *syncSessionRunner {
 	ctx, cancel := context.WithCancelCause(rootCtx)
 	runner := syncSessionRunner{
@@ -687,6 +723,7 @@ func newSyncSessionRunner(
 		metrics:                  metrics,
 		otelTracer:               otelTracer,
 		cookieStore:              cookieStore,
+		removedStreams:           removedStreams,
 	}
 	runner.syncStarted.Add(1)
 	return &runner
@@ -739,6 +776,10 @@ type MultiSyncRunner struct {
 	// cookieStore is an optional store for persisting sync cookies for stream resumption.
 	// If nil, cookie persistence is disabled.
 	cookieStore SyncCookieStore
+
+	// removedStreams keeps streams that are removed through RemoveStream and are not yet
+	// dropped from their sync session.
+	removedStreams *xsync.Map[shared.StreamId, struct{}]
 }
 
 // getNodeRequestPool returns the node-specific semaphore used to rate limit requests to each node
@@ -790,6 +831,7 @@ func NewMultiSyncRunner(
 		unfilledSyncs:          xsync.NewMap[common.Address, *syncSessionRunner](),
 		otelTracer:             otelTracer,
 		cookieStore:            cookieStore,
+		removedStreams:         xsync.NewMap[shared.StreamId, struct{}](),
 	}
 }
 
@@ -837,6 +879,11 @@ func (msr *MultiSyncRunner) addToSync(
 	rootCtx context.Context,
 	record *streamSyncInitRecord,
 ) {
+	// stream was removed before it was (re)assigned to a sync session
+	if _, removed := msr.removedStreams.LoadAndDelete(record.streamId); removed {
+		return
+	}
+
 	targetNode := record.remotes.GetStickyPeer()
 	pool := msr.getNodeRequestPool(targetNode)
 	log := logging.FromCtx(rootCtx)
@@ -855,6 +902,7 @@ func (msr *MultiSyncRunner) addToSync(
 			msr.metrics,
 			msr.otelTracer,
 			msr.cookieStore,
+			msr.removedStreams,
 		)
 		var loaded bool
 
@@ -937,6 +985,7 @@ func (msr *MultiSyncRunner) addToSync(
 				msr.metrics,
 				msr.otelTracer,
 				msr.cookieStore,
+				msr.removedStreams,
 			)
 
 			if acquireErr := pool.Acquire(rootCtx, 1); acquireErr != nil {
@@ -1000,6 +1049,13 @@ func (msr *MultiSyncRunner) addToSync(
 	}
 }
 
+// RemoveStream stops tracking the given stream. The stream is dropped from its sync session when the
+// next update for the stream is received, or when it is (re)assigned to a sync session.
+func (msr *MultiSyncRunner) RemoveStream(streamId shared.StreamId) {
+	msr.metrics.TotalStreams.With(prometheus.Labels{"type": shared.StreamTypeToString(streamId.Type())}).Dec()
+	msr.removedStreams.Store(streamId, struct{}{})
+}
+
 // AddStream adds a stream to the queue to be added to a sync session for event tracking.
 // If a cookie store is configured, it will attempt to load a stored cookie for the stream
 // to resume from the last persisted position.
@@ -1013,6 +1069,12 @@ func (msr *MultiSyncRunner) AddStream(
 	msr.metrics.TotalStreams.With(promLabels).Inc()
 
 	streamId := stream.StreamId()
+
+	// stream was removed but is still in its sync session, keep it there
+	if _, removed := msr.removedStreams.LoadAndDelete(streamId); removed {
+		return
+	}
+
 	var persistedMinipoolGen int64
 
 	// Try to load persisted state for gap detection on restart.
@@ -150,37 +150,8 @@ func (tracker *StreamsTrackerImpl) Run(ctx context.Context) error {
 
 			totalStreams++
 
-			if !tracker.filter.TrackStream(ctx, stream.StreamId(), true) {
-				return true
-			}
-
-			// Skip blocklisted streams
-			if isStreamBlocklisted(stream.StreamId()) {
-				return true
-			}
-
-			// There are some streams managed by a node that isn't registered anymore.
-			// Filter these out because we can't sync these streams.
-			stream.Stream.Nodes = slices.DeleteFunc(stream.Stream.Nodes, func(address common.Address) bool {
-				return !slices.Contains(validNodes, address)
-			})
-
-			if len(stream.Nodes()) == 0 {
-				// We know that we have a set of these on the network because some nodes were accidentally deployed
-				// with the wrong addresses early in the network's history. We've deemed these streams not worthy
-				// of repairing and generally ignore them.
-				log.Infow("Ignore stream, no valid node found", "stream", stream.StreamId())
-				return true
-			}
-
-			streamsLoaded++
-
-			// start stream sync session for stream if it hasn't seen before
-			_, loaded := tracker.tracked.LoadOrStore(stream.StreamId(), struct{}{})
-			if !loaded {
-				// Start tracking the stream. AddStream will check for stored cookies
-				// and resume from the last persisted position if a cookie store is configured.
-				tracker.multiSyncRunner.AddStream(ctx, stream, ApplyHistoricalContent{Enabled: false})
+			if tracker.trackRegistryStream(ctx, stream, validNodes) {
+				streamsLoaded++
 			}
 
 			return true
@@ -203,6 +174,87 @@ func (tracker *StreamsTrackerImpl) Run(ctx context.Context) error {
 	return nil
 }
 
+// trackRegistryStream starts tracking the given stream from the river registry when the filter accepts it
+// and it isn't tracked yet. It returns true when the stream is accepted by the filter.
+func (tracker *StreamsTrackerImpl) trackRegistryStream(
+	ctx context.Context,
+	stream *river.StreamWithId,
+	validNodes []common.Address,
+) bool {
+	if !tracker.filter.TrackStream(ctx, stream.StreamId(), true) {
+		return false
+	}
+
+	// Skip blocklisted streams
+	if isStreamBlocklisted(stream.StreamId()) {
+		return false
+	}
+
+	// There are some streams managed by a node that isn't registered anymore.
+	// Filter these out because we can't sync these streams.
+	stream.Stream.Nodes = slices.DeleteFunc(stream.Stream.Nodes, func(address common.Address) bool {
+		return !slices.Contains(validNodes, address)
+	})
+
+	if len(stream.Nodes()) == 0 {
+		// We know that we have a set of these on the network because some nodes were accidentally deployed
+		// with the wrong addresses early in the network's history. We've deemed these streams not worthy
+		// of repairing and generally ignore them.
+		logging.FromCtx(ctx).Infow("Ignore stream, no valid node found", "stream", stream.StreamId())
+		return false
+	}
+
+	// start stream sync session for stream if it hasn't seen before
+	_, loaded := tracker.tracked.LoadOrStore(stream.StreamId(), struct{}{})
+	if !loaded {
+		// Start tracking the stream. AddStream will check for stored cookies
+		// and resume from the last persisted position if a cookie store is configured.
+		tracker.multiSyncRunner.AddStream(ctx, stream, ApplyHistoricalContent{Enabled: false})
+	}
+
+	return true
+}
+
+// RescanStreams walks over all streams in the river registry and starts tracking the streams for which
+// include returns true and that are accepted by the filter. This is used to pick up streams the filter
+// didn't accept before, e.g. after the service took over the responsibility for a set of streams.
+func (tracker *StreamsTrackerImpl) RescanStreams(
+	ctx context.Context,
+	include func(streamID shared.StreamId) bool,
+) (int, error) {
+	var (
+		validNodes = tracker.nodeRegistries[0].GetValidNodeAddresses()
+		added      = 0
+	)
+
+	err := tracker.riverRegistry.ForAllStreams(
+		ctx,
+		tracker.riverRegistry.Blockchain.InitialBlockNum,
+		func(stream *river.StreamWithId) bool {
+			if include(stream.StreamId()) && tracker.trackRegistryStream(ctx, stream, validNodes) {
+				added++
+			}
+			return true
+		})
+
+	return added, err
+}
+
+// RemoveStreams stops tracking all tracked streams for which include returns true and returns the
+// number of removed streams.
+func (tracker *StreamsTrackerImpl) RemoveStreams(include func(streamID shared.StreamId) bool) int {
+	removed := 0
+	tracker.tracked.Range(func(streamID shared.StreamId, _ struct{}) bool {
+		if include(streamID) {
+			tracker.tracked.Delete(streamID)
+			tracker.multiSyncRunner.RemoveStream(streamID)
+			removed++
+		}
+		return true
+	})
+	return removed
+}
+
 func (tracker *StreamsTrackerImpl) forwardStreamEvents(
 	ctx context.Context,
 	streamWithId *river.StreamWithId,
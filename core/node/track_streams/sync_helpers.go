@@ -114,6 +114,7 @@ func fetchMiniblocksFromNode(
 			ToExclusive:   toExclusive,
 		})
 		req.Header().Set(headers.RiverNoForwardHeader, headers.RiverHeaderTrueValue)
+		req.Header().Set(headers.RiverFullMiniblocksHeader, headers.RiverHeaderTrueValue)
 
 		resp, err := client.GetMiniblocks(ctx, req)
 		if err != nil {
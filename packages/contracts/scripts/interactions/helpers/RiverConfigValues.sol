@@ -30,4 +30,6 @@ library RiverConfigValues {
         keccak256("stream.distribution.minbalancingadvantage");
     bytes32 public constant STREAM_DISTRIBUTION_MAX_BALANCING_ADVANTAGE =
         keccak256("stream.distribution.maxbalancingadvantage");
+    bytes32 public constant STREAM_DISTRIBUTION_NODE_LABELS =
+        keccak256("stream.distribution.nodelabels");
 }
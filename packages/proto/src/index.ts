@@ -7,4 +7,5 @@ export * from './gen/internal_pb'
 export * from './gen/notifications_pb'
 export * from './gen/payloads_pb'
 export * from './gen/protocol_pb'
+export * from './gen/search_pb'
 export * from './types'